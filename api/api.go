@@ -0,0 +1,189 @@
+// Package api is the low-level client of the Oxylabs Scraper APIs on which
+// the serp and ecommerce clients are built. It sends raw JSON payloads and
+// returns raw http resps, so that custom flows can be built without
+// re-implementing auth, retries, polling or the client options.
+//
+// Realtime reqs are made with Req:
+//
+//	c := api.NewClient(api.SyncBaseUrl, username, password)
+//	resp, err := c.Req(ctx, payload, http.MethodPost)
+//
+// Push-pull jobs are submitted with GetJobIDCtx, waited for with WaitJob, or
+// PollJobStatus to poll in the background, and their results fetched with
+// GetJobResult:
+//
+//	c := api.NewClient(api.AsyncBaseUrl, username, password)
+//	jobID, err := c.GetJobIDCtx(ctx, payload)
+//	if err != nil {
+//		return err
+//	}
+//	resp, err := c.WaitJob(ctx, jobID, 2*time.Second)
+//
+// Resps of finished jobs can be decoded with serp.DecodeResp or
+// ecommerce.DecodeResp.
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Base urls of the integration methods.
+const (
+	SyncBaseUrl  = internal.SyncBaseUrl
+	AsyncBaseUrl = internal.AsyncBaseUrl
+)
+
+// Client is the low-level API client. It is safe for concurrent use.
+type Client struct {
+	c *internal.Client
+}
+
+// NewClient returns a Client for the base url of an integration method
+// with the given client options applied.
+func NewClient(
+	baseUrl string,
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) *Client {
+	return &Client{c: internal.NewClient(baseUrl, username, password, opts...)}
+}
+
+// NewClientCtx returns a Client like NewClient, but returns an error if credentials
+// are missing, or with oxylabs.WithVerifyCredentials if the API rejects them.
+// The provided context bounds the verification of the credentials.
+func NewClientCtx(
+	ctx context.Context,
+	baseUrl string,
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*Client, error) {
+	c, err := internal.NewClientCtx(ctx, baseUrl, username, password, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{c: c}, nil
+}
+
+// Req makes a realtime req with the JSON payload and returns its http resp.
+func (c *Client) Req(
+	ctx context.Context,
+	jsonPayload []byte,
+	method string,
+) (*http.Response, error) {
+	return c.c.Req(ctx, jsonPayload, method)
+}
+
+// GetJobID submits a push-pull job with the JSON payload and returns its ID.
+func (c *Client) GetJobID(jsonPayload []byte) (string, error) {
+	return c.c.GetJobID(jsonPayload)
+}
+
+// GetJobIDCtx submits a push-pull job with the JSON payload and returns its ID.
+// If ctx carries an idempotency key, the job previously submitted with it is reused.
+func (c *Client) GetJobIDCtx(ctx context.Context, jsonPayload []byte) (string, error) {
+	return c.c.GetJobIDCtx(ctx, jsonPayload)
+}
+
+// WaitJob polls the status of the job until it is done and returns the http resp
+// with its results. The job is polled at the constant pollInterval,
+// or the one of the client if 0.
+func (c *Client) WaitJob(
+	ctx context.Context,
+	jobID string,
+	pollInterval time.Duration,
+) (*http.Response, error) {
+	return c.c.WaitJob(ctx, jobID, pollInterval)
+}
+
+// WaitJobCurve polls the status of the job like WaitJob, with the waits between
+// polls following curve, e.g. the one returned by PollCurve for the job payload.
+func (c *Client) WaitJobCurve(
+	ctx context.Context,
+	jobID string,
+	curve oxylabs.PollCurve,
+) (*http.Response, error) {
+	return c.c.WaitJobCurve(ctx, jobID, curve)
+}
+
+// PollCurve returns the poll curve of a job submitted with the JSON payload.
+// A pollInterval set for the req or the client polls at a constant interval,
+// otherwise the curve configured for the source or its default curve is used.
+func (c *Client) PollCurve(jsonPayload []byte, pollInterval time.Duration) oxylabs.PollCurve {
+	return c.c.PollCurve(jsonPayload, pollInterval)
+}
+
+// PollJobStatus polls the status of the job like WaitJob in the background.
+// Both channels must be buffered. On success errChan is closed and the http resp
+// is sent on httpRespChan, otherwise the error is sent on errChan. Both channels
+// are closed once polling ends.
+func (c *Client) PollJobStatus(
+	ctx context.Context,
+	jobID string,
+	pollInterval time.Duration,
+	httpRespChan chan *http.Response,
+	errChan chan error,
+) {
+	c.c.PollJobStatus(ctx, jobID, pollInterval, httpRespChan, errChan)
+}
+
+// CheckJobStatus gets the status of the job with a single req, without polling.
+func (c *Client) CheckJobStatus(ctx context.Context, jobID string) (oxylabs.JobStatus, error) {
+	return c.c.CheckJobStatus(ctx, jobID)
+}
+
+// GetJobResult gets the http resp with all results of the finished job, one per page.
+func (c *Client) GetJobResult(ctx context.Context, jobID string) (*http.Response, error) {
+	return c.c.GetJobResult(ctx, jobID)
+}
+
+// ResubmitFaulted resubmits the JSON payload of a job which ended with err, if err
+// is an oxylabs.JobFaultError and fewer than the configured retries of faulted jobs
+// were made. attempt is the number of the failed attempt, starting at 1. It returns
+// the ID of the new job, or err if the job is not resubmitted. If the resubmission
+// fails, the returned error wraps both err and the error of the resubmission.
+func (c *Client) ResubmitFaulted(
+	ctx context.Context,
+	jsonPayload []byte,
+	attempt int,
+	err error,
+) (string, error) {
+	return c.c.ResubmitFaulted(ctx, jsonPayload, attempt, err)
+}
+
+// Clone returns a copy of the client with the given client options applied.
+// The connection pool is shared with the copy unless the options change the
+// HTTP transport.
+func (c *Client) Clone(opts ...oxylabs.ClientOption) *Client {
+	return &Client{c: c.c.Clone(opts...)}
+}
+
+// SetCredentials replaces the API credentials of the client without
+// recreating it, e.g. after a secret rotation. It is safe for concurrent use.
+func (c *Client) SetCredentials(username string, password string) {
+	c.c.SetCredentials(username, password)
+}
+
+// Ping validates the credentials and the reachability of the API, e.g. at
+// startup or in readiness probes. It costs no credits.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.c.Ping(ctx)
+}
+
+// Shutdown stops issuing new polls and waits for in-flight polling to finish,
+// canceling it once ctx is done, then closes idle connections.
+func (c *Client) Shutdown(ctx context.Context) error {
+	return c.c.Shutdown(ctx)
+}
+
+// Close stops issuing new polls, cancels in-flight polling and closes idle connections.
+func (c *Client) Close() error {
+	return c.c.Close()
+}
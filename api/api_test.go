@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rewriteTransport sends all reqs, including the polling reqs
+// to data.oxylabs.io, to a test server.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_PushPull(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": [{"content": "<html></html>", "job_id": "123", "status_code": 200}]}`))
+		default:
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "username", "password")
+	c.c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	ctx := context.Background()
+	jobID, err := c.GetJobIDCtx(ctx, []byte(`{"source": "google_search", "query": "adidas"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "123", jobID)
+
+	resp, err := c.WaitJob(ctx, jobID, time.Millisecond)
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "<html></html>")
+
+	resp, err = c.GetJobResult(ctx, jobID)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewClientCtx(t *testing.T) {
+	_, err := NewClientCtx(context.Background(), AsyncBaseUrl, "username", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,23 @@
+// Package cache provides the result cache used by the SDK clients
+// to avoid spending API credits on identical queries.
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Interface is implemented by result caches. Implementations must be safe
+// for concurrent use. The methods take a context so that remote caches,
+// e.g. Redis, can be used.
+type Interface interface {
+	// Get returns the value stored for key and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores value for key. The value expires after ttl,
+	// a zero ttl means the value does not expire.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the value stored for key, if any.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+
+	c.Set(ctx, "a", []byte("1"), 0)
+	c.Set(ctx, "b", []byte("2"), 0)
+	c.Get(ctx, "a")
+	c.Set(ctx, "c", []byte("3"), 0)
+
+	_, ok, _ := c.Get(ctx, "b")
+	assert.False(t, ok)
+
+	v, ok, _ := c.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), v)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestLRU_Expires(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(0)
+
+	c.Set(ctx, "a", []byte("1"), time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	_, ok, _ := c.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestLRU_Delete(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(0)
+
+	c.Set(ctx, "a", []byte("1"), 0)
+	assert.NoError(t, c.Delete(ctx, "a"))
+	assert.NoError(t, c.Delete(ctx, "b"))
+
+	_, ok, _ := c.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
@@ -0,0 +1,128 @@
+// Package callback receives the notifications which the API sends to the
+// callback_url of a job once it has finished.
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// MaxNotificationSize is the maximum size in bytes of a notification body.
+var MaxNotificationSize int64 = 1 << 20
+
+// Notification is a job notification sent to a callback_url.
+type Notification struct {
+	ID     string            `json:"id"`
+	Status oxylabs.JobStatus `json:"status"`
+	Source string            `json:"source"`
+	Query  string            `json:"query"`
+	// Raw is the notification body.
+	Raw json.RawMessage `json:"-"`
+}
+
+// HandlerFunc handles a verified notification. Returning an error
+// responds with an error status code.
+type HandlerFunc func(ctx context.Context, n *Notification) error
+
+// Verifier returns an error if a notification req may be spoofed.
+// body is the notification body.
+type Verifier func(r *http.Request, body []byte) error
+
+// Receiver is an http.Handler receiving notifications. Notifications
+// which fail any of its verifiers are rejected with 403 Forbidden.
+type Receiver struct {
+	// Seen, if set, deduplicates notifications by job ID. Job IDs are marked seen
+	// once handled, duplicate or replayed notifications are acknowledged without
+	// being handled again, and those received while handling is in progress
+	// are rejected with 409 Conflict.
+	Seen SeenStore
+
+	handle    HandlerFunc
+	verifiers []Verifier
+}
+
+// NewReceiver returns a receiver passing the notifications
+// which pass all verifiers to handle. It returns an error without verifiers,
+// pass Unverified to receive notifications without verifying them.
+func NewReceiver(handle HandlerFunc, verifiers ...Verifier) (*Receiver, error) {
+	if handle == nil {
+		return nil, fmt.Errorf("handler is nil")
+	}
+	if len(verifiers) == 0 {
+		return nil, fmt.Errorf("at least one verifier is required")
+	}
+
+	return &Receiver{handle: handle, verifiers: verifiers}, nil
+}
+
+// ServeHTTP receives a notification.
+func (rc *Receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxNotificationSize+1))
+	if err != nil {
+		http.Error(w, "error reading body", http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > MaxNotificationSize {
+		http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	for _, verify := range rc.verifiers {
+		if err = verify(r, body); err != nil {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+	}
+
+	n := &Notification{Raw: body}
+	if err = json.Unmarshal(body, n); err != nil || n.ID == "" {
+		http.Error(w, "invalid notification", http.StatusBadRequest)
+		return
+	}
+
+	if rc.Seen != nil {
+		state, err := rc.Seen.Begin(r.Context(), n.ID)
+		if err != nil {
+			http.Error(w, "error checking notification", http.StatusInternalServerError)
+			return
+		}
+		switch state {
+		case Seen:
+			w.WriteHeader(http.StatusOK)
+			return
+		case InProgress:
+			// The API retries the notification later, by when the handler
+			// has either finished or failed and forgotten the job.
+			http.Error(w, "notification in progress", http.StatusConflict)
+			return
+		}
+	}
+
+	if err = rc.handle(r.Context(), n); err != nil {
+		// Forget the job, so that the notification is handled if it is sent again.
+		if rc.Seen != nil {
+			rc.Seen.Forget(r.Context(), n.ID)
+		}
+		http.Error(w, "error handling notification", http.StatusInternalServerError)
+		return
+	}
+
+	if rc.Seen != nil {
+		if err = rc.Seen.Done(r.Context(), n.ID); err != nil {
+			http.Error(w, "error marking notification", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,135 @@
+package callback
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceiver_Verify(t *testing.T) {
+	body := []byte(`{"id": "123", "status": "done", "source": "google_search"}`)
+
+	var received []*Notification
+	handle := func(ctx context.Context, n *Notification) error {
+		received = append(received, n)
+		return nil
+	}
+
+	allowlist, err := IPAllowlist("10.0.0.0/8", "192.0.2.1")
+	assert.NoError(t, err)
+	callbackUrl, err := TokenURL("https://example.com/callback", "secret")
+	assert.NoError(t, err)
+	token, err := Token("secret")
+	assert.NoError(t, err)
+	signed, err := HMAC("X-Signature", []byte("key"))
+	assert.NoError(t, err)
+	rc, err := NewReceiver(handle, allowlist, token, signed)
+	assert.NoError(t, err)
+
+	post := func(url string, remoteAddr string, signature string) int {
+		r := httptest.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+		r.RemoteAddr = remoteAddr
+		r.Header.Set("X-Signature", signature)
+		w := httptest.NewRecorder()
+		rc.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	signature := Sign(body, []byte("key"))
+	assert.Equal(t, http.StatusOK, post(callbackUrl, "10.1.2.3:4000", signature))
+	assert.Equal(t, http.StatusOK, post(callbackUrl, "192.0.2.1:4000", signature))
+	assert.Equal(t, http.StatusForbidden, post(callbackUrl, "192.0.2.2:4000", signature))
+	assert.Equal(t, http.StatusForbidden, post("https://example.com/callback?token=guess", "10.1.2.3:4000", signature))
+	assert.Equal(t, http.StatusForbidden, post(callbackUrl, "10.1.2.3:4000", Sign(body, []byte("other"))))
+
+	if assert.Len(t, received, 2) {
+		assert.Equal(t, "123", received[0].ID)
+		assert.Equal(t, "google_search", received[0].Source)
+	}
+
+	_, err = IPAllowlist("not an ip")
+	assert.Error(t, err)
+}
+
+func TestReceiver_Seen(t *testing.T) {
+	handled := 0
+	fail := true
+	rc, err := NewReceiver(func(ctx context.Context, n *Notification) error {
+		handled++
+		if fail {
+			fail = false
+			return errors.New("downstream unavailable")
+		}
+		return nil
+	}, Unverified)
+	assert.NoError(t, err)
+	rc.Seen = NewMemorySeen(time.Hour)
+
+	post := func() int {
+		r := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(`{"id": "123", "status": "done"}`))
+		w := httptest.NewRecorder()
+		rc.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// A failed notification is handled again, a replayed one is not.
+	assert.Equal(t, http.StatusInternalServerError, post())
+	assert.Equal(t, http.StatusOK, post())
+	assert.Equal(t, http.StatusOK, post())
+	assert.Equal(t, 2, handled)
+}
+
+func TestReceiver_SeenInProgress(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	rc, err := NewReceiver(func(ctx context.Context, n *Notification) error {
+		close(started)
+		<-release
+		return nil
+	}, Unverified)
+	assert.NoError(t, err)
+	rc.Seen = NewMemorySeen(time.Hour)
+
+	post := func() int {
+		r := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(`{"id": "123", "status": "done"}`))
+		w := httptest.NewRecorder()
+		rc.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	first := make(chan int)
+	go func() { first <- post() }()
+	<-started
+
+	// A duplicate received while the first is handled is rejected, not acknowledged.
+	assert.Equal(t, http.StatusConflict, post())
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-first)
+	assert.Equal(t, http.StatusOK, post())
+}
+
+func TestReceiver_RequiresVerifiers(t *testing.T) {
+	handle := func(ctx context.Context, n *Notification) error { return nil }
+
+	_, err := NewReceiver(handle)
+	assert.EqualError(t, err, "at least one verifier is required")
+	_, err = NewReceiver(nil, Unverified)
+	assert.EqualError(t, err, "handler is nil")
+	_, err = NewReceiver(handle, Unverified)
+	assert.NoError(t, err)
+
+	_, err = Token("")
+	assert.EqualError(t, err, "secret is empty")
+	_, err = HMAC("X-Signature", nil)
+	assert.EqualError(t, err, "secret is empty")
+	_, err = TokenURL("https://example.com/callback", "")
+	assert.EqualError(t, err, "secret is empty")
+}
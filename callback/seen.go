@@ -0,0 +1,105 @@
+package callback
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SeenState is the state of a job ID in a SeenStore.
+type SeenState int
+
+const (
+	// Unseen job IDs have not been handled yet.
+	Unseen SeenState = iota
+	// InProgress job IDs are being handled.
+	InProgress
+	// Seen job IDs have been handled.
+	Seen
+)
+
+// SeenStore records the job IDs of handled notifications, so that duplicate
+// or replayed notifications are not handled again. Stores shared by several
+// receivers, e.g. backed by Redis, deduplicate across processes.
+// Implementations must be safe for concurrent use.
+type SeenStore interface {
+	// Begin marks an unseen job ID as in progress and returns its previous state.
+	// Checking and marking must be atomic.
+	Begin(ctx context.Context, jobID string) (SeenState, error)
+	// Done marks the job ID as seen once its notification has been handled.
+	Done(ctx context.Context, jobID string) error
+	// Forget unmarks the job ID, so that a notification which
+	// failed to be handled is handled when it is sent again.
+	Forget(ctx context.Context, jobID string) error
+}
+
+// MemorySeen is an in-memory SeenStore which forgets job IDs after a TTL.
+type MemorySeen struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	seen     map[string]seenEntry
+	prunedAt time.Time
+}
+
+type seenEntry struct {
+	state SeenState
+	at    time.Time
+}
+
+// NewMemorySeen returns a MemorySeen remembering job IDs for ttl,
+// a zero ttl means job IDs are remembered forever. Job IDs left
+// in progress, e.g. by a crashed handler, are also forgotten after ttl.
+func NewMemorySeen(ttl time.Duration) *MemorySeen {
+	return &MemorySeen{ttl: ttl, seen: make(map[string]seenEntry), prunedAt: time.Now()}
+}
+
+// Begin marks an unseen job ID as in progress and returns its previous state.
+func (s *MemorySeen) Begin(ctx context.Context, jobID string) (SeenState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.prune(now)
+
+	if e, ok := s.seen[jobID]; ok && (s.ttl == 0 || now.Sub(e.at) < s.ttl) {
+		return e.state, nil
+	}
+	s.seen[jobID] = seenEntry{state: InProgress, at: now}
+
+	return Unseen, nil
+}
+
+// Done marks the job ID as seen.
+func (s *MemorySeen) Done(ctx context.Context, jobID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seen[jobID] = seenEntry{state: Seen, at: time.Now()}
+
+	return nil
+}
+
+// Forget unmarks the job ID.
+func (s *MemorySeen) Forget(ctx context.Context, jobID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.seen, jobID)
+
+	return nil
+}
+
+// prune removes the job IDs marked longer than the TTL ago. It scans
+// the job IDs at most once per TTL, so that Begin stays cheap.
+func (s *MemorySeen) prune(now time.Time) {
+	if s.ttl == 0 || now.Sub(s.prunedAt) < s.ttl {
+		return
+	}
+	s.prunedAt = now
+
+	for jobID, e := range s.seen {
+		if now.Sub(e.at) >= s.ttl {
+			delete(s.seen, jobID)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package callback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+// ErrUnverified is returned by verifiers for notifications which may be spoofed.
+var ErrUnverified = errors.New("unverified notification")
+
+// TokenParam is the query parameter of the callback_url carrying its secret token.
+const TokenParam = "token"
+
+// IPAllowlist returns a verifier accepting notifications sent from the given IPs
+// or CIDR ranges, e.g. the callback IPs published by Oxylabs. The IP is taken from
+// the remote address of the req, so behind a reverse proxy it must be set from the
+// forwarded headers first.
+func IPAllowlist(ips ...string) (Verifier, error) {
+	prefixes := make([]netip.Prefix, 0, len(ips))
+	for _, ip := range ips {
+		if !strings.Contains(ip, "/") {
+			addr, err := netip.ParseAddr(ip)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing ip %q: %v", ip, err)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(ip)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing cidr %q: %v", ip, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return func(r *http.Request, body []byte) error {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		addr, err := netip.ParseAddr(host)
+		if err != nil {
+			return fmt.Errorf("%w: invalid remote address %q", ErrUnverified, r.RemoteAddr)
+		}
+		addr = addr.Unmap()
+
+		for _, prefix := range prefixes {
+			if prefix.Contains(addr) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w: ip %s is not allowed", ErrUnverified, addr)
+	}, nil
+}
+
+// TokenURL returns callbackUrl with the secret token added as its TokenParam
+// query parameter, to be set as the callback_url of jobs verified with Token.
+func TokenURL(callbackUrl string, secret string) (string, error) {
+	if secret == "" {
+		return "", fmt.Errorf("secret is empty")
+	}
+
+	u, err := url.Parse(callbackUrl)
+	if err != nil {
+		return "", fmt.Errorf("error parsing callback url: %v", err)
+	}
+
+	query := u.Query()
+	query.Set(TokenParam, secret)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// Token returns a verifier accepting notifications sent to a callback_url
+// built with TokenURL and the same secret. It returns an error if secret is empty,
+// which would accept notifications without a token.
+func Token(secret string) (Verifier, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("secret is empty")
+	}
+
+	return func(r *http.Request, body []byte) error {
+		token := r.URL.Query().Get(TokenParam)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return fmt.Errorf("%w: invalid token", ErrUnverified)
+		}
+
+		return nil
+	}, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of body with secret.
+func Sign(body []byte, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// HMAC returns a verifier accepting notifications whose header carries the
+// signature of their body made with Sign and the same secret, e.g. when
+// notifications are relayed by a gateway which signs them. It returns an error
+// if secret is empty, which would accept signatures anyone can make.
+func HMAC(header string, secret []byte) (Verifier, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret is empty")
+	}
+
+	return func(r *http.Request, body []byte) error {
+		signature, err := hex.DecodeString(r.Header.Get(header))
+		if err != nil || len(signature) == 0 {
+			return fmt.Errorf("%w: missing signature", ErrUnverified)
+		}
+
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(body)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return fmt.Errorf("%w: invalid signature", ErrUnverified)
+		}
+
+		return nil
+	}, nil
+}
+
+// Unverified is a verifier accepting every notification. Passing it to NewReceiver
+// opts out of verification, e.g. for receivers behind a gateway which verifies
+// notifications itself.
+func Unverified(r *http.Request, body []byte) error {
+	return nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/api"
+	"github.com/oxylabs/oxylabs-sdk-go/ecommerce"
+	"github.com/oxylabs/oxylabs-sdk-go/jobs"
+	"github.com/oxylabs/oxylabs-sdk-go/jobs/jobsyaml"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/spf13/cobra"
+)
+
+func newJobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "job",
+		Short: "Inspect and run push-pull jobs",
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status <job_id>",
+		Short: "Print the status of a job",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkCredentials(); err != nil {
+				return err
+			}
+			c := api.NewClient(api.AsyncBaseUrl, username, password)
+
+			status, err := c.CheckJobStatus(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			return printJson(map[string]interface{}{"id": args[0], "status": status})
+		},
+	}
+
+	resultsCmd := &cobra.Command{
+		Use:   "results <job_id>",
+		Short: "Print the results of a finished job",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkCredentials(); err != nil {
+				return err
+			}
+			c := api.NewClient(api.AsyncBaseUrl, username, password)
+
+			resp, err := c.GetJobResult(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+
+			respBody, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return fmt.Errorf("error reading resp body: %v", err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("error with status code %s: %s", resp.Status, respBody)
+			}
+
+			return printJson(json.RawMessage(respBody))
+		},
+	}
+
+	runCmd := &cobra.Command{
+		Use:   "run <file>",
+		Short: "Run the jobs defined in a YAML or JSON job file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defs, err := jobsyaml.Load(args[0])
+			if err != nil {
+				return err
+			}
+			if err := checkCredentials(); err != nil {
+				return err
+			}
+			r := &jobs.Runner{
+				Serp:      serp.InitAsync(username, password),
+				Ecommerce: ecommerce.InitAsync(username, password),
+			}
+
+			for i, def := range defs {
+				res, err := r.Run(cmd.Context(), def)
+				if err != nil {
+					return fmt.Errorf("error running job %d: %v", i+1, err)
+				}
+				if res.Serp != nil {
+					err = printJson(res.Serp)
+				} else {
+					err = printJson(res.Ecommerce)
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.AddCommand(statusCmd, resultsCmd, runCmd)
+
+	return cmd
+}
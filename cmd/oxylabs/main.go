@@ -0,0 +1,60 @@
+// Command oxylabs is a CLI for ad-hoc scrapes and job debugging with the Oxylabs Scraper APIs.
+// It is part of the SDK module, so that it can be installed with go install ...@latest,
+// which does not accept modules with replace directives.
+//
+// Credentials are read from the --username and --password flags or from the
+// OXYLABS_USERNAME and OXYLABS_PASSWORD environment variables.
+//
+// Usage:
+//
+//	oxylabs serp google "adidas" --pages 3 --json
+//	oxylabs job status <job_id>
+//	oxylabs job results <job_id>
+//	oxylabs job run jobs.yaml
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	username string
+	password string
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:           "oxylabs",
+		Short:         "Scrape with the Oxylabs Scraper APIs",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	rootCmd.PersistentFlags().StringVar(&username, "username", os.Getenv("OXYLABS_USERNAME"), "Oxylabs API username")
+	rootCmd.PersistentFlags().StringVar(&password, "password", os.Getenv("OXYLABS_PASSWORD"), "Oxylabs API password")
+
+	rootCmd.AddCommand(newSerpCmd(), newJobCmd())
+
+	// Cancel in-flight reqs on interrupt.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+// checkCredentials returns an error if the credentials are not set.
+func checkCredentials() error {
+	if username == "" || password == "" {
+		return fmt.Errorf("credentials are not set, use --username and --password or OXYLABS_USERNAME and OXYLABS_PASSWORD")
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/spf13/cobra"
+)
+
+// serpFlags contains the flags shared by the serp subcommands.
+type serpFlags struct {
+	domain      string
+	startPage   int
+	pages       int
+	limit       int
+	geoLocation string
+	userAgent   string
+	render      string
+	parse       bool
+	json        bool
+}
+
+func (f *serpFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.domain, "domain", "", "domain, e.g. com")
+	cmd.Flags().IntVar(&f.startPage, "start-page", 0, "start page")
+	cmd.Flags().IntVar(&f.pages, "pages", 0, "number of pages")
+	cmd.Flags().IntVar(&f.limit, "limit", 0, "number of results per page")
+	cmd.Flags().StringVar(&f.geoLocation, "geo-location", "", "geo location")
+	cmd.Flags().StringVar(&f.userAgent, "user-agent", "", "user agent type, e.g. desktop")
+	cmd.Flags().StringVar(&f.render, "render", "", "render, html or png")
+	cmd.Flags().BoolVar(&f.parse, "parse", false, "parse results")
+	cmd.Flags().BoolVar(&f.json, "json", false, "print the whole resp as JSON")
+}
+
+func newSerpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serp",
+		Short: "Scrape search engines via the SERP API",
+	}
+
+	googleFlags := &serpFlags{}
+	googleCmd := &cobra.Command{
+		Use:   "google <query>",
+		Short: "Scrape Google with google_search as source",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkCredentials(); err != nil {
+				return err
+			}
+			c := serp.Init(username, password)
+			res, err := c.ScrapeGoogleSearchCtx(cmd.Context(), args[0], &serp.GoogleSearchOpts{
+				Domain:      oxylabs.Domain(googleFlags.domain),
+				StartPage:   googleFlags.startPage,
+				Pages:       googleFlags.pages,
+				Limit:       googleFlags.limit,
+				GeoLocation: oxylabs.GeoLocation(googleFlags.geoLocation),
+				UserAgent:   oxylabs.UserAgent(googleFlags.userAgent),
+				Render:      oxylabs.Render(googleFlags.render),
+				Parse:       googleFlags.parse,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printResp(res, googleFlags.json)
+		},
+	}
+	googleFlags.register(googleCmd)
+
+	bingFlags := &serpFlags{}
+	bingCmd := &cobra.Command{
+		Use:   "bing <query>",
+		Short: "Scrape Bing with bing_search as source",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkCredentials(); err != nil {
+				return err
+			}
+			c := serp.Init(username, password)
+			res, err := c.ScrapeBingSearchCtx(cmd.Context(), args[0], &serp.BingSearchOpts{
+				Domain:      oxylabs.Domain(bingFlags.domain),
+				StartPage:   bingFlags.startPage,
+				Pages:       bingFlags.pages,
+				Limit:       bingFlags.limit,
+				GeoLocation: oxylabs.GeoLocation(bingFlags.geoLocation),
+				UserAgent:   oxylabs.UserAgent(bingFlags.userAgent),
+				Render:      oxylabs.Render(bingFlags.render),
+				Parse:       bingFlags.parse,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printResp(res, bingFlags.json)
+		},
+	}
+	bingFlags.register(bingCmd)
+
+	cmd.AddCommand(googleCmd, bingCmd)
+
+	return cmd
+}
+
+// printResp prints the whole resp as JSON or the content of each result.
+func printResp(res *serp.Resp, asJson bool) error {
+	if asJson {
+		return printJson(res)
+	}
+
+	for _, result := range res.Results {
+		if result.Content != "" {
+			fmt.Println(result.Content)
+			continue
+		}
+		if err := printJson(result.ContentParsed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printJson(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
@@ -3,6 +3,7 @@ package ecommerce
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,6 +11,19 @@ import (
 	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
+// checkDeliveryZip checks the delivery_zip parameter,
+// which must be a US zip code on amazon.com.
+func checkDeliveryZip(domain oxylabs.Domain, zip string) error {
+	if domain == oxylabs.DOMAIN_COM && !oxylabs.IsZipCodeValid(zip) {
+		return fmt.Errorf("invalid delivery_zip parameter, expected a US zip code: %v", zip)
+	}
+	if !oxylabs.IsPostalCodeValid(zip) {
+		return fmt.Errorf("invalid delivery_zip parameter: %v", zip)
+	}
+
+	return nil
+}
+
 // AmazonUrlOpts contains all the query parameters available for amazon.
 type AmazonUrlOpts struct {
 	UserAgent         oxylabs.UserAgent
@@ -18,25 +32,42 @@ type AmazonUrlOpts struct {
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *AmazonUrlOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "AmazonUrlOpts",
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeAmazonUrl parameters.
 func (opt *AmazonUrlOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeAmazonUrl scrapes amazon via Oxylabs E-Commerce API with amazon as source.
@@ -44,7 +75,7 @@ func (c *EcommerceClient) ScrapeAmazonUrl(
 	url string,
 	opts ...*AmazonUrlOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeAmazonUrlCtx(ctx, url, opts...)
@@ -57,8 +88,23 @@ func (c *EcommerceClient) ScrapeAmazonUrlCtx(
 	url string,
 	opts ...*AmazonUrlOpts,
 ) (*Resp, error) {
+	req, err := prepareAmazonUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareAmazonUrl applies the defaults to the opts of a scrape with amazon as source,
+// checks them and returns the req.
+func prepareAmazonUrl(
+	c *internal.Client,
+	url string,
+	opts []*AmazonUrlOpts,
+) (*scrapeReq, error) {
 	// Check validity of url.
-	err := internal.ValidateUrl(url, "amazon")
+	err := c.CheckUrl(url, "amazon")
 	if err != nil {
 		return nil, err
 	}
@@ -66,11 +112,13 @@ func (c *EcommerceClient) ScrapeAmazonUrlCtx(
 	// Prepare options.
 	opt := &AmazonUrlOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err = opt.checkParameterValidity()
@@ -91,7 +139,7 @@ func (c *EcommerceClient) ScrapeAmazonUrlCtx(
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
+		payload["parsing_instructions"] = opt.ParseInstructions
 		customParserFlag = true
 	}
 
@@ -100,20 +148,16 @@ func (c *EcommerceClient) ScrapeAmazonUrlCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // AmazonSearchOpts contains all the query parameters available for amazon_search.
@@ -121,7 +165,10 @@ type AmazonSearchOpts struct {
 	Domain            oxylabs.Domain
 	StartPage         int
 	Pages             int
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
+	DeliveryZip       string
+	Currency          string
+	Language          string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
@@ -129,29 +176,69 @@ type AmazonSearchOpts struct {
 	ParseInstructions *map[string]interface{}
 	Context           []func(oxylabs.ContextOption)
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *AmazonSearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "AmazonSearchOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		Context:           &opt.Context,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeAmazonSearch parameters.
 func (opt *AmazonSearchOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
+	if opt.DeliveryZip != "" {
+		if err := checkDeliveryZip(opt.Domain, opt.DeliveryZip); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if opt.Currency != "" && !oxylabs.IsCurrencyValid(opt.Currency) {
+		errs = append(errs, fmt.Errorf("invalid currency parameter: %v", opt.Currency))
+	}
+
+	if opt.Language != "" && !oxylabs.IsLanguageValid(opt.Language) {
+		errs = append(errs, fmt.Errorf("invalid language parameter: %v", opt.Language))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeAmazonSearch scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
@@ -159,7 +246,7 @@ func (c *EcommerceClient) ScrapeAmazonSearch(
 	query string,
 	opts ...*AmazonSearchOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeAmazonSearchCtx(ctx, query, opts...)
@@ -172,10 +259,27 @@ func (c *EcommerceClient) ScrapeAmazonSearchCtx(
 	query string,
 	opts ...*AmazonSearchOpts,
 ) (*Resp, error) {
+	req, err := prepareAmazonSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareAmazonSearch applies the defaults to the opts of a scrape with amazon_search as source,
+// checks them and returns the req.
+func prepareAmazonSearch(
+	c *internal.Client,
+	query string,
+	opts []*AmazonSearchOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &AmazonSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map and apply each provided context modifier function.
@@ -184,14 +288,28 @@ func (c *EcommerceClient) ScrapeAmazonSearchCtx(
 		modifier(context)
 	}
 
+	// Typed context fields take precedence over the context modifiers.
+	if opt.DeliveryZip != "" {
+		oxylabs.DeliveryZip(opt.DeliveryZip)(context)
+	}
+	if opt.Currency != "" {
+		oxylabs.Currency(opt.Currency)(context)
+	}
+	if opt.Language != "" {
+		oxylabs.Language(opt.Language)(context)
+	}
+
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity()
+	err = opt.checkParameterValidity()
 	if err != nil {
 		return nil, err
 	}
@@ -217,13 +335,25 @@ func (c *EcommerceClient) ScrapeAmazonSearchCtx(
 				"key":   "merchant_id",
 				"value": context["merchant_id"],
 			},
+			{
+				"key":   "delivery_zip",
+				"value": context["delivery_zip"],
+			},
+			{
+				"key":   "currency",
+				"value": context["currency"],
+			},
+			{
+				"key":   "language",
+				"value": context["language"],
+			},
 		},
 	}
 
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
+		payload["parsing_instructions"] = opt.ParseInstructions
 		customParserFlag = true
 	}
 
@@ -232,26 +362,25 @@ func (c *EcommerceClient) ScrapeAmazonSearchCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // AmazonProductOpts contains all the query parameters available for amazon_product.
 type AmazonProductOpts struct {
 	Domain            oxylabs.Domain
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
+	DeliveryZip       string
+	Currency          string
+	Language          string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
@@ -259,25 +388,63 @@ type AmazonProductOpts struct {
 	ParseInstructions *map[string]interface{}
 	Context           []func(oxylabs.ContextOption)
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *AmazonProductOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "AmazonProductOpts",
+		Domain:            &opt.Domain,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		Context:           &opt.Context,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeAmazonProduct parameters.
 func (opt *AmazonProductOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
+	if opt.DeliveryZip != "" {
+		if err := checkDeliveryZip(opt.Domain, opt.DeliveryZip); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if opt.Currency != "" && !oxylabs.IsCurrencyValid(opt.Currency) {
+		errs = append(errs, fmt.Errorf("invalid currency parameter: %v", opt.Currency))
+	}
+
+	if opt.Language != "" && !oxylabs.IsLanguageValid(opt.Language) {
+		errs = append(errs, fmt.Errorf("invalid language parameter: %v", opt.Language))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeAmazonProduct scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
@@ -285,7 +452,7 @@ func (c *EcommerceClient) ScrapeAmazonProduct(
 	query string,
 	opts ...*AmazonProductOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeAmazonProductCtx(ctx, query, opts...)
@@ -298,10 +465,27 @@ func (c *EcommerceClient) ScrapeAmazonProductCtx(
 	query string,
 	opts ...*AmazonProductOpts,
 ) (*Resp, error) {
+	req, err := prepareAmazonProduct(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareAmazonProduct applies the defaults to the opts of a scrape with amazon_product as source,
+// checks them and returns the req.
+func prepareAmazonProduct(
+	c *internal.Client,
+	query string,
+	opts []*AmazonProductOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &AmazonProductOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map and apply each provided context modifier function.
@@ -310,9 +494,19 @@ func (c *EcommerceClient) ScrapeAmazonProductCtx(
 		modifier(context)
 	}
 
+	// Typed context fields take precedence over the context modifiers.
+	if opt.DeliveryZip != "" {
+		oxylabs.DeliveryZip(opt.DeliveryZip)(context)
+	}
+	if opt.Currency != "" {
+		oxylabs.Currency(opt.Currency)(context)
+	}
+	if opt.Language != "" {
+		oxylabs.Language(opt.Language)(context)
+	}
+
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity()
@@ -335,13 +529,25 @@ func (c *EcommerceClient) ScrapeAmazonProductCtx(
 				"key":   "autoselect_variant",
 				"value": context["autoselect_variant"],
 			},
+			{
+				"key":   "delivery_zip",
+				"value": context["delivery_zip"],
+			},
+			{
+				"key":   "currency",
+				"value": context["currency"],
+			},
+			{
+				"key":   "language",
+				"value": context["language"],
+			},
 		},
 	}
 
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
+		payload["parsing_instructions"] = opt.ParseInstructions
 		customParserFlag = true
 	}
 
@@ -350,20 +556,16 @@ func (c *EcommerceClient) ScrapeAmazonProductCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // AmazonPricingOpts contains all the query parameters available for amazon_pricing.
@@ -371,36 +573,78 @@ type AmazonPricingOpts struct {
 	Domain            oxylabs.Domain
 	StartPage         int
 	Pages             int
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
+	DeliveryZip       string
+	Currency          string
+	Language          string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *AmazonPricingOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "AmazonPricingOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeAmazonPricing parameters.
 func (opt *AmazonPricingOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
+	if opt.DeliveryZip != "" {
+		if err := checkDeliveryZip(opt.Domain, opt.DeliveryZip); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if opt.Currency != "" && !oxylabs.IsCurrencyValid(opt.Currency) {
+		errs = append(errs, fmt.Errorf("invalid currency parameter: %v", opt.Currency))
+	}
+
+	if opt.Language != "" && !oxylabs.IsLanguageValid(opt.Language) {
+		errs = append(errs, fmt.Errorf("invalid language parameter: %v", opt.Language))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeAmazonPricing scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
@@ -408,7 +652,7 @@ func (c *EcommerceClient) ScrapeAmazonPricing(
 	query string,
 	opts ...*AmazonPricingOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeAmazonPricingCtx(ctx, query, opts...)
@@ -421,17 +665,43 @@ func (c *EcommerceClient) ScrapeAmazonPricingCtx(
 	query string,
 	opts ...*AmazonPricingOpts,
 ) (*Resp, error) {
+	req, err := prepareAmazonPricing(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareAmazonPricing applies the defaults to the opts of a scrape with amazon_pricing as source,
+// checks them and returns the req.
+func prepareAmazonPricing(
+	c *internal.Client,
+	query string,
+	opts []*AmazonPricingOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &AmazonPricingOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Initialize the context map with the typed context fields.
+	context := make(oxylabs.ContextOption)
+	if opt.DeliveryZip != "" {
+		oxylabs.DeliveryZip(opt.DeliveryZip)(context)
+	}
+	if opt.Currency != "" {
+		oxylabs.Currency(opt.Currency)(context)
+	}
+	if opt.Language != "" {
+		oxylabs.Language(opt.Language)(context)
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity()
@@ -451,12 +721,26 @@ func (c *EcommerceClient) ScrapeAmazonPricingCtx(
 		"render":          opt.Render,
 		"callback_url":    opt.CallbackUrl,
 		"parse":           opt.Parse,
+		"context": []map[string]interface{}{
+			{
+				"key":   "delivery_zip",
+				"value": context["delivery_zip"],
+			},
+			{
+				"key":   "currency",
+				"value": context["currency"],
+			},
+			{
+				"key":   "language",
+				"value": context["language"],
+			},
+		},
 	}
 
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
+		payload["parsing_instructions"] = opt.ParseInstructions
 		customParserFlag = true
 	}
 
@@ -465,26 +749,22 @@ func (c *EcommerceClient) ScrapeAmazonPricingCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // AmazonReviewsOpts contains all the query parameters available for amazon_reviews.
 type AmazonReviewsOpts struct {
 	Domain            oxylabs.Domain
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	StartPage         int
 	Pages             int
@@ -493,29 +773,54 @@ type AmazonReviewsOpts struct {
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *AmazonReviewsOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "AmazonReviewsOpts",
+		Domain:            &opt.Domain,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeAmazonReviews parameters.
 func (opt *AmazonReviewsOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeAmazonReviews scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
@@ -523,7 +828,7 @@ func (c *EcommerceClient) ScrapeAmazonReviews(
 	query string,
 	opts ...*AmazonReviewsOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeAmazonReviewsCtx(ctx, query, opts...)
@@ -536,17 +841,31 @@ func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
 	query string,
 	opts ...*AmazonReviewsOpts,
 ) (*Resp, error) {
+	req, err := prepareAmazonReviews(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareAmazonReviews applies the defaults to the opts of a scrape with amazon_reviews as source,
+// checks them and returns the req.
+func prepareAmazonReviews(
+	c *internal.Client,
+	query string,
+	opts []*AmazonReviewsOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &AmazonReviewsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity()
@@ -571,7 +890,7 @@ func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
+		payload["parsing_instructions"] = opt.ParseInstructions
 		customParserFlag = true
 	}
 
@@ -580,51 +899,70 @@ func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // AmazonQuestionsOpts contains all the query parameters available for amazon_questions.
 type AmazonQuestionsOpts struct {
 	Domain            oxylabs.Domain
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *AmazonQuestionsOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "AmazonQuestionsOpts",
+		Domain:            &opt.Domain,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeAmazonQuestions parameters.
 func (opt *AmazonQuestionsOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeAmazonQuestions scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
@@ -632,7 +970,7 @@ func (c *EcommerceClient) ScrapeAmazonQuestions(
 	query string,
 	opts ...*AmazonQuestionsOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeAmazonQuestionsCtx(ctx, query, opts...)
@@ -645,15 +983,31 @@ func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
 	query string,
 	opts ...*AmazonQuestionsOpts,
 ) (*Resp, error) {
+	req, err := prepareAmazonQuestions(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareAmazonQuestions applies the defaults to the opts of a scrape with amazon_questions as source,
+// checks them and returns the req.
+func prepareAmazonQuestions(
+	c *internal.Client,
+	query string,
+	opts []*AmazonQuestionsOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &AmazonQuestionsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity()
@@ -676,7 +1030,7 @@ func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
+		payload["parsing_instructions"] = opt.ParseInstructions
 		customParserFlag = true
 	}
 
@@ -685,57 +1039,92 @@ func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // AmazonBestsellersOpts contains all the query parameters available for amazon_bestsellers.
+// The query is the browse node ID or the department name, and the category_id
+// context option narrows the list to a sub-category of the browse node.
 type AmazonBestsellersOpts struct {
 	Domain            oxylabs.Domain
 	StartPage         int
 	Pages             int
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
+	Currency          string
+	Language          string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
+	Context           []func(oxylabs.ContextOption)
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *AmazonBestsellersOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "AmazonBestsellersOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		Context:           &opt.Context,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeAmazonBestsellers parameters.
 func (opt *AmazonBestsellersOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
+	if opt.Currency != "" && !oxylabs.IsCurrencyValid(opt.Currency) {
+		errs = append(errs, fmt.Errorf("invalid currency parameter: %v", opt.Currency))
+	}
+
+	if opt.Language != "" && !oxylabs.IsLanguageValid(opt.Language) {
+		errs = append(errs, fmt.Errorf("invalid language parameter: %v", opt.Language))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeAmazonBestsellers scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
@@ -743,7 +1132,7 @@ func (c *EcommerceClient) ScrapeAmazonBestsellers(
 	query string,
 	opts ...*AmazonBestsellersOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeAmazonBestsellersCtx(ctx, query, opts...)
@@ -756,17 +1145,45 @@ func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
 	query string,
 	opts ...*AmazonBestsellersOpts,
 ) (*Resp, error) {
+	req, err := prepareAmazonBestsellers(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareAmazonBestsellers applies the defaults to the opts of a scrape with amazon_bestsellers as source,
+// checks them and returns the req.
+func prepareAmazonBestsellers(
+	c *internal.Client,
+	query string,
+	opts []*AmazonBestsellersOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &AmazonBestsellersOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Initialize the context map and apply each provided context modifier function.
+	context := make(oxylabs.ContextOption)
+	for _, modifier := range opt.Context {
+		modifier(context)
+	}
+
+	// Typed context fields take precedence over the context modifiers.
+	if opt.Currency != "" {
+		oxylabs.Currency(opt.Currency)(context)
+	}
+	if opt.Language != "" {
+		oxylabs.Language(opt.Language)(context)
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity()
@@ -786,12 +1203,26 @@ func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
 		"render":          opt.Render,
 		"callback_url":    opt.CallbackUrl,
 		"parse":           opt.Parse,
+		"context": []map[string]interface{}{
+			{
+				"key":   "category_id",
+				"value": context["category_id"],
+			},
+			{
+				"key":   "currency",
+				"value": context["currency"],
+			},
+			{
+				"key":   "language",
+				"value": context["language"],
+			},
+		},
 	}
 
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
+		payload["parsing_instructions"] = opt.ParseInstructions
 		customParserFlag = true
 	}
 
@@ -800,51 +1231,70 @@ func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // AmazonSellersOpts contains all the query parameters available for amazon_seller.
 type AmazonSellersOpts struct {
 	Domain            oxylabs.Domain
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *AmazonSellersOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "AmazonSellersOpts",
+		Domain:            &opt.Domain,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeAmazonSeller parameters.
 func (opt *AmazonSellersOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeAmazonSellers scrapes amazon via Oxylabs E-Commerce API with amazon_seller as source.
@@ -852,7 +1302,7 @@ func (c *EcommerceClient) ScrapeAmazonSellers(
 	query string,
 	opts ...*AmazonSellersOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeAmazonSellersCtx(ctx, query, opts...)
@@ -865,15 +1315,31 @@ func (c *EcommerceClient) ScrapeAmazonSellersCtx(
 	query string,
 	opts ...*AmazonSellersOpts,
 ) (*Resp, error) {
+	req, err := prepareAmazonSellers(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareAmazonSellers applies the defaults to the opts of a scrape with amazon_sellers as source,
+// checks them and returns the req.
+func prepareAmazonSellers(
+	c *internal.Client,
+	query string,
+	opts []*AmazonSellersOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &AmazonSellersOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity()
@@ -896,7 +1362,7 @@ func (c *EcommerceClient) ScrapeAmazonSellersCtx(
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
+		payload["parsing_instructions"] = opt.ParseInstructions
 		customParserFlag = true
 	}
 
@@ -905,18 +1371,14 @@ func (c *EcommerceClient) ScrapeAmazonSellersCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
@@ -2,23 +2,19 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
-	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // ScrapeAmazonUrl scrapes amazon via Oxylabs E-Commerce API with amazon as source.
 func (c *EcommerceClientAsync) ScrapeAmazonUrl(
 	url string,
 	opts ...*AmazonUrlOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeAmazonUrlCtx(ctx, url, opts...)
 
-	return c.ScrapeAmazonUrlCtx(ctx, url, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeAmazonUrlCtx scrapes amazon via Oxylabs E-Commerce API with amazon as source.
@@ -27,101 +23,48 @@ func (c *EcommerceClientAsync) ScrapeAmazonUrlCtx(
 	ctx context.Context,
 	url string,
 	opts ...*AmazonUrlOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	/// Check validity of url.
-	err := internal.ValidateUrl(url, "amazon")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &AmazonUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
+) (*AsyncResult, error) {
+	job, err := c.submitAmazonUrl(ctx, url, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	//Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitAmazonUrl submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitAmazonUrl(
+	ctx context.Context,
+	url string,
+	opts ...*AmazonUrlOpts,
+) (*asyncJob, error) {
+	req, err := prepareAmazonUrl(c.C, url, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitAmazonUrl submits a job via Oxylabs E-Commerce API with amazon as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitAmazonUrl(
+	ctx context.Context,
+	url string,
+	opts ...*AmazonUrlOpts,
+) (string, error) {
+	return c.submitOnly(c.submitAmazonUrl(ctx, url, opts...))
 }
 
 // ScrapeAmazonSearch scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
 func (c *EcommerceClientAsync) ScrapeAmazonSearch(
 	query string,
 	opts ...*AmazonSearchOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeAmazonSearchCtx(ctx, query, opts...)
 
-	return c.ScrapeAmazonSearchCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeAmazonSearchCtx scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
@@ -130,118 +73,48 @@ func (c *EcommerceClientAsync) ScrapeAmazonSearchCtx(
 	ctx context.Context,
 	query string,
 	opts ...*AmazonSearchOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonSearch,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "category_id",
-				"value": context["category_id"],
-			},
-			{
-				"key":   "merchant_id",
-				"value": context["merchant_id"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitAmazonSearch(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitAmazonSearch submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitAmazonSearch(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSearchOpts,
+) (*asyncJob, error) {
+	req, err := prepareAmazonSearch(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitAmazonSearch submits a job via Oxylabs E-Commerce API with amazon_search as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitAmazonSearch(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSearchOpts,
+) (string, error) {
+	return c.submitOnly(c.submitAmazonSearch(ctx, query, opts...))
 }
 
 // ScrapeAmazonProduct scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
 func (c *EcommerceClientAsync) ScrapeAmazonProduct(
 	query string,
 	opts ...*AmazonProductOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeAmazonProductCtx(ctx, query, opts...)
 
-	return c.ScrapeAmazonProductCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeAmazonProductCtx scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
@@ -250,110 +123,48 @@ func (c *EcommerceClientAsync) ScrapeAmazonProductCtx(
 	ctx context.Context,
 	query string,
 	opts ...*AmazonProductOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonProductOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonProduct,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "autoselect_variant",
-				"value": context["autoselect_variant"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitAmazonProduct(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitAmazonProduct submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitAmazonProduct(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonProductOpts,
+) (*asyncJob, error) {
+	req, err := prepareAmazonProduct(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitAmazonProduct submits a job via Oxylabs E-Commerce API with amazon_product as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitAmazonProduct(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonProductOpts,
+) (string, error) {
+	return c.submitOnly(c.submitAmazonProduct(ctx, query, opts...))
 }
 
 // ScrapeAmazonPricing scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
 func (c *EcommerceClientAsync) ScrapeAmazonPricing(
 	query string,
 	opts ...*AmazonPricingOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeAmazonPricingCtx(ctx, query, opts...)
 
-	return c.ScrapeAmazonPricingCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeAmazonPricingCtx scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
@@ -362,102 +173,48 @@ func (c *EcommerceClientAsync) ScrapeAmazonPricingCtx(
 	ctx context.Context,
 	query string,
 	opts ...*AmazonPricingOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonPricingOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonPricing,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitAmazonPricing(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitAmazonPricing submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitAmazonPricing(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonPricingOpts,
+) (*asyncJob, error) {
+	req, err := prepareAmazonPricing(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitAmazonPricing submits a job via Oxylabs E-Commerce API with amazon_pricing as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitAmazonPricing(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonPricingOpts,
+) (string, error) {
+	return c.submitOnly(c.submitAmazonPricing(ctx, query, opts...))
 }
 
 // ScrapeAmazonReviews scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
 func (c *EcommerceClientAsync) ScrapeAmazonReviews(
 	query string,
 	opts ...*AmazonReviewsOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeAmazonReviewsCtx(ctx, query, opts...)
 
-	return c.ScrapeAmazonReviewsCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeAmazonReviewsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
@@ -466,102 +223,48 @@ func (c *EcommerceClientAsync) ScrapeAmazonReviewsCtx(
 	ctx context.Context,
 	query string,
 	opts ...*AmazonReviewsOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonReviewsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonReviews,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitAmazonReviews(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitAmazonReviews submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitAmazonReviews(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonReviewsOpts,
+) (*asyncJob, error) {
+	req, err := prepareAmazonReviews(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitAmazonReviews submits a job via Oxylabs E-Commerce API with amazon_reviews as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitAmazonReviews(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonReviewsOpts,
+) (string, error) {
+	return c.submitOnly(c.submitAmazonReviews(ctx, query, opts...))
 }
 
 // ScrapeAmazonQuestions scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
 func (c *EcommerceClientAsync) ScrapeAmazonQuestions(
 	query string,
 	opts ...*AmazonQuestionsOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeAmazonQuestionsCtx(ctx, query, opts...)
 
-	return c.ScrapeAmazonQuestionsCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeAmazonQuestionsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
@@ -570,98 +273,48 @@ func (c *EcommerceClientAsync) ScrapeAmazonQuestionsCtx(
 	ctx context.Context,
 	query string,
 	opts ...*AmazonQuestionsOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonQuestionsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonQuestions,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitAmazonQuestions(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitAmazonQuestions submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitAmazonQuestions(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) (*asyncJob, error) {
+	req, err := prepareAmazonQuestions(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitAmazonQuestions submits a job via Oxylabs E-Commerce API with amazon_questions as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitAmazonQuestions(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) (string, error) {
+	return c.submitOnly(c.submitAmazonQuestions(ctx, query, opts...))
 }
 
 // ScrapeAmazonBestSellers scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
 func (c *EcommerceClientAsync) ScrapeAmazonBestsellers(
 	query string,
 	opts ...*AmazonBestsellersOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeAmazonBestsellersCtx(ctx, query, opts...)
 
-	return c.ScrapeAmazonBestsellersCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeAmazonBestsellersCtx scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
@@ -670,102 +323,48 @@ func (c *EcommerceClientAsync) ScrapeAmazonBestsellersCtx(
 	ctx context.Context,
 	query string,
 	opts ...*AmazonBestsellersOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonBestsellersOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonBestsellers,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitAmazonBestsellers(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitAmazonBestsellers submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitAmazonBestsellers(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) (*asyncJob, error) {
+	req, err := prepareAmazonBestsellers(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitAmazonBestsellers submits a job via Oxylabs E-Commerce API with amazon_bestsellers as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitAmazonBestsellers(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) (string, error) {
+	return c.submitOnly(c.submitAmazonBestsellers(ctx, query, opts...))
 }
 
 // ScrapeAmazonSellers scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source.
 func (c *EcommerceClientAsync) ScrapeAmazonSellers(
 	query string,
 	opts ...*AmazonSellersOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeAmazonSellersCtx(ctx, query, opts...)
 
-	return c.ScrapeAmazonSellersCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeAmazonSellersCtx scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source.
@@ -774,85 +373,35 @@ func (c *EcommerceClientAsync) ScrapeAmazonSellersCtx(
 	ctx context.Context,
 	query string,
 	opts ...*AmazonSellersOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &AmazonSellersOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonSellers,
-		"domain":          opt.Domain,
-		"query":           query,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitAmazonSellers(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitAmazonSellers submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitAmazonSellers(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSellersOpts,
+) (*asyncJob, error) {
+	req, err := prepareAmazonSellers(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitAmazonSellers submits a job via Oxylabs E-Commerce API with amazon_sellers as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitAmazonSellers(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSellersOpts,
+) (string, error) {
+	return c.submitOnly(c.submitAmazonSellers(ctx, query, opts...))
 }
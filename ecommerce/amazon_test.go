@@ -0,0 +1,48 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeAmazonProduct_TypedContext(t *testing.T) {
+	var payload struct {
+		Context []struct {
+			Key   string      `json:"key"`
+			Value interface{} `json:"value"`
+		} `json:"context"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	_, err := c.ScrapeAmazonProduct("B07FZ8S74R", &AmazonProductOpts{
+		DeliveryZip: "10001",
+		Currency:    "EUR",
+		Language:    "es_US",
+		Context:     []func(oxylabs.ContextOption){oxylabs.DeliveryZip("90210")},
+	})
+	assert.NoError(t, err)
+	ctx := map[string]interface{}{}
+	for _, entry := range payload.Context {
+		ctx[entry.Key] = entry.Value
+	}
+	assert.Equal(t, "10001", ctx["delivery_zip"])
+	assert.Equal(t, "EUR", ctx["currency"])
+	assert.Equal(t, "es_US", ctx["language"])
+
+	assert.EqualError(t, (&AmazonProductOpts{DeliveryZip: "SW1A 1AA"}).Validate(),
+		"invalid delivery_zip parameter, expected a US zip code: SW1A 1AA")
+	assert.NoError(t, (&AmazonProductOpts{Domain: oxylabs.DOMAIN_CO_UK, DeliveryZip: "SW1A 1AA"}).Validate())
+	assert.EqualError(t, (&AmazonProductOpts{Currency: "EURO"}).Validate(), "invalid currency parameter: EURO")
+}
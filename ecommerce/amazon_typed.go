@@ -0,0 +1,91 @@
+package ecommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// AmazonBestseller is a ranked entry of the parsed amazon_bestsellers results.
+type AmazonBestseller struct {
+	Pos          int     `json:"pos"`
+	Url          string  `json:"url"`
+	Asin         string  `json:"asin"`
+	Price        float64 `json:"price"`
+	Title        string  `json:"title"`
+	Image        string  `json:"image"`
+	Rating       float64 `json:"rating"`
+	Currency     string  `json:"currency"`
+	IsPrime      bool    `json:"is_prime"`
+	PriceStr     string  `json:"price_str"`
+	PriceUpper   float64 `json:"price_upper"`
+	RatingsCount int     `json:"ratings_count"`
+}
+
+// AmazonSeller is the parsed amazon_sellers seller profile.
+type AmazonSeller struct {
+	Url                  string               `json:"url"`
+	Query                string               `json:"query"`
+	Rating               float64              `json:"rating"`
+	Description          string               `json:"description"`
+	BusinessName         string               `json:"business_name"`
+	BusinessAddress      string               `json:"business_address"`
+	RecentFeedback       []RecentFeedback     `json:"recent_feedback"`
+	FeedbackSummaryTable FeedbackSummaryTable `json:"feedback_summary_table"`
+}
+
+// UnmarshalJSON decodes the results of a parsed page, which are a list of
+// ranked entries for amazon_bestsellers and an object for other sources.
+func (r *Result) UnmarshalJSON(data []byte) error {
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '[' {
+		*r = Result{}
+		return json.Unmarshal(data, &r.Bestsellers)
+	}
+
+	type result Result
+	return json.Unmarshal(data, (*result)(r))
+}
+
+// MarshalJSON encodes the ranked entries as a list, so that a marshalled Resp
+// decodes back into the same Result.
+func (r Result) MarshalJSON() ([]byte, error) {
+	if r.Bestsellers != nil {
+		return json.Marshal(r.Bestsellers)
+	}
+
+	type result Result
+	return json.Marshal(result(r))
+}
+
+// AmazonBestsellers returns the ranked entries of all the parsed pages
+// of an amazon_bestsellers resp.
+func (r *Resp) AmazonBestsellers() []AmazonBestseller {
+	var bestsellers []AmazonBestseller
+	for _, result := range r.Results {
+		bestsellers = append(bestsellers, result.ContentParsed.Results.Bestsellers...)
+	}
+
+	return bestsellers
+}
+
+// AmazonSeller returns the seller profile of a parsed amazon_sellers resp.
+func (r *Resp) AmazonSeller() (*AmazonSeller, error) {
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("resp has no results")
+	}
+	if !r.Parse || r.ParseInstructions {
+		return nil, fmt.Errorf("resp was not parsed with the built-in parser")
+	}
+
+	content := r.Results[0].ContentParsed
+	return &AmazonSeller{
+		Url:                  content.Url,
+		Query:                content.Query,
+		Rating:               content.Rating,
+		Description:          content.Description,
+		BusinessName:         content.BusinessName,
+		BusinessAddress:      content.BusinessAddress,
+		RecentFeedback:       content.RecentFeedback,
+		FeedbackSummaryTable: content.FeedbackSummaryTable,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResp_AmazonBestsellers(t *testing.T) {
+	body := `{"results": [{"content": {"url": "https://www.amazon.com/gp/bestsellers/1000", "page": 1, "results": [
+		{"pos": 1, "asin": "B0001", "title": "First", "price": 9.99, "currency": "USD"},
+		{"pos": 2, "asin": "B0002", "title": "Second", "price": 19.99, "currency": "USD"}
+	]}, "page": 1, "status_code": 200}]}`
+	httpResp := &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}
+
+	resp, err := DecodeResp(httpResp, oxylabs.DecodeParsed)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Results[0].Error)
+
+	bestsellers := resp.AmazonBestsellers()
+	assert.Len(t, bestsellers, 2)
+	assert.Equal(t, "B0002", bestsellers[1].Asin)
+	assert.Equal(t, 2, bestsellers[1].Pos)
+
+	// The ranked entries survive a round trip of the resp.
+	data, err := json.Marshal(resp)
+	assert.NoError(t, err)
+	reloaded := &Resp{}
+	assert.NoError(t, json.Unmarshal(data, reloaded))
+	assert.Equal(t, bestsellers, reloaded.AmazonBestsellers())
+}
+
+func TestResp_AmazonSeller(t *testing.T) {
+	body := `{"results": [{"content": {"url": "https://www.amazon.com/sp?seller=A1", "rating": 4.5,
+		"business_name": "Example LLC", "recent_feedback": [{"feedback": "Great", "rating_stars": 5}]}, "status_code": 200}]}`
+	httpResp := &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}
+
+	resp, err := DecodeResp(httpResp, oxylabs.DecodeParsed)
+	assert.NoError(t, err)
+
+	seller, err := resp.AmazonSeller()
+	assert.NoError(t, err)
+	assert.Equal(t, "Example LLC", seller.BusinessName)
+	assert.Equal(t, 4.5, seller.Rating)
+	assert.Equal(t, 5, seller.RecentFeedback[0].RatingStars)
+}
@@ -1,11 +1,13 @@
 package ecommerce
 
 import (
-	"net/http"
+	"context"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
+// EcommerceClient is safe for concurrent use by multiple goroutines.
 type EcommerceClient struct {
 	C *internal.Client
 }
@@ -14,19 +16,44 @@ type EcommerceClient struct {
 func Init(
 	username string,
 	password string,
+	opts ...oxylabs.ClientOption,
 ) *EcommerceClient {
 	return &EcommerceClient{
-		C: &internal.Client{
-			BaseUrl: internal.SyncBaseUrl,
-			ApiCredentials: &internal.ApiCredentials{
-				Username: username,
-				Password: password,
-			},
-			HttpClient: &http.Client{},
-		},
+		C: internal.NewClient(internal.SyncBaseUrl, username, password, opts...),
 	}
 }
 
+// NewEcommerceClient returns a client for the Sync runtime model. Unlike Init it
+// returns an error if credentials are missing, or with oxylabs.WithVerifyCredentials
+// if the API rejects them.
+func NewEcommerceClient(
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*EcommerceClient, error) {
+	return NewEcommerceClientCtx(context.Background(), username, password, opts...)
+}
+
+// NewEcommerceClientCtx returns a client for the Sync runtime model.
+// The provided context bounds the verification of the credentials.
+func NewEcommerceClientCtx(
+	ctx context.Context,
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*EcommerceClient, error) {
+	c, err := internal.NewClientCtx(ctx, internal.SyncBaseUrl, username, password, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EcommerceClient{C: c}, nil
+}
+
+// EcommerceClientAsync is safe for concurrent use by multiple goroutines.
+// Its scrape methods return an error if the job could not be submitted, or an
+// AsyncResult whose jobs are polled in the background until their Results
+// channel receives exactly one resp or Err reports why it failed.
 type EcommerceClientAsync struct {
 	C *internal.Client
 }
@@ -35,15 +62,110 @@ type EcommerceClientAsync struct {
 func InitAsync(
 	username string,
 	password string,
+	opts ...oxylabs.ClientOption,
 ) *EcommerceClientAsync {
 	return &EcommerceClientAsync{
-		C: &internal.Client{
-			BaseUrl: internal.AsyncBaseUrl,
-			ApiCredentials: &internal.ApiCredentials{
-				Username: username,
-				Password: password,
-			},
-			HttpClient: &http.Client{},
-		},
+		C: internal.NewClient(internal.AsyncBaseUrl, username, password, opts...),
+	}
+}
+
+// NewEcommerceClientAsync returns a client for the Async runtime model. Unlike InitAsync
+// it returns an error if credentials are missing, or with oxylabs.WithVerifyCredentials
+// if the API rejects them.
+func NewEcommerceClientAsync(
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*EcommerceClientAsync, error) {
+	return NewEcommerceClientAsyncCtx(context.Background(), username, password, opts...)
+}
+
+// NewEcommerceClientAsyncCtx returns a client for the Async runtime model.
+// The provided context bounds the verification of the credentials.
+func NewEcommerceClientAsyncCtx(
+	ctx context.Context,
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*EcommerceClientAsync, error) {
+	c, err := internal.NewClientCtx(ctx, internal.AsyncBaseUrl, username, password, opts...)
+	if err != nil {
+		return nil, err
 	}
+
+	return &EcommerceClientAsync{C: c}, nil
+}
+
+// Clone returns a copy of the client with the given client options applied,
+// e.g. for per-goroutine defaults. The connection pool is shared with the copy
+// unless the options change the HTTP transport.
+func (c *EcommerceClient) Clone(opts ...oxylabs.ClientOption) *EcommerceClient {
+	return &EcommerceClient{C: c.C.Clone(opts...)}
+}
+
+// Clone returns a copy of the client with the given client options applied,
+// e.g. for per-goroutine defaults. The connection pool is shared with the copy
+// unless the options change the HTTP transport.
+func (c *EcommerceClientAsync) Clone(opts ...oxylabs.ClientOption) *EcommerceClientAsync {
+	return &EcommerceClientAsync{C: c.C.Clone(opts...)}
+}
+
+// SetCredentials replaces the API credentials of the client without
+// recreating it, e.g. after a secret rotation. It is safe for concurrent use.
+func (c *EcommerceClient) SetCredentials(username string, password string) {
+	c.C.SetCredentials(username, password)
+}
+
+// SetCredentials replaces the API credentials of the client without
+// recreating it, e.g. after a secret rotation. It is safe for concurrent use.
+func (c *EcommerceClientAsync) SetCredentials(username string, password string) {
+	c.C.SetCredentials(username, password)
+}
+
+// Close rejects new reqs, waits for in-flight reqs to return and closes idle connections.
+func (c *EcommerceClient) Close() error {
+	return c.C.Close()
+}
+
+// Shutdown rejects new reqs and waits for in-flight reqs to return or ctx to be
+// done, then closes idle connections.
+func (c *EcommerceClient) Shutdown(ctx context.Context) error {
+	return c.C.Shutdown(ctx)
+}
+
+// Close stops issuing new polls, cancels in-flight polling and closes idle connections.
+func (c *EcommerceClientAsync) Close() error {
+	return c.C.Close()
+}
+
+// Shutdown stops issuing new polls and waits for in-flight polling to finish,
+// canceling it once ctx is done, then closes idle connections.
+func (c *EcommerceClientAsync) Shutdown(ctx context.Context) error {
+	return c.C.Shutdown(ctx)
+}
+
+// Ping validates the credentials and the reachability of the API, e.g. at
+// startup or in readiness probes. It costs no credits.
+func (c *EcommerceClient) Ping(ctx context.Context) error {
+	return c.C.Ping(ctx)
+}
+
+// Ping validates the credentials and the reachability of the API, e.g. at
+// startup or in readiness probes. It costs no credits.
+func (c *EcommerceClientAsync) Ping(ctx context.Context) error {
+	return c.C.Ping(ctx)
+}
+
+// Validate checks the query, url or product id and the opts of a scrape,
+// e.g. *AmazonSearchOpts, with the defaults of the client applied, as the
+// scrape would, without making the req.
+func (c *EcommerceClient) Validate(query string, opts interface{}) error {
+	return validate(c.C, query, opts)
+}
+
+// Validate checks the query, url or product id and the opts of a scrape,
+// e.g. *AmazonSearchOpts, with the defaults of the client applied, as the
+// scrape would, without making the req.
+func (c *EcommerceClientAsync) Validate(query string, opts interface{}) error {
+	return validate(c.C, query, opts)
 }
@@ -0,0 +1,65 @@
+package ecommerce
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rewriteTransport sends all reqs, including the polling reqs
+// to data.oxylabs.io, to a test server.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestEcommerceClientAsync_AsyncResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": [{"content": "<html></html>", "job_id": "123", "status_code": 200}]}`))
+		case "/v1/queries/456":
+			w.Write([]byte(`{"id": "456", "status": "faulted"}`))
+		case "/v1/queries/456/results":
+			w.Write([]byte(`{"results": []}`))
+		default:
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := InitAsync("username", "password")
+	c.C.BaseUrl = server.URL + "/v1/queries"
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	// The results channel receives exactly one resp and is then closed.
+	res, err := c.ScrapeAmazonSearch("adidas", &AmazonSearchOpts{PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	resp, ok := <-res.Results()
+	assert.True(t, ok)
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+	_, ok = <-res.Results()
+	assert.False(t, ok)
+	assert.NoError(t, res.Err())
+
+	// A failed scrape closes the results channel without a resp and reports its error.
+	res, err = c.ResumeJob("456", &ResumeJobOpts{PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	_, ok = <-res.Results()
+	assert.False(t, ok)
+	assert.Error(t, res.Err())
+	<-res.Done()
+}
@@ -0,0 +1,87 @@
+package ecommerce
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+// fillOpts sets every field of the opts which is serialized into the payload.
+func fillOpts(opts reflect.Value) {
+	values := map[string]interface{}{
+		"Parse": true,
+		"ParseInstructions": &map[string]interface{}{
+			"title": map[string]interface{}{
+				"_fns": []map[string]interface{}{{"_fn": "xpath_one", "_args": []string{"//h1/text()"}}},
+			},
+		},
+		"CallbackUrl":     "https://example.com/callback",
+		"CallbackURL":     "https://example.com/callback",
+		"GeoLocation":     "10001",
+		"Render":          oxylabs.HTML,
+		"Locale":          "en",
+		"UserAgent":       oxylabs.UA_DESKTOP,
+		"StartPage":       1,
+		"Pages":           2,
+		"Limit":           24,
+		"DeliveryZip":     "10001",
+		"Currency":        "USD",
+		"Language":        "en",
+		"ResultsLanguage": "en",
+		"StoreId":         "01400943",
+		"FulfillmentType": KrogerPickup,
+	}
+	for name, value := range values {
+		if field := opts.FieldByName(name); field.IsValid() {
+			field.Set(reflect.ValueOf(value).Convert(field.Type()))
+		}
+	}
+}
+
+func TestEcommerceClient_ScrapeOptsPassSourceParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": [{"content": {}, "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	urls := map[string]string{
+		"ScrapeAmazonUrlCtx":         "https://www.amazon.com/dp/B07FZ8S74R",
+		"ScrapeGoogleShoppingUrlCtx": "https://shopping.google.com/search?q=adidas",
+		"ScrapeKrogerUrlCtx":         "https://www.kroger.com/p/adidas/0001",
+		"ScrapeTargetUrlCtx":         "https://www.target.com/p/adidas/-/A-1",
+		"ScrapeUniversalUrlCtx":      "https://example.com",
+		"ScrapeWayfairUrlCtx":        "https://www.wayfair.com/adidas.html",
+	}
+
+	client := reflect.ValueOf(c)
+	for i := 0; i < client.NumMethod(); i++ {
+		method := client.Type().Method(i)
+		if !strings.HasPrefix(method.Name, "Scrape") || !strings.HasSuffix(method.Name, "Ctx") ||
+			method.Type.NumIn() != 4 || !method.Type.IsVariadic() {
+			continue
+		}
+		t.Run(method.Name, func(t *testing.T) {
+			opts := reflect.New(method.Type.In(3).Elem().Elem())
+			fillOpts(opts.Elem())
+
+			query := "B07FZ8S74R"
+			if url, ok := urls[method.Name]; ok {
+				query = url
+			}
+			out := client.Method(i).Call([]reflect.Value{
+				reflect.ValueOf(context.Background()), reflect.ValueOf(query), opts,
+			})
+			err, _ := out[1].Interface().(error)
+			assert.NoError(t, err)
+		})
+	}
+}
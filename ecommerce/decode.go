@@ -0,0 +1,42 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DecodeContent decodes the content of the first result into v,
+// which should be a pointer to a caller-provided struct.
+func (r *Resp) DecodeContent(v interface{}) error {
+	if len(r.Results) == 0 {
+		return fmt.Errorf("resp has no results")
+	}
+
+	return r.Results[0].DecodeContent(v)
+}
+
+// DecodeContent decodes the content of the result into v,
+// which should be a pointer to a caller-provided struct.
+// Custom parsed content is preferred, then raw content holding JSON,
+// and finally the content parsed by the built-in parser.
+func (r *Results) DecodeContent(v interface{}) error {
+	var data []byte
+	var err error
+	switch {
+	case r.CustomContentParsed != nil:
+		data, err = json.Marshal(r.CustomContentParsed)
+	case r.Content != "":
+		data = []byte(r.Content)
+	default:
+		data, err = json.Marshal(r.ContentParsed)
+	}
+	if err != nil {
+		return fmt.Errorf("error marshalling content: %v", err)
+	}
+
+	if err = json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding content: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package ecommerce
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Engine is a search engine without a dedicated source,
+// scraped via the universal source.
+type Engine string
+
+const (
+	DuckDuckGo Engine = "duckduckgo"
+	Ecosia     Engine = "ecosia"
+)
+
+// Results per DuckDuckGo page, used to compute the offset of a page.
+const duckDuckGoPageSize = 30
+
+// EngineSearchOpts contains the query parameters available for engine presets.
+type EngineSearchOpts struct {
+	// Page is the page of results to scrape, starting at 1.
+	Page int
+	// Region is the region code of the engine, e.g. us-en for DuckDuckGo.
+	// If empty it is derived from a country code GeoLocation and Locale.
+	Region      string
+	GeoLocation oxylabs.GeoLocation
+	Locale      oxylabs.Locale
+	UserAgent   oxylabs.UserAgent
+	Render      oxylabs.Render
+	CallbackUrl string
+	// Parse applies the preset parse instructions of the engine,
+	// unless ParseInstructions is set.
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *EngineSearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "EngineSearchOpts",
+		GeoLocation:       &opt.GeoLocation,
+		Locale:            &opt.Locale,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// EngineUrl returns the search url of the query on the engine.
+func EngineUrl(engine Engine, query string, opt *EngineSearchOpts) (string, error) {
+	if opt == nil {
+		opt = &EngineSearchOpts{}
+	}
+	if opt.Page < 0 {
+		return "", fmt.Errorf("invalid page parameter: %v", opt.Page)
+	}
+
+	params := url.Values{"q": {query}}
+	page := max(opt.Page, 1)
+
+	switch engine {
+	case DuckDuckGo:
+		if region := engineRegion(opt); region != "" {
+			params.Set("kl", region)
+		}
+		if page > 1 {
+			params.Set("s", strconv.Itoa((page-1)*duckDuckGoPageSize))
+		}
+		return "https://html.duckduckgo.com/html/?" + params.Encode(), nil
+	case Ecosia:
+		if page > 1 {
+			params.Set("p", strconv.Itoa(page-1))
+		}
+		return "https://www.ecosia.org/search?" + params.Encode(), nil
+	default:
+		return "", fmt.Errorf("invalid engine: %v", engine)
+	}
+}
+
+// engineRegion returns the region, or derives it from the geo location
+// country code and the locale, e.g. de-de for DE and de.
+func engineRegion(opt *EngineSearchOpts) string {
+	if opt.Region != "" {
+		return opt.Region
+	}
+	if !oxylabs.IsCountryCodeValid(string(opt.GeoLocation)) {
+		return ""
+	}
+
+	lang := "en"
+	if opt.Locale != "" {
+		lang = strings.SplitN(string(opt.Locale), "-", 2)[0]
+	}
+
+	return strings.ToLower(string(opt.GeoLocation)) + "-" + strings.ToLower(lang)
+}
+
+// EngineParseInstructions returns the preset parse instructions of the engine,
+// which extract the organic results. Presets follow the markup of the engine
+// at the time of writing, so pass your own instructions if it changes.
+func EngineParseInstructions(engine Engine) (*map[string]interface{}, error) {
+	var results, title, link, desc string
+	switch engine {
+	case DuckDuckGo:
+		results = "//div[contains(@class, 'result__body')]"
+		title = ".//a[contains(@class, 'result__a')]//text()"
+		link = ".//a[contains(@class, 'result__a')]/@href"
+		desc = ".//a[contains(@class, 'result__snippet')]//text()"
+	case Ecosia:
+		results = "//article[@data-test-id='organic-result']"
+		title = ".//a[@data-test-id='result-link']//text()"
+		link = ".//a[@data-test-id='result-link']/@href"
+		desc = ".//p[@data-test-id='web-result-description']//text()"
+	default:
+		return nil, fmt.Errorf("invalid engine: %v", engine)
+	}
+
+	fn := func(name oxylabs.FnName, xpath string) map[string]interface{} {
+		return map[string]interface{}{
+			"_fns": []oxylabs.Fn{{Name: name, Args: []string{xpath}}},
+		}
+	}
+	items := fn(oxylabs.Xpath, results)
+	items["_items"] = map[string]interface{}{
+		"title": fn(oxylabs.XpathOne, title),
+		"url":   fn(oxylabs.XpathOne, link),
+		"desc":  fn(oxylabs.XpathOne, desc),
+	}
+
+	return &map[string]interface{}{"results": items}, nil
+}
+
+// universalOpts returns the universal source options of an engine search.
+func (opt *EngineSearchOpts) universalOpts(engine Engine, query string) (string, *UniversalUrlOpts, error) {
+	url, err := EngineUrl(engine, query, opt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	universalOpt := &UniversalUrlOpts{
+		UserAgent:         opt.UserAgent,
+		GeoLocation:       opt.GeoLocation,
+		Locale:            opt.Locale,
+		Render:            opt.Render,
+		CallbackUrl:       opt.CallbackUrl,
+		Parse:             opt.Parse || opt.ParseInstructions != nil,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
+		Meta:              opt.Meta,
+	}
+	if opt.Parse && opt.ParseInstructions == nil {
+		if universalOpt.ParseInstructions, err = EngineParseInstructions(engine); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return url, universalOpt, nil
+}
+
+// engineOpts returns the last of opts, or empty opts.
+func engineOpts(opts []*EngineSearchOpts) *EngineSearchOpts {
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		return opts[len(opts)-1]
+	}
+
+	return &EngineSearchOpts{}
+}
+
+// ScrapeEngineSearch scrapes a search engine without a dedicated source
+// via Oxylabs E-Commerce API with universal_ecommerce as source.
+func (c *EcommerceClient) ScrapeEngineSearch(
+	engine Engine,
+	query string,
+	opts ...*EngineSearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeEngineSearchCtx(ctx, engine, query, opts...)
+}
+
+// ScrapeEngineSearchCtx scrapes a search engine without a dedicated source
+// via Oxylabs E-Commerce API with universal_ecommerce as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeEngineSearchCtx(
+	ctx context.Context,
+	engine Engine,
+	query string,
+	opts ...*EngineSearchOpts,
+) (*Resp, error) {
+	url, opt, err := engineOpts(opts).universalOpts(engine, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ScrapeUniversalUrlCtx(ctx, url, opt)
+}
+
+// ScrapeEngineSearch scrapes a search engine without a dedicated source with async
+// polling runtime via Oxylabs E-Commerce API and universal_ecommerce as source.
+func (c *EcommerceClientAsync) ScrapeEngineSearch(
+	engine Engine,
+	query string,
+	opts ...*EngineSearchOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeEngineSearchCtx(ctx, engine, query, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeEngineSearchCtx scrapes a search engine without a dedicated source with async
+// polling runtime via Oxylabs E-Commerce API and universal_ecommerce as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeEngineSearchCtx(
+	ctx context.Context,
+	engine Engine,
+	query string,
+	opts ...*EngineSearchOpts,
+) (*AsyncResult, error) {
+	url, opt, err := engineOpts(opts).universalOpts(engine, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ScrapeUniversalUrlCtx(ctx, url, opt)
+}
@@ -0,0 +1,53 @@
+package ecommerce
+
+import (
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineUrl(t *testing.T) {
+	tests := []struct {
+		name   string
+		engine Engine
+		opt    *EngineSearchOpts
+		want   string
+	}{
+		{
+			name:   "duckduckgo",
+			engine: DuckDuckGo,
+			want:   "https://html.duckduckgo.com/html/?q=running+shoes",
+		},
+		{
+			name:   "duckduckgo region from geo location",
+			engine: DuckDuckGo,
+			opt:    &EngineSearchOpts{Page: 2, GeoLocation: "DE", Locale: "de"},
+			want:   "https://html.duckduckgo.com/html/?kl=de-de&q=running+shoes&s=30",
+		},
+		{
+			name:   "ecosia",
+			engine: Ecosia,
+			opt:    &EngineSearchOpts{Page: 3},
+			want:   "https://www.ecosia.org/search?p=2&q=running+shoes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, err := EngineUrl(tt.engine, "running shoes", tt.opt)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, url)
+		})
+	}
+
+	_, err := EngineUrl("altavista", "running shoes", nil)
+	assert.Error(t, err)
+}
+
+func TestEngineParseInstructions(t *testing.T) {
+	for _, engine := range []Engine{DuckDuckGo, Ecosia} {
+		instructions, err := EngineParseInstructions(engine)
+		assert.NoError(t, err)
+		assert.NoError(t, oxylabs.ValidateParseInstructions(instructions))
+	}
+}
@@ -0,0 +1,281 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// EtsySearchOpts contains all the query parameters available for etsy_search.
+type EtsySearchOpts struct {
+	StartPage         int
+	Pages             int
+	UserAgent         oxylabs.UserAgent
+	CallbackUrl       string
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *EtsySearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "EtsySearchOpts",
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// checkParameterValidity checks validity of ScrapeEtsySearch parameters.
+func (opt *EtsySearchOpts) checkParametersValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.Pages <= 0 || opt.StartPage <= 0 {
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ScrapeEtsySearch scrapes etsy via Oxylabs E-Commerce API with etsy_search as source.
+func (c *EcommerceClient) ScrapeEtsySearch(
+	query string,
+	opts ...*EtsySearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeEtsySearchCtx(ctx, query, opts...)
+}
+
+// ScrapeEtsySearchCtx scrapes etsy via Oxylabs E-Commerce API with etsy_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeEtsySearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*EtsySearchOpts,
+) (*Resp, error) {
+	req, err := prepareEtsySearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareEtsySearch applies the defaults to the opts of a scrape with etsy_search as source,
+// checks them and returns the req.
+func prepareEtsySearch(
+	c *internal.Client,
+	query string,
+	opts []*EtsySearchOpts,
+) (*scrapeReq, error) {
+	// Prepare options.
+	opt := &EtsySearchOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check validity of parameters.
+	err = opt.checkParametersValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":          oxylabs.EtsySearch,
+		"query":           query,
+		"start_page":      opt.StartPage,
+		"pages":           opt.Pages,
+		"user_agent_type": opt.UserAgent,
+		"callback_url":    opt.CallbackUrl,
+	}
+
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
+	customParserFlag := false
+	if opt.ParseInstructions != nil {
+		payload["parse"] = true
+		payload["parsing_instructions"] = &opt.ParseInstructions
+		customParserFlag = true
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+	if err := internal.CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
+}
+
+// EtsyProductOpts contains all the query parameters available for etsy_product.
+type EtsyProductOpts struct {
+	UserAgent         oxylabs.UserAgent
+	CallbackUrl       string
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *EtsyProductOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "EtsyProductOpts",
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// checkParameterValidity checks validity of ScrapeEtsyProduct parameters.
+func (opt *EtsyProductOpts) checkParametersValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ScrapeEtsyProduct scrapes etsy via Oxylabs E-Commerce API with etsy_product as source.
+func (c *EcommerceClient) ScrapeEtsyProduct(
+	productId string,
+	opts ...*EtsyProductOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeEtsyProductCtx(ctx, productId, opts...)
+}
+
+// ScrapeEtsyProductCtx scrapes etsy via Oxylabs E-Commerce API with etsy_product as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeEtsyProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*EtsyProductOpts,
+) (*Resp, error) {
+	req, err := prepareEtsyProduct(c.C, productId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareEtsyProduct applies the defaults to the opts of a scrape with etsy_product as source,
+// checks them and returns the req.
+func prepareEtsyProduct(
+	c *internal.Client,
+	productId string,
+	opts []*EtsyProductOpts,
+) (*scrapeReq, error) {
+	// Check validity of product id.
+	if err := c.CheckProductId(productId); err != nil {
+		return nil, err
+	}
+
+	// Prepare options.
+	opt := &EtsyProductOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Check validity of parameters.
+	err := opt.checkParametersValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":          oxylabs.EtsyProduct,
+		"query":           productId,
+		"user_agent_type": opt.UserAgent,
+		"callback_url":    opt.CallbackUrl,
+	}
+
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
+	customParserFlag := false
+	if opt.ParseInstructions != nil {
+		payload["parse"] = true
+		payload["parsing_instructions"] = &opt.ParseInstructions
+		customParserFlag = true
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+	if err := internal.CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
+}
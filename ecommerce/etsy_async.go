@@ -0,0 +1,111 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+)
+
+// ScrapeEtsySearch scrapes etsy with async polling runtime via Oxylabs E-Commerce API
+// and etsy_search as source.
+func (c *EcommerceClientAsync) ScrapeEtsySearch(
+	query string,
+	opts ...*EtsySearchOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeEtsySearchCtx(ctx, query, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeEtsySearchCtx scrapes etsy with async polling runtime via Oxylabs E-Commerce API
+// and etsy_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeEtsySearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*EtsySearchOpts,
+) (*AsyncResult, error) {
+	job, err := c.submitEtsySearch(ctx, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.poll(ctx, job), nil
+}
+
+// submitEtsySearch submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitEtsySearch(
+	ctx context.Context,
+	query string,
+	opts ...*EtsySearchOpts,
+) (*asyncJob, error) {
+	req, err := prepareEtsySearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req)
+}
+
+// SubmitEtsySearch submits a job via Oxylabs E-Commerce API with etsy_search as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitEtsySearch(
+	ctx context.Context,
+	query string,
+	opts ...*EtsySearchOpts,
+) (string, error) {
+	return c.submitOnly(c.submitEtsySearch(ctx, query, opts...))
+}
+
+// ScrapeEtsyProduct scrapes etsy with async polling runtime via Oxylabs E-Commerce API
+// and etsy_product as source.
+func (c *EcommerceClientAsync) ScrapeEtsyProduct(
+	productId string,
+	opts ...*EtsyProductOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeEtsyProductCtx(ctx, productId, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeEtsyProductCtx scrapes etsy with async polling runtime via Oxylabs E-Commerce API
+// and etsy_product as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeEtsyProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*EtsyProductOpts,
+) (*AsyncResult, error) {
+	job, err := c.submitEtsyProduct(ctx, productId, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.poll(ctx, job), nil
+}
+
+// submitEtsyProduct submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitEtsyProduct(
+	ctx context.Context,
+	productId string,
+	opts ...*EtsyProductOpts,
+) (*asyncJob, error) {
+	req, err := prepareEtsyProduct(c.C, productId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req)
+}
+
+// SubmitEtsyProduct submits a job via Oxylabs E-Commerce API with etsy_product as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitEtsyProduct(
+	ctx context.Context,
+	productId string,
+	opts ...*EtsyProductOpts,
+) (string, error) {
+	return c.submitOnly(c.submitEtsyProduct(ctx, productId, opts...))
+}
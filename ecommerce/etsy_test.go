@@ -0,0 +1,63 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeEtsyProduct(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": {"url": "https://www.etsy.com/listing/1234567890", "title": "Mug",
+			"rating": 4.8, "parse_status_code": 12000}, "status_code": 200}], "job": {"id": "1", "source": "etsy_product"}}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	resp, err := c.ScrapeEtsyProduct("1234567890", &EtsyProductOpts{Parse: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "etsy_product", payload["source"])
+	assert.Equal(t, "1234567890", payload["query"])
+	assert.Equal(t, true, payload["parse"])
+
+	content := resp.Results[0].ContentParsed
+	assert.Equal(t, "Mug", content.Title)
+	assert.Equal(t, 4.8, content.Rating)
+
+	// A custom parser decodes into the custom parsed content.
+	resp, err = c.ScrapeEtsyProduct("1234567890", &EtsyProductOpts{
+		ParseInstructions: &map[string]interface{}{"title": map[string]interface{}{
+			"_fns": []map[string]interface{}{{"_fn": "xpath_one", "_args": []string{"//h1/text()"}}},
+		}},
+	})
+	if assert.NoError(t, err) {
+		assert.NotNil(t, payload["parsing_instructions"])
+		assert.Equal(t, "Mug", resp.Results[0].CustomContentParsed["title"])
+	}
+}
+
+func TestScrapeEtsySearch(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	resp, err := c.ScrapeEtsySearch("mug", &EtsySearchOpts{Pages: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "etsy_search", payload["source"])
+	assert.Equal(t, "mug", payload["query"])
+	assert.EqualValues(t, 2, payload["pages"])
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+}
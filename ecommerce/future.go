@@ -0,0 +1,21 @@
+package ecommerce
+
+import "github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+
+// AsyncResult is the result of an async scrape, received from its Results channel.
+type AsyncResult = oxylabs.AsyncResult[*Resp]
+
+// Future is the result of a scrape, common to the realtime and async clients.
+type Future = oxylabs.Future[*Resp]
+
+// FromResp returns the Future of a realtime scrape, e.g.
+// FromResp(c.ScrapeAmazonSearch("adidas")).
+func FromResp(resp *Resp, err error) *Future {
+	return oxylabs.ResolvedFuture(resp, err)
+}
+
+// FromAsync returns the Future of an async scrape, e.g.
+// FromAsync(c.ScrapeAmazonSearch("adidas")).
+func FromAsync(res *AsyncResult, err error) *Future {
+	return oxylabs.AsyncFuture(res, err)
+}
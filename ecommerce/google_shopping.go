@@ -3,6 +3,7 @@ package ecommerce
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,29 +24,51 @@ type GoogleShoppingUrlOpts struct {
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleShoppingUrlOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleShoppingUrlOpts",
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		GeoLocation:       &opt.GeoLocation,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleShoppingUrl parameters.
 func (opt *GoogleShoppingUrlOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeGoogleShoppingUrl scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source.
@@ -53,7 +76,7 @@ func (c *EcommerceClient) ScrapeGoogleShoppingUrl(
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
@@ -66,8 +89,23 @@ func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleShoppingUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleShoppingUrl applies the defaults to the opts of a scrape with google_shopping as source,
+// checks them and returns the req.
+func prepareGoogleShoppingUrl(
+	c *internal.Client,
+	url string,
+	opts []*GoogleShoppingUrlOpts,
+) (*scrapeReq, error) {
 	// Check validity of url.
-	err := internal.ValidateUrl(url, "shopping.google")
+	err := c.CheckUrl(url, "shopping.google")
 	if err != nil {
 		return nil, err
 	}
@@ -75,11 +113,13 @@ func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
 	// Prepare options.
 	opt := &GoogleShoppingUrlOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err = opt.checkParameterValidity()
@@ -110,20 +150,16 @@ func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleShoppingSearchOpts contains all the query parameters available for google shopping search.
@@ -133,46 +169,74 @@ type GoogleShoppingSearchOpts struct {
 	Pages             int
 	Locale            oxylabs.Locale
 	ResultsLanguage   string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackURL       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
 	Context           []func(oxylabs.ContextOption)
 }
 
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleShoppingSearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleShoppingSearchOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Locale:            &opt.Locale,
+		ResultsLanguage:   &opt.ResultsLanguage,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackURL,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+		Context:           &opt.Context,
+	}
+}
+
 // checkParameterValidity checks validity of ScrapeGoogleShoppingSearch parameters.
 func (opt *GoogleShoppingSearchOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if ctx["sort_by"] != nil && !internal.InList(ctx["sort_by"].(string), AcceptedSortByParameters) {
-		return fmt.Errorf("invalid sort_by parameter: %v", ctx["sort_by"])
+		errs = append(errs, fmt.Errorf("invalid sort_by parameter: %v", ctx["sort_by"]))
 	}
 
 	if (ctx["min_price"] != nil || ctx["max_price"] != nil) &&
 		(ctx["min_price"].(int) < 0 || ctx["max_price"].(int) < 0) {
-		return fmt.Errorf("min and max prices should be greater than 0")
+		errs = append(errs, fmt.Errorf("min and max prices should be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeGoogleShoppingSearch scrapes google shopping via Oxylabs E-Commerce API
@@ -181,7 +245,7 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearch(
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
@@ -195,10 +259,27 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleShoppingSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleShoppingSearch applies the defaults to the opts of a scrape with google_shopping_search as source,
+// checks them and returns the req.
+func prepareGoogleShoppingSearch(
+	c *internal.Client,
+	query string,
+	opts []*GoogleShoppingSearchOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleShoppingSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map and apply each provided context modifier function.
@@ -208,14 +289,17 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
 	}
 
 	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 	internal.SetDefaultSortBy(context)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
+	err = opt.checkParameterValidity(context)
 	if err != nil {
 		return nil, err
 	}
@@ -266,20 +350,16 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleShoppingProductOpts contains all the query parameters available for google shopping product.
@@ -287,32 +367,57 @@ type GoogleShoppingProductOpts struct {
 	Domain            oxylabs.Domain
 	Locale            oxylabs.Locale
 	ResultsLanguage   string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackURL       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleShoppingProductOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleShoppingProductOpts",
+		Domain:            &opt.Domain,
+		Locale:            &opt.Locale,
+		ResultsLanguage:   &opt.ResultsLanguage,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackURL,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleShoppingProduct parameters.
 func (opt *GoogleShoppingProductOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeGoogleShoppingProduct scrapes google shopping via Oxylabs E-Commerce API
@@ -321,7 +426,7 @@ func (c *EcommerceClient) ScrapeGoogleShoppingProduct(
 	query string,
 	opts ...*GoogleShoppingProductOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
@@ -335,15 +440,31 @@ func (c *EcommerceClient) ScrapeGoogleShoppingProductCtx(
 	query string,
 	opts ...*GoogleShoppingProductOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleShoppingProduct(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleShoppingProduct applies the defaults to the opts of a scrape with google_shopping_product as source,
+// checks them and returns the req.
+func prepareGoogleShoppingProduct(
+	c *internal.Client,
+	query string,
+	opts []*GoogleShoppingProductOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleShoppingProductOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity()
@@ -377,20 +498,16 @@ func (c *EcommerceClient) ScrapeGoogleShoppingProductCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleShoppingPricingOpts contains all the query parameters available for google shopping pricing.
@@ -400,36 +517,63 @@ type GoogleShoppingPricingOpts struct {
 	Pages             int
 	Locale            oxylabs.Locale
 	ResultsLanguage   string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackURL       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleShoppingPricingOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleShoppingPricingOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Locale:            &opt.Locale,
+		ResultsLanguage:   &opt.ResultsLanguage,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackURL,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleShoppingPricing parameters.
 func (opt *GoogleShoppingPricingOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeGoogleShoppingPricing scrapes google shopping via Oxylabs E-Commerce API
@@ -438,7 +582,7 @@ func (c *EcommerceClient) ScrapeGoogleShoppingPricing(
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
@@ -452,17 +596,31 @@ func (c *EcommerceClient) ScrapeGoogleShoppingPricingCtx(
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleShoppingPricing(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleShoppingPricing applies the defaults to the opts of a scrape with google_shopping_pricing as source,
+// checks them and returns the req.
+func prepareGoogleShoppingPricing(
+	c *internal.Client,
+	query string,
+	opts []*GoogleShoppingPricingOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleShoppingPricingOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity()
@@ -498,18 +656,14 @@ func (c *EcommerceClient) ScrapeGoogleShoppingPricingCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
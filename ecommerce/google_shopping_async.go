@@ -2,12 +2,8 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
-	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // ScrapeGoogleShoppingUrl scrapes google shopping with async polling runtime
@@ -15,11 +11,11 @@ import (
 func (c *EcommerceClientAsync) ScrapeGoogleShoppingUrl(
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
 
-	return c.ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleShoppingUrlCtx scrapes google shopping with async polling runtime
@@ -29,91 +25,37 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingUrlCtx(
 	ctx context.Context,
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Check validity of url.
-	err := internal.ValidateUrl(url, "shopping.google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleShoppingUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleShoppingUrl(ctx, url, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleShoppingUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"geo_location":    opt.GeoLocation,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitGoogleShoppingUrl submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitGoogleShoppingUrl(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleShoppingUrlOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleShoppingUrl(c.C, url, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleShoppingUrl submits a job via Oxylabs E-Commerce API with google_shopping as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitGoogleShoppingUrl(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleShoppingUrlOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleShoppingUrl(ctx, url, opts...))
 }
 
 // ScrapeGoogleShoppingSearch scrapes google shopping with async polling runtime
@@ -121,11 +63,11 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingUrlCtx(
 func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearch(
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleShoppingSearchCtx scrapes google shopping with async polling runtime
@@ -135,118 +77,37 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearchCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultSortBy(context)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingSearch,
-		"domain":           opt.Domain,
-		"query":            query,
-		"start_page":       opt.StartPage,
-		"pages":            opt.Pages,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "sort_by",
-				"value": context["sort_by"],
-			},
-			{
-				"key":   "min_price",
-				"value": context["min_price"],
-			},
-			{
-				"key":   "max_price",
-				"value": context["max_price"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleShoppingSearch(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitGoogleShoppingSearch submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitGoogleShoppingSearch(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleShoppingSearch(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleShoppingSearch submits a job via Oxylabs E-Commerce API with google_shopping_search as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitGoogleShoppingSearch(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleShoppingSearch(ctx, query, opts...))
 }
 
 // ScrapeGoogleShoppingProduct scrapes google shopping with async polling runtime
@@ -254,11 +115,11 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearchCtx(
 func (c *EcommerceClientAsync) ScrapeGoogleShoppingProduct(
 	query string,
 	opts ...*GoogleShoppingProductOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleShoppingProductCtx scrapes google shopping with async polling runtime
@@ -268,88 +129,37 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingProductCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleShoppingProductOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingProductOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingProduct,
-		"domain":           opt.Domain,
-		"query":            query,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleShoppingProduct(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
 
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitGoogleShoppingProduct submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitGoogleShoppingProduct(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleShoppingProduct(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleShoppingProduct submits a job via Oxylabs E-Commerce API with google_shopping_product as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitGoogleShoppingProduct(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleShoppingProduct(ctx, query, opts...))
 }
 
 // ScrapeGoogleShoppingPricing scrapes google shopping with async polling runtime
@@ -357,11 +167,11 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingProductCtx(
 func (c *EcommerceClientAsync) ScrapeGoogleShoppingPricing(
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleShoppingPricingCtx scrapes google shopping via Oxylabs E-Commerce API
@@ -371,91 +181,35 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingPricingCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingPricingOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingPricing,
-		"domain":           opt.Domain,
-		"query":            query,
-		"start_page":       opt.StartPage,
-		"pages":            opt.Pages,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleShoppingPricing(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	/// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitGoogleShoppingPricing submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitGoogleShoppingPricing(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleShoppingPricing(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleShoppingPricing submits a job via Oxylabs E-Commerce API with google_shopping_pricing as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitGoogleShoppingPricing(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleShoppingPricing(ctx, query, opts...))
 }
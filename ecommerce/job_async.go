@@ -0,0 +1,171 @@
+package ecommerce
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// ResumeJobOpts contains the options for resuming polling of an existing job.
+// The job is polled and its results are decoded as it was submitted, as
+// persisted in the job store or else reported by the job endpoint. Parse and
+// CustomParser, if set, override the decoding of the results, and PollInterval
+// the poll curve of the job.
+type ResumeJobOpts struct {
+	Parse          bool
+	CustomParser   bool
+	PollInterval   time.Duration
+	RequestTimeout time.Duration
+	Meta           map[string]string
+	// Username is the account the job was submitted with, so that a client with
+	// a credentials pool uses it. It defaults to the account persisted in the job store.
+	Username string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *ResumeJobOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:         "ResumeJobOpts",
+		Parse:        &opt.Parse,
+		PollInterval: &opt.PollInterval,
+	}
+}
+
+// ResumeJob re-attaches polling to an already submitted job via Oxylabs E-Commerce API,
+// e.g. a job loaded from a job store after a restart.
+func (c *EcommerceClientAsync) ResumeJob(
+	jobID string,
+	opts ...*ResumeJobOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ResumeJobCtx(ctx, jobID, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ResumeJobCtx re-attaches polling to an already submitted job via Oxylabs E-Commerce API,
+// e.g. a job loaded from a job store after a restart.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ResumeJobCtx(
+	ctx context.Context,
+	jobID string,
+	opts ...*ResumeJobOpts,
+) (*AsyncResult, error) {
+	if jobID == "" {
+		return nil, fmt.Errorf("job id parameter is empty")
+	}
+
+	// Prepare options.
+	opt := &ResumeJobOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	// The job is resumed as it was submitted, e.g. by the process before a restart.
+	resumed, err := c.C.ResumeJob(ctx, jobID, opt.Username, opt.PollInterval)
+	if err != nil {
+		return nil, err
+	}
+	strategy := resumed.Strategy
+	if opt.Parse || opt.CustomParser {
+		strategy = oxylabs.DecodeStrategyFor(opt.Parse, opt.CustomParser)
+	}
+
+	return c.poll(ctx, &asyncJob{
+		ID:       jobID,
+		Payload:  resumed.Payload,
+		Strategy: strategy,
+		Meta:     opt.Meta,
+		Curve:    &resumed.Curve,
+	}), nil
+}
+
+// JobResultsOpts contains the options for fetching the results of a finished job.
+// The results are decoded as requested by the options the job was submitted with.
+type JobResultsOpts struct {
+	RequestTimeout time.Duration
+	Meta           map[string]string
+	// Username is the account the job was submitted with, e.g. persisted
+	// in jobstore.Job, so that a client with a credentials pool uses it.
+	Username string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *JobResultsOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts: "JobResultsOpts",
+	}
+}
+
+// GetJobResults fetches all results of a finished job via Oxylabs E-Commerce API,
+// e.g. a job submitted with SubmitOnly, ordered by page.
+func (c *EcommerceClientAsync) GetJobResults(
+	jobID string,
+	opts ...*JobResultsOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.GetJobResultsCtx(ctx, jobID, opts...)
+}
+
+// GetJobResultsCtx fetches all results of a finished job via Oxylabs E-Commerce API,
+// e.g. a job submitted with SubmitOnly, ordered by page.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) GetJobResultsCtx(
+	ctx context.Context,
+	jobID string,
+	opts ...*JobResultsOpts,
+) (*Resp, error) {
+	if jobID == "" {
+		return nil, fmt.Errorf("job id parameter is empty")
+	}
+
+	// Prepare options.
+	opt := &JobResultsOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	if err := c.C.PinAccount(jobID, opt.Username); err != nil {
+		return nil, err
+	}
+
+	// The results are decoded as the job was submitted, e.g. by another process.
+	job := Job{}
+	if err := c.C.GetJob(ctx, jobID, &job); err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.C.GetJobResult(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := DecodeRespWith(httpResp, oxylabs.DecodeStrategyFor(job.Parse, job.ParsingInstructions != nil), c.C.Codec())
+	if err != nil {
+		return nil, err
+	}
+	resp.Job = job
+	resp.orderResults()
+	resp.Meta = opt.Meta
+
+	return resp, nil
+}
+
+// CheckJobStatus gets the status of a job via Oxylabs E-Commerce API with a single req,
+// without polling, e.g. for external schedulers driving their own polling cadence.
+// Once it is done, its results are fetched with GetJobResults.
+func (c *EcommerceClientAsync) CheckJobStatus(
+	ctx context.Context,
+	jobID string,
+) (oxylabs.JobStatus, error) {
+	if jobID == "" {
+		return "", fmt.Errorf("job id parameter is empty")
+	}
+
+	return c.C.CheckJobStatus(ctx, jobID)
+}
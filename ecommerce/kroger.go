@@ -0,0 +1,475 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// KrogerFulfillmentType is the fulfillment type the Kroger results are scoped to.
+type KrogerFulfillmentType string
+
+const (
+	KrogerPickup   KrogerFulfillmentType = "pickup"
+	KrogerDelivery KrogerFulfillmentType = "delivery"
+	KrogerInStore  KrogerFulfillmentType = "in_store"
+)
+
+// IsKrogerFulfillmentTypeValid checks the fulfillment_type parameter.
+func IsKrogerFulfillmentTypeValid(fulfillmentType KrogerFulfillmentType) bool {
+	switch fulfillmentType {
+	case
+		KrogerPickup,
+		KrogerDelivery,
+		KrogerInStore:
+		return true
+	default:
+		return false
+	}
+}
+
+// KrogerUrlOpts contains all the query parameters available for kroger.
+type KrogerUrlOpts struct {
+	StoreId           string
+	DeliveryZip       string
+	FulfillmentType   KrogerFulfillmentType
+	UserAgent         oxylabs.UserAgent
+	CallbackUrl       string
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *KrogerUrlOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "KrogerUrlOpts",
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// checkParameterValidity checks validity of ScrapeKrogerUrl parameters.
+func (opt *KrogerUrlOpts) checkParametersValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.FulfillmentType != "" && !IsKrogerFulfillmentTypeValid(opt.FulfillmentType) {
+		errs = append(errs, fmt.Errorf("invalid fulfillment_type parameter: %v", opt.FulfillmentType))
+	}
+
+	if opt.DeliveryZip != "" && !oxylabs.IsZipCodeValid(opt.DeliveryZip) {
+		errs = append(errs, fmt.Errorf("invalid delivery_zip parameter: %v", opt.DeliveryZip))
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ScrapeKrogerUrl scrapes kroger via Oxylabs E-Commerce API with kroger as source.
+func (c *EcommerceClient) ScrapeKrogerUrl(
+	url string,
+	opts ...*KrogerUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeKrogerUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeKrogerUrlCtx scrapes kroger via Oxylabs E-Commerce API with kroger as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeKrogerUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*KrogerUrlOpts,
+) (*Resp, error) {
+	req, err := prepareKrogerUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareKrogerUrl applies the defaults to the opts of a scrape with kroger as source,
+// checks them and returns the req.
+func prepareKrogerUrl(
+	c *internal.Client,
+	url string,
+	opts []*KrogerUrlOpts,
+) (*scrapeReq, error) {
+	// Check validity of url.
+	err := c.CheckUrl(url, "kroger.com")
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare options.
+	opt := &KrogerUrlOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Check validity of parameters.
+	err = opt.checkParametersValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":           oxylabs.Kroger,
+		"url":              url,
+		"store_id":         opt.StoreId,
+		"delivery_zip":     opt.DeliveryZip,
+		"fulfillment_type": opt.FulfillmentType,
+		"user_agent_type":  opt.UserAgent,
+		"callback_url":     opt.CallbackUrl,
+	}
+
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
+	customParserFlag := false
+	if opt.ParseInstructions != nil {
+		payload["parse"] = true
+		payload["parsing_instructions"] = &opt.ParseInstructions
+		customParserFlag = true
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+	if err := internal.CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
+}
+
+// KrogerSearchOpts contains all the query parameters available for kroger_search.
+type KrogerSearchOpts struct {
+	StartPage         int
+	Pages             int
+	StoreId           string
+	DeliveryZip       string
+	FulfillmentType   KrogerFulfillmentType
+	UserAgent         oxylabs.UserAgent
+	CallbackUrl       string
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *KrogerSearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "KrogerSearchOpts",
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// checkParameterValidity checks validity of ScrapeKrogerSearch parameters.
+func (opt *KrogerSearchOpts) checkParametersValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.Pages <= 0 || opt.StartPage <= 0 {
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
+	}
+
+	if opt.FulfillmentType != "" && !IsKrogerFulfillmentTypeValid(opt.FulfillmentType) {
+		errs = append(errs, fmt.Errorf("invalid fulfillment_type parameter: %v", opt.FulfillmentType))
+	}
+
+	if opt.DeliveryZip != "" && !oxylabs.IsZipCodeValid(opt.DeliveryZip) {
+		errs = append(errs, fmt.Errorf("invalid delivery_zip parameter: %v", opt.DeliveryZip))
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ScrapeKrogerSearch scrapes kroger via Oxylabs E-Commerce API with kroger_search as source.
+func (c *EcommerceClient) ScrapeKrogerSearch(
+	query string,
+	opts ...*KrogerSearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeKrogerSearchCtx(ctx, query, opts...)
+}
+
+// ScrapeKrogerSearchCtx scrapes kroger via Oxylabs E-Commerce API with kroger_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeKrogerSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*KrogerSearchOpts,
+) (*Resp, error) {
+	req, err := prepareKrogerSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareKrogerSearch applies the defaults to the opts of a scrape with kroger_search as source,
+// checks them and returns the req.
+func prepareKrogerSearch(
+	c *internal.Client,
+	query string,
+	opts []*KrogerSearchOpts,
+) (*scrapeReq, error) {
+	// Prepare options.
+	opt := &KrogerSearchOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check validity of parameters.
+	err = opt.checkParametersValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":           oxylabs.KrogerSearch,
+		"query":            query,
+		"start_page":       opt.StartPage,
+		"pages":            opt.Pages,
+		"store_id":         opt.StoreId,
+		"delivery_zip":     opt.DeliveryZip,
+		"fulfillment_type": opt.FulfillmentType,
+		"user_agent_type":  opt.UserAgent,
+		"callback_url":     opt.CallbackUrl,
+	}
+
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
+	customParserFlag := false
+	if opt.ParseInstructions != nil {
+		payload["parse"] = true
+		payload["parsing_instructions"] = &opt.ParseInstructions
+		customParserFlag = true
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+	if err := internal.CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
+}
+
+// KrogerProductOpts contains all the query parameters available for kroger_product.
+type KrogerProductOpts struct {
+	StoreId           string
+	DeliveryZip       string
+	FulfillmentType   KrogerFulfillmentType
+	UserAgent         oxylabs.UserAgent
+	CallbackUrl       string
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *KrogerProductOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "KrogerProductOpts",
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// checkParameterValidity checks validity of ScrapeKrogerProduct parameters.
+func (opt *KrogerProductOpts) checkParametersValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.FulfillmentType != "" && !IsKrogerFulfillmentTypeValid(opt.FulfillmentType) {
+		errs = append(errs, fmt.Errorf("invalid fulfillment_type parameter: %v", opt.FulfillmentType))
+	}
+
+	if opt.DeliveryZip != "" && !oxylabs.IsZipCodeValid(opt.DeliveryZip) {
+		errs = append(errs, fmt.Errorf("invalid delivery_zip parameter: %v", opt.DeliveryZip))
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ScrapeKrogerProduct scrapes kroger via Oxylabs E-Commerce API with kroger_product as source.
+func (c *EcommerceClient) ScrapeKrogerProduct(
+	productId string,
+	opts ...*KrogerProductOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeKrogerProductCtx(ctx, productId, opts...)
+}
+
+// ScrapeKrogerProductCtx scrapes kroger via Oxylabs E-Commerce API with kroger_product as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeKrogerProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*KrogerProductOpts,
+) (*Resp, error) {
+	req, err := prepareKrogerProduct(c.C, productId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareKrogerProduct applies the defaults to the opts of a scrape with kroger_product as source,
+// checks them and returns the req.
+func prepareKrogerProduct(
+	c *internal.Client,
+	productId string,
+	opts []*KrogerProductOpts,
+) (*scrapeReq, error) {
+	// Check validity of product id.
+	if err := c.CheckProductId(productId); err != nil {
+		return nil, err
+	}
+
+	// Prepare options.
+	opt := &KrogerProductOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Check validity of parameters.
+	err := opt.checkParametersValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":           oxylabs.KrogerProduct,
+		"query":            productId,
+		"store_id":         opt.StoreId,
+		"delivery_zip":     opt.DeliveryZip,
+		"fulfillment_type": opt.FulfillmentType,
+		"user_agent_type":  opt.UserAgent,
+		"callback_url":     opt.CallbackUrl,
+	}
+
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
+	customParserFlag := false
+	if opt.ParseInstructions != nil {
+		payload["parse"] = true
+		payload["parsing_instructions"] = &opt.ParseInstructions
+		customParserFlag = true
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+	if err := internal.CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
+}
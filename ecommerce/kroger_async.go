@@ -0,0 +1,163 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+)
+
+// ScrapeKrogerUrl scrapes kroger with async polling runtime via Oxylabs E-Commerce API
+// and kroger as source.
+func (c *EcommerceClientAsync) ScrapeKrogerUrl(
+	url string,
+	opts ...*KrogerUrlOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeKrogerUrlCtx(ctx, url, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeKrogerUrlCtx scrapes kroger with async polling runtime via Oxylabs E-Commerce API
+// and kroger as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeKrogerUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*KrogerUrlOpts,
+) (*AsyncResult, error) {
+	job, err := c.submitKrogerUrl(ctx, url, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.poll(ctx, job), nil
+}
+
+// submitKrogerUrl submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitKrogerUrl(
+	ctx context.Context,
+	url string,
+	opts ...*KrogerUrlOpts,
+) (*asyncJob, error) {
+	req, err := prepareKrogerUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req)
+}
+
+// SubmitKrogerUrl submits a job via Oxylabs E-Commerce API with kroger as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitKrogerUrl(
+	ctx context.Context,
+	url string,
+	opts ...*KrogerUrlOpts,
+) (string, error) {
+	return c.submitOnly(c.submitKrogerUrl(ctx, url, opts...))
+}
+
+// ScrapeKrogerSearch scrapes kroger with async polling runtime via Oxylabs E-Commerce API
+// and kroger_search as source.
+func (c *EcommerceClientAsync) ScrapeKrogerSearch(
+	query string,
+	opts ...*KrogerSearchOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeKrogerSearchCtx(ctx, query, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeKrogerSearchCtx scrapes kroger with async polling runtime via Oxylabs E-Commerce API
+// and kroger_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeKrogerSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*KrogerSearchOpts,
+) (*AsyncResult, error) {
+	job, err := c.submitKrogerSearch(ctx, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.poll(ctx, job), nil
+}
+
+// submitKrogerSearch submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitKrogerSearch(
+	ctx context.Context,
+	query string,
+	opts ...*KrogerSearchOpts,
+) (*asyncJob, error) {
+	req, err := prepareKrogerSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req)
+}
+
+// SubmitKrogerSearch submits a job via Oxylabs E-Commerce API with kroger_search as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitKrogerSearch(
+	ctx context.Context,
+	query string,
+	opts ...*KrogerSearchOpts,
+) (string, error) {
+	return c.submitOnly(c.submitKrogerSearch(ctx, query, opts...))
+}
+
+// ScrapeKrogerProduct scrapes kroger with async polling runtime via Oxylabs E-Commerce API
+// and kroger_product as source.
+func (c *EcommerceClientAsync) ScrapeKrogerProduct(
+	productId string,
+	opts ...*KrogerProductOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeKrogerProductCtx(ctx, productId, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeKrogerProductCtx scrapes kroger with async polling runtime via Oxylabs E-Commerce API
+// and kroger_product as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeKrogerProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*KrogerProductOpts,
+) (*AsyncResult, error) {
+	job, err := c.submitKrogerProduct(ctx, productId, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.poll(ctx, job), nil
+}
+
+// submitKrogerProduct submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitKrogerProduct(
+	ctx context.Context,
+	productId string,
+	opts ...*KrogerProductOpts,
+) (*asyncJob, error) {
+	req, err := prepareKrogerProduct(c.C, productId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req)
+}
+
+// SubmitKrogerProduct submits a job via Oxylabs E-Commerce API with kroger_product as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitKrogerProduct(
+	ctx context.Context,
+	productId string,
+	opts ...*KrogerProductOpts,
+) (string, error) {
+	return c.submitOnly(c.submitKrogerProduct(ctx, productId, opts...))
+}
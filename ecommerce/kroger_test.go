@@ -0,0 +1,36 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeKrogerSearch(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	_, err := c.ScrapeKrogerSearch("milk", &KrogerSearchOpts{
+		StoreId:         "01400943",
+		DeliveryZip:     "45202",
+		FulfillmentType: KrogerPickup,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "kroger_search", payload["source"])
+	assert.Equal(t, "01400943", payload["store_id"])
+	assert.Equal(t, "45202", payload["delivery_zip"])
+	assert.Equal(t, "pickup", payload["fulfillment_type"])
+
+	_, err = c.ScrapeKrogerSearch("milk", &KrogerSearchOpts{DeliveryZip: "452"})
+	assert.EqualError(t, err, "invalid delivery_zip parameter: 452")
+}
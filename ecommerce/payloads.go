@@ -0,0 +1,59 @@
+package ecommerce
+
+import (
+	"fmt"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/internal/payloads"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Register the payload builders of the sources for oxylabs.PayloadHash.
+func init() {
+	payloads.Register(string(oxylabs.AmazonUrl), payloadBuilder(prepareAmazonUrl))
+	payloads.Register(string(oxylabs.AmazonSearch), payloadBuilder(prepareAmazonSearch))
+	payloads.Register(string(oxylabs.AmazonProduct), payloadBuilder(prepareAmazonProduct))
+	payloads.Register(string(oxylabs.AmazonPricing), payloadBuilder(prepareAmazonPricing))
+	payloads.Register(string(oxylabs.AmazonReviews), payloadBuilder(prepareAmazonReviews))
+	payloads.Register(string(oxylabs.AmazonQuestions), payloadBuilder(prepareAmazonQuestions))
+	payloads.Register(string(oxylabs.AmazonBestsellers), payloadBuilder(prepareAmazonBestsellers))
+	payloads.Register(string(oxylabs.AmazonSellers), payloadBuilder(prepareAmazonSellers))
+	payloads.Register(string(oxylabs.EtsySearch), payloadBuilder(prepareEtsySearch))
+	payloads.Register(string(oxylabs.EtsyProduct), payloadBuilder(prepareEtsyProduct))
+	payloads.Register(string(oxylabs.GoogleShoppingUrl), payloadBuilder(prepareGoogleShoppingUrl))
+	payloads.Register(string(oxylabs.GoogleShoppingSearch), payloadBuilder(prepareGoogleShoppingSearch))
+	payloads.Register(string(oxylabs.GoogleShoppingProduct), payloadBuilder(prepareGoogleShoppingProduct))
+	payloads.Register(string(oxylabs.GoogleShoppingPricing), payloadBuilder(prepareGoogleShoppingPricing))
+	payloads.Register(string(oxylabs.Kroger), payloadBuilder(prepareKrogerUrl))
+	payloads.Register(string(oxylabs.KrogerSearch), payloadBuilder(prepareKrogerSearch))
+	payloads.Register(string(oxylabs.KrogerProduct), payloadBuilder(prepareKrogerProduct))
+	payloads.Register(string(oxylabs.Target), payloadBuilder(prepareTargetUrl))
+	payloads.Register(string(oxylabs.TargetSearch), payloadBuilder(prepareTargetSearch))
+	payloads.Register(string(oxylabs.TargetProduct), payloadBuilder(prepareTargetProduct))
+	payloads.Register(string(oxylabs.Universal), payloadBuilder(prepareUniversalUrl))
+	payloads.Register(string(oxylabs.WayfairSearch), payloadBuilder(prepareWayfairSearch))
+	payloads.Register(string(oxylabs.Wayfair), payloadBuilder(prepareWayfairUrl))
+}
+
+// payloadBuilder returns the payloads.Builder of the source prepared by prepare,
+// which builds the payload as a client without client defaults would send it.
+func payloadBuilder[T any](
+	prepare func(c *internal.Client, query string, opts []*T) (*scrapeReq, error),
+) payloads.Builder {
+	return func(query string, opts interface{}) ([]byte, error) {
+		var opt *T
+		if opts != nil {
+			var ok bool
+			if opt, ok = opts.(*T); !ok {
+				return nil, fmt.Errorf("unsupported opts type %T", opts)
+			}
+		}
+
+		req, err := prepare(&internal.Client{}, query, []*T{opt})
+		if err != nil {
+			return nil, err
+		}
+
+		return req.Payload, nil
+	}
+}
@@ -0,0 +1,57 @@
+package ecommerce
+
+import (
+	"context"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// scrapeReq is a checked scrape: its payload with the options needed
+// to poll for and decode its results.
+type scrapeReq struct {
+	Payload      []byte
+	PollInterval time.Duration
+	Strategy     oxylabs.DecodeStrategy
+	Meta         map[string]string
+}
+
+// scrape makes the req and decodes its resp.
+func (c *EcommerceClient) scrape(
+	ctx context.Context,
+	req *scrapeReq,
+) (*Resp, error) {
+	httpResp, err := c.C.Req(ctx, req.Payload, "POST")
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the http Response and get the response.
+	resp, err := DecodeRespWith(httpResp, req.Strategy, c.C.Codec())
+	if err != nil {
+		return nil, err
+	}
+	resp.Meta = req.Meta
+	resp.Payload = c.C.PayloadEcho(req.Payload)
+
+	return resp, nil
+}
+
+// submit submits the req as a job and returns it without polling.
+func (c *EcommerceClientAsync) submit(
+	ctx context.Context,
+	req *scrapeReq,
+) (*asyncJob, error) {
+	jobID, err := c.C.GetJobIDCtx(ctx, req.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &asyncJob{
+		ID:           jobID,
+		Payload:      req.Payload,
+		PollInterval: req.PollInterval,
+		Strategy:     req.Strategy,
+		Meta:         req.Meta,
+	}, nil
+}
@@ -2,9 +2,13 @@ package ecommerce
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"sort"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // Resp is the response struct for all ecommerce sources.
@@ -15,19 +19,133 @@ type Resp struct {
 	Job               Job       `json:"job"`
 	StatusCode        int       `json:"status_code"`
 	Status            string    `json:"status"`
+	// Meta are the user tags of the req opts, carried through to
+	// correlate the resp with the work item it originates from.
+	Meta map[string]string `json:"meta,omitempty"`
+	// Payload is the submitted req payload, set with oxylabs.WithPayloadEcho.
+	Payload json.RawMessage `json:"payload,omitempty"`
 }
 
+// Results is a single result of a job.
+// Depending on the parse options only one of CustomContentParsed,
+// ContentParsed and Content is populated.
 type Results struct {
-	CustomContentParsed map[string]interface{}
-	ContentParsed       Content
-	Content             string
-	CreatedAt           string `json:"created_at"`
-	UpdatedAt           string `json:"updated_at"`
-	Page                int    `json:"page"`
-	Url                 string `json:"url"`
-	JobID               string `json:"job_id"`
-	StatusCode          int    `json:"status_code"`
-	ParserType          string `json:"parser_type"`
+	CustomContentParsed map[string]interface{} `json:"custom_content_parsed,omitempty"`
+	ContentParsed       Content                `json:"content_parsed"`
+	Content             string                 `json:"content"`
+	CreatedAt           string                 `json:"created_at"`
+	UpdatedAt           string                 `json:"updated_at"`
+	Page                int                    `json:"page"`
+	Url                 string                 `json:"url"`
+	JobID               string                 `json:"job_id"`
+	StatusCode          int                    `json:"status_code"`
+	ParserType          string                 `json:"parser_type"`
+	ContentKind         oxylabs.ContentKind    `json:"content_kind"`
+	Error               string                 `json:"error,omitempty"`
+	// Headers are the resp headers of the target page, if the API returned them.
+	Headers oxylabs.TargetHeaders `json:"headers,omitempty"`
+}
+
+// orderResults orders the results of a multi-page job by page, numbering
+// the results without a page from the start page of the job.
+func (r *Resp) orderResults() {
+	startPage := max(r.Job.StartPage, 1)
+	for i := range r.Results {
+		if r.Results[i].Page == 0 {
+			r.Results[i].Page = startPage + i
+		}
+	}
+	sort.SliceStable(r.Results, func(i, j int) bool {
+		return r.Results[i].Page < r.Results[j].Page
+	})
+}
+
+// FinalUrl returns the url of the scraped page after any redirects were followed.
+func (r *Results) FinalUrl() string {
+	return r.Url
+}
+
+// RequestedUrl returns the url the job was submitted with, or an empty
+// string for sources which take a query.
+func (r *Resp) RequestedUrl() string {
+	url, _ := r.Job.Url.(string)
+	return url
+}
+
+// Redirected reports whether the final url of any result differs from the
+// requested url, compared as normalized urls, see internal.SameUrl.
+func (r *Resp) Redirected() bool {
+	requested := r.RequestedUrl()
+	if requested == "" {
+		return false
+	}
+	for _, result := range r.Results {
+		if result.Url != "" && !internal.SameUrl(result.Url, requested) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Failed reports whether the page of the result failed.
+func (r *Results) Failed() bool {
+	return r.Error != "" || (r.StatusCode != 0 && (r.StatusCode < 200 || r.StatusCode >= 400))
+}
+
+// Err returns the error of a failed page, or nil.
+func (r *Results) Err() error {
+	if !r.Failed() {
+		return nil
+	}
+	if r.Error != "" {
+		return fmt.Errorf("page %d: %s", r.Page, r.Error)
+	}
+
+	return fmt.Errorf("page %d: failed with status code %d", r.Page, r.StatusCode)
+}
+
+// Bytes returns the raw content of the result, decoding base64 encoded
+// images and binary content.
+func (r *Results) Bytes() ([]byte, error) {
+	if r.ContentKind == oxylabs.ContentJSON && r.Content == "" {
+		if r.CustomContentParsed != nil {
+			return json.Marshal(r.CustomContentParsed)
+		}
+		return json.Marshal(r.ContentParsed)
+	}
+
+	return oxylabs.DecodeContent(r.Content, r.contentKind())
+}
+
+// contentKind returns the content kind of the result, detecting it
+// for results which were not decoded from a resp.
+func (r *Results) contentKind() oxylabs.ContentKind {
+	if r.ContentKind == "" && r.Content != "" {
+		return oxylabs.DetectContentKind(r.Content)
+	}
+
+	return r.ContentKind
+}
+
+// HTML returns the raw html content of the result, or an error if its
+// content is not html. htmlutil.ResultText and htmlutil.ResultDocument
+// extract its text and parse it into a goquery document.
+func (r *Results) HTML() (string, error) {
+	if err := r.checkHTML(); err != nil {
+		return "", err
+	}
+
+	return r.Content, nil
+}
+
+// checkHTML checks that the content of the result is html.
+func (r *Results) checkHTML() error {
+	if r.ContentKind != "" && r.ContentKind != oxylabs.ContentHTML {
+		return fmt.Errorf("page %d: content of kind %s is not html", r.Page, r.ContentKind)
+	}
+
+	return nil
 }
 
 type Content struct {
@@ -119,6 +237,8 @@ type Result struct {
 	PriceStr               string                   `json:"price_str"`
 	PriceUpper             float64                  `json:"price_upper"`
 	RatingsCount           int                      `json:"ratings_count"`
+	// Bestsellers are the ranked entries of amazon_bestsellers, whose results are a list.
+	Bestsellers []AmazonBestseller `json:"-"`
 }
 
 type Paid struct {
@@ -454,140 +574,121 @@ type Job struct {
 		Key   string      `json:"key"`
 		Value interface{} `json:"value"`
 	} `json:"context,omitempty"`
-	CreatedAt           string        `json:"created_at"`
-	Domain              string        `json:"domain"`
-	GeoLocation         interface{}   `json:"geo_location"`
-	ID                  string        `json:"id"`
-	Limit               int           `json:"limit"`
-	Locale              interface{}   `json:"locale"`
-	Pages               int           `json:"pages"`
-	Parse               bool          `json:"parse"`
-	ParserType          interface{}   `json:"parser_type"`
-	ParsingInstructions interface{}   `json:"parsing_instructions"`
-	BrowserInstructions interface{}   `json:"browser_instructions"`
-	Render              interface{}   `json:"render"`
-	Url                 interface{}   `json:"url"`
-	Query               string        `json:"query"`
-	Source              string        `json:"source"`
-	StartPage           int           `json:"start_page"`
-	Status              string        `json:"status"`
-	StorageType         interface{}   `json:"storage_type"`
-	StorageUrl          interface{}   `json:"storage_url"`
-	Subdomain           string        `json:"subdomain"`
-	ContentEncoding     string        `json:"content_encoding"`
-	UpdatedAt           string        `json:"updated_at"`
-	UserAgentType       string        `json:"user_agent_type"`
-	SessionInfo         interface{}   `json:"session_info"`
-	Statuses            []interface{} `json:"statuses"`
-	ClientNotes         interface{}   `json:"client_notes"`
+	CreatedAt           string            `json:"created_at"`
+	Domain              string            `json:"domain"`
+	GeoLocation         interface{}       `json:"geo_location"`
+	ID                  string            `json:"id"`
+	Limit               int               `json:"limit"`
+	Locale              interface{}       `json:"locale"`
+	Pages               int               `json:"pages"`
+	Parse               bool              `json:"parse"`
+	ParserType          interface{}       `json:"parser_type"`
+	ParsingInstructions interface{}       `json:"parsing_instructions"`
+	BrowserInstructions interface{}       `json:"browser_instructions"`
+	Render              interface{}       `json:"render"`
+	Url                 interface{}       `json:"url"`
+	Query               string            `json:"query"`
+	Source              string            `json:"source"`
+	StartPage           int               `json:"start_page"`
+	Status              oxylabs.JobStatus `json:"status"`
+	StorageType         interface{}       `json:"storage_type"`
+	StorageUrl          interface{}       `json:"storage_url"`
+	Subdomain           string            `json:"subdomain"`
+	ContentEncoding     string            `json:"content_encoding"`
+	UpdatedAt           string            `json:"updated_at"`
+	UserAgentType       string            `json:"user_agent_type"`
+	SessionInfo         interface{}       `json:"session_info"`
+	Statuses            []interface{}     `json:"statuses"`
+	ClientNotes         interface{}       `json:"client_notes"`
 	Links               []struct {
 		Rel    string `json:"rel"`
 		Href   string `json:"href"`
 		Method string `json:"method"`
 	} `json:"_links,omitempty"`
+	// PageJobIDs are the IDs of the jobs of each page, in order,
+	// of a req whose pages were made in parallel.
+	PageJobIDs []string `json:"page_job_ids,omitempty"`
 }
 
 // Custom function to unmarshal into the Resp struct.
 // Because of different return types depending on the parse option.
+// Both the API resp and a Resp marshalled with json.Marshal are accepted,
+// so that a Resp can be persisted and reloaded later.
 func (r *Resp) UnmarshalJSON(data []byte) error {
-	// Unmarshal json data into RawResp map.
-	var rawResp map[string]json.RawMessage
-	if err := json.Unmarshal(data, &rawResp); err != nil {
+	return r.unmarshal(data, oxylabs.StdCodec{})
+}
+
+// unmarshal unmarshals data into the Resp with the codec. The resp is decoded
+// once with its results kept raw, and each page is then decoded from its raw
+// result, so that a page which fails to decode does not fail the others.
+func (r *Resp) unmarshal(data []byte, codec oxylabs.Codec) error {
+	type resp Resp
+	var raw struct {
+		resp
+		Parse   *bool             `json:"parse"`
+		Results []json.RawMessage `json:"results"`
+	}
+	if err := codec.Unmarshal(data, &raw); err != nil {
 		return err
 	}
 
-	// Unmarshal the results array.
-	if resultsData, ok := rawResp["results"]; ok {
-		// Slice to store raw JSON messages for each result.
-		var resultsRawMessages []json.RawMessage
-		if err := json.Unmarshal(resultsData, &resultsRawMessages); err != nil {
-			return err
-		}
-
-		// Unmarshal each result into the Results slice.
-		for _, resultRawMessage := range resultsRawMessages {
-			if r.Parse && !r.ParseInstructions {
-				var result struct {
-					ContentParsed Content `json:"content"`
-					CreatedAt     string  `json:"created_at"`
-					UpdatedAt     string  `json:"updated_at"`
-					Page          int     `json:"page"`
-					Url           string  `json:"url"`
-					JobID         string  `json:"job_id"`
-					StatusCode    int     `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					ContentParsed: result.ContentParsed,
-					CreatedAt:     result.CreatedAt,
-					UpdatedAt:     result.UpdatedAt,
-					Page:          result.Page,
-					Url:           result.Url,
-					JobID:         result.JobID,
-					StatusCode:    result.StatusCode,
-				})
-			} else if r.Parse && r.ParseInstructions {
-				var result struct {
-					CustomContentParsed map[string]interface{} `json:"content"`
-					CreatedAt           string                 `json:"created_at"`
-					UpdatedAt           string                 `json:"updated_at"`
-					Page                int                    `json:"page"`
-					Url                 string                 `json:"url"`
-					JobID               string                 `json:"job_id"`
-					StatusCode          int                    `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					CustomContentParsed: result.CustomContentParsed,
-					CreatedAt:           result.CreatedAt,
-					UpdatedAt:           result.UpdatedAt,
-					Page:                result.Page,
-					Url:                 result.Url,
-					JobID:               result.JobID,
-					StatusCode:          result.StatusCode,
-				})
-			} else if !r.Parse {
-				var result struct {
-					Content    string `json:"content"`
-					CreatedAt  string `json:"created_at"`
-					UpdatedAt  string `json:"updated_at"`
-					Page       int    `json:"page"`
-					Url        string `json:"url"`
-					JobID      string `json:"job_id"`
-					StatusCode int    `json:"status_code"`
-				}
-				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
-				}
-				r.Results = append(r.Results, Results{
-					Content:    result.Content,
-					CreatedAt:  result.CreatedAt,
-					UpdatedAt:  result.UpdatedAt,
-					Page:       result.Page,
-					Url:        result.Url,
-					JobID:      result.JobID,
-					StatusCode: result.StatusCode,
-				})
+	// A marshalled Resp carries the parse flag, which the API resp does not.
+	if raw.Parse != nil {
+		*r = Resp(raw.resp)
+		r.Parse = *raw.Parse
+		for _, result := range raw.Results {
+			var results Results
+			if err := codec.Unmarshal(result, &results); err != nil {
+				return err
 			}
+			r.Results = append(r.Results, results)
 		}
+		return nil
 	}
 
-	// Unmarshal the job object.
-	if jobData, ok := rawResp["job"]; ok {
-		var job Job
-		if err := json.Unmarshal(jobData, &job); err != nil {
+	// Unmarshal each result into the Results slice.
+	decode := decodeResult[rawResult]
+	switch r.Strategy() {
+	case oxylabs.DecodeParsed:
+		decode = decodeResult[parsedResult]
+	case oxylabs.DecodeCustomParsed:
+		decode = decodeResult[customParsedResult]
+	}
+	for _, result := range raw.Results {
+		results, err := decode(result, codec)
+		if err != nil {
 			return err
 		}
-		r.Job = job
+		r.Results = append(r.Results, results)
 	}
+	r.Job = raw.Job
 
 	return nil
 }
 
+// decodeResult decodes the raw result of a page as R. A page which fails to
+// decode, e.g. one with an error message as content, is kept as a raw result
+// with its error instead of failing the whole resp.
+func decodeResult[R any, P interface {
+	*R
+	resultDecoder
+}](data []byte, codec oxylabs.Codec) (Results, error) {
+	var result R
+	err := codec.Unmarshal(data, &result)
+	if err == nil {
+		return P(&result).results(), nil
+	}
+
+	var failed rawResult
+	if codec.Unmarshal(data, &failed) != nil {
+		return Results{}, err
+	}
+	results := failed.results()
+	results.Error = fmt.Sprintf("error decoding content: %v", err)
+
+	return results, nil
+}
+
 // GetResp returns a Resp struct from the http.Response object.
 // It will use the parse and customParserFlag parameters
 // to determine how to parse the response.
@@ -596,11 +697,32 @@ func GetResp(
 	parse bool,
 	customParserFlag bool,
 ) (*Resp, error) {
-	// Read the resp body into a buffer.
-	respBody, err := io.ReadAll(httpResp.Body)
+	return DecodeResp(httpResp, oxylabs.DecodeStrategyFor(parse, customParserFlag))
+}
+
+// DecodeResp returns a Resp struct from the http.Response object,
+// decoding the content of the results with the given strategy.
+func DecodeResp(
+	httpResp *http.Response,
+	strategy oxylabs.DecodeStrategy,
+) (*Resp, error) {
+	return DecodeRespWith(httpResp, strategy, oxylabs.StdCodec{})
+}
+
+// DecodeRespWith returns a Resp struct from the http.Response object like
+// DecodeResp, decoding the JSON with the codec.
+func DecodeRespWith(
+	httpResp *http.Response,
+	strategy oxylabs.DecodeStrategy,
+	codec oxylabs.Codec,
+) (*Resp, error) {
+	// Read the resp body into a buffer and release the connection.
+	defer httpResp.Body.Close()
+	respBody, release, err := internal.ReadBody(httpResp.Body, codec)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	// If status code not 200, return error.
 	if httpResp.StatusCode != 200 {
@@ -609,9 +731,9 @@ func GetResp(
 
 	// Unmarshal the JSON object.
 	res := &Resp{}
-	res.Parse = parse
-	res.ParseInstructions = customParserFlag
-	if err := res.UnmarshalJSON(respBody); err != nil {
+	res.Parse = strategy != oxylabs.DecodeRaw
+	res.ParseInstructions = strategy == oxylabs.DecodeCustomParsed
+	if err := res.unmarshal(respBody, codec); err != nil {
 		return nil, fmt.Errorf("failed to parse JSON object: %v", err)
 	}
 
@@ -621,3 +743,101 @@ func GetResp(
 
 	return res, nil
 }
+
+// FailedPages returns the page numbers of the results that failed,
+// as some pages of a multi-page job may fail while others succeed.
+func (r *Resp) FailedPages() []int {
+	var pages []int
+	for i := range r.Results {
+		if r.Results[i].Failed() {
+			pages = append(pages, r.Results[i].Page)
+		}
+	}
+
+	return pages
+}
+
+// Errs returns the errors of the failed pages joined, or nil.
+func (r *Resp) Errs() error {
+	var errs []error
+	for i := range r.Results {
+		errs = append(errs, r.Results[i].Err())
+	}
+
+	return errors.Join(errs...)
+}
+
+// Strategy returns the decode strategy of the content of the results.
+func (r *Resp) Strategy() oxylabs.DecodeStrategy {
+	return oxylabs.DecodeStrategyFor(r.Parse, r.ParseInstructions)
+}
+
+// resultDecoder is implemented by the result types of each decode strategy.
+type resultDecoder interface {
+	results() Results
+}
+
+// resultMeta contains the fields common to the results of all decode strategies.
+type resultMeta struct {
+	CreatedAt  string                `json:"created_at"`
+	UpdatedAt  string                `json:"updated_at"`
+	Page       int                   `json:"page"`
+	Url        string                `json:"url"`
+	JobID      string                `json:"job_id"`
+	StatusCode int                   `json:"status_code"`
+	Headers    oxylabs.TargetHeaders `json:"headers"`
+}
+
+func (m resultMeta) results() Results {
+	return Results{
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+		Page:       m.Page,
+		Url:        m.Url,
+		JobID:      m.JobID,
+		StatusCode: m.StatusCode,
+		Headers:    m.Headers,
+	}
+}
+
+// rawResult is a result decoded with oxylabs.DecodeRaw.
+type rawResult struct {
+	resultMeta
+	Content string `json:"content"`
+}
+
+func (r *rawResult) results() Results {
+	results := r.resultMeta.results()
+	results.Content = r.Content
+	results.ContentKind = oxylabs.DetectContentKind(r.Content)
+
+	return results
+}
+
+// parsedResult is a result decoded with oxylabs.DecodeParsed.
+type parsedResult struct {
+	resultMeta
+	ContentParsed Content `json:"content"`
+}
+
+func (r *parsedResult) results() Results {
+	results := r.resultMeta.results()
+	results.ContentParsed = r.ContentParsed
+	results.ContentKind = oxylabs.ContentJSON
+
+	return results
+}
+
+// customParsedResult is a result decoded with oxylabs.DecodeCustomParsed.
+type customParsedResult struct {
+	resultMeta
+	CustomContentParsed map[string]interface{} `json:"content"`
+}
+
+func (r *customParsedResult) results() Results {
+	results := r.resultMeta.results()
+	results.CustomContentParsed = r.CustomContentParsed
+	results.ContentKind = oxylabs.ContentJSON
+
+	return results
+}
@@ -0,0 +1,35 @@
+package ecommerce
+
+import (
+	"fmt"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Screenshot returns the decoded png screenshot of the first result.
+// The job must have been submitted with oxylabs.PNG as render option.
+func (r *Resp) Screenshot() ([]byte, error) {
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("resp has no results")
+	}
+
+	return r.Results[0].Screenshot()
+}
+
+// Screenshot returns the decoded png screenshot of the result.
+// The job must have been submitted with oxylabs.PNG as render option.
+func (r *Results) Screenshot() ([]byte, error) {
+	if r.Content == "" {
+		return nil, fmt.Errorf("result has no content")
+	}
+	if r.contentKind() != oxylabs.ContentPNG {
+		return nil, fmt.Errorf("result content is not a png screenshot")
+	}
+
+	screenshot, err := r.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("error decoding screenshot: %w", err)
+	}
+
+	return screenshot, nil
+}
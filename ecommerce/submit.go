@@ -0,0 +1,134 @@
+package ecommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// asyncJob is a submitted job with the options needed to poll for and decode its results.
+// Payload is needed to resubmit the job if it faults.
+type asyncJob struct {
+	ID           string
+	Payload      []byte
+	PollInterval time.Duration
+	Strategy     oxylabs.DecodeStrategy
+	Meta         map[string]string
+	// Curve is the poll curve of a resumed job, which defaults to the one of its payload.
+	Curve *oxylabs.PollCurve
+}
+
+// SubmitOnly submits a job via Oxylabs E-Commerce API and returns its ID without polling,
+// e.g. when results are delivered to a callback url or cloud storage.
+// The source is selected by the type of opts, e.g. *AmazonUrlOpts for the
+// source of ScrapeAmazonUrl, and query is the url for url sources.
+// The Submit methods of the sources, e.g. SubmitAmazonUrl, take typed opts instead.
+func (c *EcommerceClientAsync) SubmitOnly(
+	ctx context.Context,
+	query string,
+	opts interface{},
+) (string, error) {
+	var job *asyncJob
+	var err error
+	switch o := opts.(type) {
+	case *AmazonUrlOpts:
+		job, err = c.submitAmazonUrl(ctx, query, o)
+	case *AmazonSearchOpts:
+		job, err = c.submitAmazonSearch(ctx, query, o)
+	case *AmazonProductOpts:
+		job, err = c.submitAmazonProduct(ctx, query, o)
+	case *AmazonPricingOpts:
+		job, err = c.submitAmazonPricing(ctx, query, o)
+	case *AmazonReviewsOpts:
+		job, err = c.submitAmazonReviews(ctx, query, o)
+	case *AmazonQuestionsOpts:
+		job, err = c.submitAmazonQuestions(ctx, query, o)
+	case *AmazonBestsellersOpts:
+		job, err = c.submitAmazonBestsellers(ctx, query, o)
+	case *AmazonSellersOpts:
+		job, err = c.submitAmazonSellers(ctx, query, o)
+	case *GoogleShoppingUrlOpts:
+		job, err = c.submitGoogleShoppingUrl(ctx, query, o)
+	case *GoogleShoppingSearchOpts:
+		job, err = c.submitGoogleShoppingSearch(ctx, query, o)
+	case *GoogleShoppingProductOpts:
+		job, err = c.submitGoogleShoppingProduct(ctx, query, o)
+	case *GoogleShoppingPricingOpts:
+		job, err = c.submitGoogleShoppingPricing(ctx, query, o)
+	case *UniversalUrlOpts:
+		job, err = c.submitUniversalUrl(ctx, query, o)
+	case *WayfairSearchOpts:
+		job, err = c.submitWayfairSearch(ctx, query, o)
+	case *WayfairUrlOpts:
+		job, err = c.submitWayfairUrl(ctx, query, o)
+	case *TargetUrlOpts:
+		job, err = c.submitTargetUrl(ctx, query, o)
+	case *TargetSearchOpts:
+		job, err = c.submitTargetSearch(ctx, query, o)
+	case *TargetProductOpts:
+		job, err = c.submitTargetProduct(ctx, query, o)
+	case *EtsySearchOpts:
+		job, err = c.submitEtsySearch(ctx, query, o)
+	case *EtsyProductOpts:
+		job, err = c.submitEtsyProduct(ctx, query, o)
+	case *KrogerUrlOpts:
+		job, err = c.submitKrogerUrl(ctx, query, o)
+	case *KrogerSearchOpts:
+		job, err = c.submitKrogerSearch(ctx, query, o)
+	case *KrogerProductOpts:
+		job, err = c.submitKrogerProduct(ctx, query, o)
+	default:
+		return "", fmt.Errorf("unsupported opts type %T, opts select the source", opts)
+	}
+
+	return c.submitOnly(job, err)
+}
+
+// submitOnly returns the ID of the job submitted without polling.
+func (c *EcommerceClientAsync) submitOnly(job *asyncJob, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+
+	return job.ID, nil
+}
+
+// poll polls the status of the job in the background and returns its async result.
+func (c *EcommerceClientAsync) poll(
+	ctx context.Context,
+	job *asyncJob,
+) *AsyncResult {
+	return oxylabs.RunAsync(ctx, func(ctx context.Context) (*Resp, error) {
+		var httpResp *http.Response
+		for attempt := 1; ; attempt++ {
+			// Poll job status.
+			var err error
+			curve := c.C.PollCurve(job.Payload, job.PollInterval)
+			if job.Curve != nil {
+				curve = *job.Curve
+			}
+			if httpResp, err = c.C.WaitJobCurve(ctx, job.ID, curve); err == nil {
+				break
+			}
+
+			// Resubmit the job if it faulted and retries are enabled.
+			if job.ID, err = c.C.ResubmitFaulted(ctx, job.Payload, attempt, err); err != nil {
+				return nil, err
+			}
+		}
+
+		// Unmarshal the http Response and get the response.
+		resp, err := DecodeRespWith(httpResp, job.Strategy, c.C.Codec())
+		if err != nil {
+			return nil, err
+		}
+		resp.orderResults()
+		resp.Meta = job.Meta
+		resp.Payload = c.C.PayloadEcho(job.Payload)
+
+		return resp, nil
+	})
+}
@@ -0,0 +1,411 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// TargetUrlOpts contains all the query parameters available for target.
+type TargetUrlOpts struct {
+	UserAgent         oxylabs.UserAgent
+	CallbackUrl       string
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *TargetUrlOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "TargetUrlOpts",
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// checkParameterValidity checks validity of ScrapeTargetUrl parameters.
+func (opt *TargetUrlOpts) checkParametersValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ScrapeTargetUrl scrapes target via Oxylabs E-Commerce API with target as source.
+func (c *EcommerceClient) ScrapeTargetUrl(
+	url string,
+	opts ...*TargetUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeTargetUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeTargetUrlCtx scrapes target via Oxylabs E-Commerce API with target as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeTargetUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*TargetUrlOpts,
+) (*Resp, error) {
+	req, err := prepareTargetUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareTargetUrl applies the defaults to the opts of a scrape with target as source,
+// checks them and returns the req.
+func prepareTargetUrl(
+	c *internal.Client,
+	url string,
+	opts []*TargetUrlOpts,
+) (*scrapeReq, error) {
+	// Check validity of url.
+	err := c.CheckUrl(url, "target.com")
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare options.
+	opt := &TargetUrlOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Check validity of parameters.
+	err = opt.checkParametersValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":          oxylabs.Target,
+		"url":             url,
+		"user_agent_type": opt.UserAgent,
+		"callback_url":    opt.CallbackUrl,
+	}
+
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
+	customParserFlag := false
+	if opt.ParseInstructions != nil {
+		payload["parse"] = true
+		payload["parsing_instructions"] = &opt.ParseInstructions
+		customParserFlag = true
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+	if err := internal.CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
+}
+
+// TargetSearchOpts contains all the query parameters available for target_search.
+type TargetSearchOpts struct {
+	StartPage         int
+	Pages             int
+	UserAgent         oxylabs.UserAgent
+	CallbackUrl       string
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *TargetSearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "TargetSearchOpts",
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// checkParameterValidity checks validity of ScrapeTargetSearch parameters.
+func (opt *TargetSearchOpts) checkParametersValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.Pages <= 0 || opt.StartPage <= 0 {
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ScrapeTargetSearch scrapes target via Oxylabs E-Commerce API with target_search as source.
+func (c *EcommerceClient) ScrapeTargetSearch(
+	query string,
+	opts ...*TargetSearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeTargetSearchCtx(ctx, query, opts...)
+}
+
+// ScrapeTargetSearchCtx scrapes target via Oxylabs E-Commerce API with target_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeTargetSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*TargetSearchOpts,
+) (*Resp, error) {
+	req, err := prepareTargetSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareTargetSearch applies the defaults to the opts of a scrape with target_search as source,
+// checks them and returns the req.
+func prepareTargetSearch(
+	c *internal.Client,
+	query string,
+	opts []*TargetSearchOpts,
+) (*scrapeReq, error) {
+	// Prepare options.
+	opt := &TargetSearchOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check validity of parameters.
+	err = opt.checkParametersValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":          oxylabs.TargetSearch,
+		"query":           query,
+		"start_page":      opt.StartPage,
+		"pages":           opt.Pages,
+		"user_agent_type": opt.UserAgent,
+		"callback_url":    opt.CallbackUrl,
+	}
+
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
+	customParserFlag := false
+	if opt.ParseInstructions != nil {
+		payload["parse"] = true
+		payload["parsing_instructions"] = &opt.ParseInstructions
+		customParserFlag = true
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+	if err := internal.CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
+}
+
+// TargetProductOpts contains all the query parameters available for target_product.
+type TargetProductOpts struct {
+	UserAgent         oxylabs.UserAgent
+	CallbackUrl       string
+	Parse             bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *TargetProductOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "TargetProductOpts",
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
+}
+
+// checkParameterValidity checks validity of ScrapeTargetProduct parameters.
+func (opt *TargetProductOpts) checkParametersValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ScrapeTargetProduct scrapes target via Oxylabs E-Commerce API with target_product as source.
+func (c *EcommerceClient) ScrapeTargetProduct(
+	productId string,
+	opts ...*TargetProductOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.ScrapeTargetProductCtx(ctx, productId, opts...)
+}
+
+// ScrapeTargetProductCtx scrapes target via Oxylabs E-Commerce API with target_product as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeTargetProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*TargetProductOpts,
+) (*Resp, error) {
+	req, err := prepareTargetProduct(c.C, productId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareTargetProduct applies the defaults to the opts of a scrape with target_product as source,
+// checks them and returns the req.
+func prepareTargetProduct(
+	c *internal.Client,
+	productId string,
+	opts []*TargetProductOpts,
+) (*scrapeReq, error) {
+	// Check validity of product id.
+	if err := c.CheckProductId(productId); err != nil {
+		return nil, err
+	}
+
+	// Prepare options.
+	opt := &TargetProductOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
+	}
+
+	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Check validity of parameters.
+	err := opt.checkParametersValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare payload.
+	payload := map[string]interface{}{
+		"source":          oxylabs.TargetProduct,
+		"query":           productId,
+		"user_agent_type": opt.UserAgent,
+		"callback_url":    opt.CallbackUrl,
+	}
+
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
+	customParserFlag := false
+	if opt.ParseInstructions != nil {
+		payload["parse"] = true
+		payload["parsing_instructions"] = &opt.ParseInstructions
+		customParserFlag = true
+	}
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+	if err := internal.CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
+}
@@ -0,0 +1,163 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+)
+
+// ScrapeTargetUrl scrapes target with async polling runtime via Oxylabs E-Commerce API
+// and target as source.
+func (c *EcommerceClientAsync) ScrapeTargetUrl(
+	url string,
+	opts ...*TargetUrlOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeTargetUrlCtx(ctx, url, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeTargetUrlCtx scrapes target with async polling runtime via Oxylabs E-Commerce API
+// and target as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeTargetUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*TargetUrlOpts,
+) (*AsyncResult, error) {
+	job, err := c.submitTargetUrl(ctx, url, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.poll(ctx, job), nil
+}
+
+// submitTargetUrl submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitTargetUrl(
+	ctx context.Context,
+	url string,
+	opts ...*TargetUrlOpts,
+) (*asyncJob, error) {
+	req, err := prepareTargetUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req)
+}
+
+// SubmitTargetUrl submits a job via Oxylabs E-Commerce API with target as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitTargetUrl(
+	ctx context.Context,
+	url string,
+	opts ...*TargetUrlOpts,
+) (string, error) {
+	return c.submitOnly(c.submitTargetUrl(ctx, url, opts...))
+}
+
+// ScrapeTargetSearch scrapes target with async polling runtime via Oxylabs E-Commerce API
+// and target_search as source.
+func (c *EcommerceClientAsync) ScrapeTargetSearch(
+	query string,
+	opts ...*TargetSearchOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeTargetSearchCtx(ctx, query, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeTargetSearchCtx scrapes target with async polling runtime via Oxylabs E-Commerce API
+// and target_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeTargetSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*TargetSearchOpts,
+) (*AsyncResult, error) {
+	job, err := c.submitTargetSearch(ctx, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.poll(ctx, job), nil
+}
+
+// submitTargetSearch submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitTargetSearch(
+	ctx context.Context,
+	query string,
+	opts ...*TargetSearchOpts,
+) (*asyncJob, error) {
+	req, err := prepareTargetSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req)
+}
+
+// SubmitTargetSearch submits a job via Oxylabs E-Commerce API with target_search as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitTargetSearch(
+	ctx context.Context,
+	query string,
+	opts ...*TargetSearchOpts,
+) (string, error) {
+	return c.submitOnly(c.submitTargetSearch(ctx, query, opts...))
+}
+
+// ScrapeTargetProduct scrapes target with async polling runtime via Oxylabs E-Commerce API
+// and target_product as source.
+func (c *EcommerceClientAsync) ScrapeTargetProduct(
+	productId string,
+	opts ...*TargetProductOpts,
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeTargetProductCtx(ctx, productId, opts...)
+
+	return internal.CancelOnDone(cancel, res, err)
+}
+
+// ScrapeTargetProductCtx scrapes target with async polling runtime via Oxylabs E-Commerce API
+// and target_product as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeTargetProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*TargetProductOpts,
+) (*AsyncResult, error) {
+	job, err := c.submitTargetProduct(ctx, productId, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.poll(ctx, job), nil
+}
+
+// submitTargetProduct submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitTargetProduct(
+	ctx context.Context,
+	productId string,
+	opts ...*TargetProductOpts,
+) (*asyncJob, error) {
+	req, err := prepareTargetProduct(c.C, productId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req)
+}
+
+// SubmitTargetProduct submits a job via Oxylabs E-Commerce API with target_product as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitTargetProduct(
+	ctx context.Context,
+	productId string,
+	opts ...*TargetProductOpts,
+) (string, error) {
+	return c.submitOnly(c.submitTargetProduct(ctx, productId, opts...))
+}
@@ -0,0 +1,61 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeTargetSearch(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": {"url": "https://www.target.com/s?searchTerm=lego", "title": "Lego",
+			"query": "lego", "page": 2}, "page": 2, "status_code": 200}], "job": {"id": "1", "source": "target_search"}}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	resp, err := c.ScrapeTargetSearch("lego", &TargetSearchOpts{StartPage: 2, Parse: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "target_search", payload["source"])
+	assert.Equal(t, "lego", payload["query"])
+	assert.EqualValues(t, 2, payload["start_page"])
+	assert.EqualValues(t, 1, payload["pages"])
+	assert.Equal(t, true, payload["parse"])
+
+	content := resp.Results[0].ContentParsed
+	assert.Equal(t, "Lego", content.Title)
+	assert.Equal(t, "lego", content.Query)
+	assert.Equal(t, 2, resp.Results[0].Page)
+	assert.Equal(t, "1", resp.Job.ID)
+
+	_, err = c.ScrapeTargetSearch("lego", &TargetSearchOpts{StartPage: -1})
+	assert.EqualError(t, err, "pages and start_page parameters must be greater than 0")
+}
+
+func TestScrapeTargetUrl(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	resp, err := c.ScrapeTargetUrl("https://www.target.com/p/-/A-87450164")
+	assert.NoError(t, err)
+	assert.Equal(t, "target", payload["source"])
+	assert.Equal(t, "https://www.target.com/p/-/A-87450164", payload["url"])
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+
+	_, err = c.ScrapeTargetUrl("https://www.etsy.com/listing/1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package ecommerce
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// TypedResp is the resp of DecodeTypedResp with the content of each result decoded into T.
+type TypedResp[T any] struct {
+	Results    []TypedResults[T] `json:"results"`
+	Job        Job               `json:"job"`
+	StatusCode int               `json:"status_code"`
+	Status     string            `json:"status"`
+}
+
+// TypedResults is a single result of a job with its content decoded into T.
+type TypedResults[T any] struct {
+	Content    T      `json:"content"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+	Page       int    `json:"page"`
+	Url        string `json:"url"`
+	JobID      string `json:"job_id"`
+	StatusCode int    `json:"status_code"`
+}
+
+// DecodeTypedResp returns a TypedResp from the http.Response object of a job
+// submitted with parse=true or parsing instructions, decoding the content of
+// each result straight into T in a single pass, e.g. a struct with only the
+// fields of the parsed product a pipeline needs. Unlike DecodeResp followed by
+// DecodeContent, the content is not decoded into generic values and re-encoded.
+func DecodeTypedResp[T any](httpResp *http.Response) (*TypedResp[T], error) {
+	return DecodeTypedRespWith[T](httpResp, oxylabs.StdCodec{})
+}
+
+// DecodeTypedRespWith returns a TypedResp from the http.Response object like
+// DecodeTypedResp, decoding the JSON with the codec.
+func DecodeTypedRespWith[T any](httpResp *http.Response, codec oxylabs.Codec) (*TypedResp[T], error) {
+	// Read the resp body into a buffer and release the connection.
+	defer httpResp.Body.Close()
+	respBody, release, err := internal.ReadBody(httpResp.Body, codec)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("error with status code %s: %s", httpResp.Status, respBody)
+	}
+
+	// Unmarshal the JSON object.
+	res := &TypedResp[T]{}
+	if err = codec.Unmarshal(respBody, res); err != nil {
+		return nil, fmt.Errorf("error decoding content into %T: %v", *new(T), err)
+	}
+	res.StatusCode = httpResp.StatusCode
+	res.Status = httpResp.Status
+
+	return res, nil
+}
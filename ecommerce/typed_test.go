@@ -0,0 +1,28 @@
+package ecommerce
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeTypedResp(t *testing.T) {
+	httpResp := &http.Response{
+		StatusCode: 200,
+		Status:     "200 OK",
+		Body:       io.NopCloser(strings.NewReader(`{"results": [{"content": {"title": "adidas", "price": 10.5}, "page": 1}], "job": {"id": "1"}}`)),
+	}
+
+	type product struct {
+		Title string  `json:"title"`
+		Price float64 `json:"price"`
+	}
+	resp, err := DecodeTypedResp[product](httpResp)
+	assert.NoError(t, err)
+	assert.Equal(t, product{Title: "adidas", Price: 10.5}, resp.Results[0].Content)
+	assert.Equal(t, "1", resp.Job.ID)
+	assert.Equal(t, 200, resp.StatusCode)
+}
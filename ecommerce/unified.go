@@ -0,0 +1,1004 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// EcommerceUnifiedClient routes scrapes over the integration method configured with
+// oxylabs.WithIntegration and returns their results as Futures, so that the
+// integration method can be switched by configuration. It is safe for concurrent use.
+type EcommerceUnifiedClient struct {
+	C *internal.Client
+}
+
+// InitUnified initializes a unified client with the given credentials and client options.
+func InitUnified(
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) *EcommerceUnifiedClient {
+	// Realtime scrapes and push-pull jobs share the client, and with it
+	// its connection pool, credentials, job limiter and poll scheduler.
+	c := internal.NewClient(internal.SyncBaseUrl, username, password, opts...)
+	c.AsyncUrl = internal.AsyncBaseUrl
+
+	return &EcommerceUnifiedClient{C: c}
+}
+
+// sync returns the client scraping over the realtime integration.
+func (c *EcommerceUnifiedClient) sync() *EcommerceClient {
+	return &EcommerceClient{C: c.C}
+}
+
+// async returns the client scraping over the push-pull integration.
+func (c *EcommerceUnifiedClient) async() *EcommerceClientAsync {
+	return &EcommerceClientAsync{C: c.C}
+}
+
+// integration returns the configured integration method.
+func (c *EcommerceUnifiedClient) integration() oxylabs.Integration {
+	if c.C.Config == nil || c.C.Config.Integration == "" {
+		return oxylabs.Realtime
+	}
+
+	return c.C.Config.Integration
+}
+
+// realtimeCtx returns the context of a realtime scrape, limited
+// to the push-pull fallback timeout if one is configured.
+func (c *EcommerceUnifiedClient) realtimeCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.C.Config == nil || c.C.Config.PushPullFallback == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, c.C.Config.PushPullFallback)
+}
+
+// fallback reports whether a failed realtime scrape should be retried with
+// push-pull. Fallback is opt-in with oxylabs.WithPushPullFallback, as a scrape
+// which was not done within the fallback timeout may still run and be billed.
+// Scrapes which failed before they were sent are retried as well.
+func (c *EcommerceUnifiedClient) fallback(ctx context.Context, realtimeCtx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil || c.C.Config == nil || c.C.Config.PushPullFallback == 0 {
+		return false
+	}
+
+	return errors.Is(realtimeCtx.Err(), context.DeadlineExceeded) || internal.NotSent(err)
+}
+
+// realtime runs the realtime scrape in the background and returns its Future
+// right away, as for push-pull, so that callers wait in Future.Get for both.
+// The scrape is retried with push-pull by fallback, see the fallback method.
+func (c *EcommerceUnifiedClient) realtime(
+	ctx context.Context,
+	scrape func(ctx context.Context) (*Resp, error),
+	fallback func(ctx context.Context) (*AsyncResult, error),
+) *Future {
+	return FromAsync(oxylabs.RunAsync(ctx, func(ctx context.Context) (*Resp, error) {
+		realtimeCtx, cancel := c.realtimeCtx(ctx)
+		defer cancel()
+
+		resp, err := scrape(realtimeCtx)
+		if !c.fallback(ctx, realtimeCtx, err) {
+			return resp, err
+		}
+
+		return FromAsync(fallback(ctx)).Get(ctx)
+	}), nil)
+}
+
+// unsupportedIntegration returns the error of a source which does
+// not support the configured integration method.
+func (c *EcommerceUnifiedClient) unsupportedIntegration(source oxylabs.Source) *Future {
+	return FromResp(nil, fmt.Errorf("source %s does not support the %s integration", source, c.integration()))
+}
+
+// proxyReq scrapes url via the proxy endpoint. The url must belong to host, if set.
+// check validates the opts of f once the client defaults are applied, as for the
+// other integration methods.
+func (c *EcommerceUnifiedClient) proxyReq(
+	ctx context.Context,
+	url string,
+	host string,
+	f internal.Fields,
+	check func() error,
+	meta map[string]string,
+) (*Resp, error) {
+	// Check validity of URL.
+	if err := c.C.CheckUrl(url, host); err != nil {
+		return nil, err
+	}
+
+	// Apply client defaults.
+	c.C.ApplyDefaults(f, 0)
+
+	// Check validity of parameters.
+	if err := check(); err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.C.ProxyReq(ctx, url, f)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	result := Results{Url: url, StatusCode: httpResp.StatusCode}
+	parse := f.Parse != nil && *f.Parse
+	if parse {
+		if err = json.Unmarshal(body, &result.ContentParsed); err != nil {
+			return nil, fmt.Errorf("error unmarshalling parsed content: %v", err)
+		}
+	} else {
+		result.Content = string(body)
+	}
+
+	return &Resp{
+		Parse:      parse,
+		Results:    []Results{result},
+		StatusCode: httpResp.StatusCode,
+		Status:     httpResp.Status,
+		Meta:       meta,
+	}, nil
+}
+
+// proxy scrapes url via the proxy endpoint in the background, see proxyReq.
+func (c *EcommerceUnifiedClient) proxy(
+	ctx context.Context,
+	url string,
+	host string,
+	f internal.Fields,
+	check func() error,
+	meta map[string]string,
+) *Future {
+	return FromAsync(oxylabs.RunAsync(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.proxyReq(ctx, url, host, f, check, meta)
+	}), nil)
+}
+
+// ScrapeAmazonUrl scrapes amazon via Oxylabs E-Commerce API with amazon as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeAmazonUrl(
+	url string,
+	opts ...*AmazonUrlOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeAmazonUrlCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeAmazonUrlCtx scrapes amazon via Oxylabs E-Commerce API with amazon as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeAmazonUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*AmazonUrlOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeAmazonUrlCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		opt := &AmazonUrlOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that defaults are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+		return c.proxy(ctx, url, "amazon", opt.fields(), opt.Validate, opt.Meta)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeAmazonUrlCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeAmazonUrlCtx(ctx, url, opts...)
+	})
+}
+
+// ScrapeAmazonSearch scrapes amazon via Oxylabs E-Commerce API with amazon_search as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeAmazonSearch(
+	query string,
+	opts ...*AmazonSearchOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeAmazonSearchCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeAmazonSearchCtx scrapes amazon via Oxylabs E-Commerce API with amazon_search as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeAmazonSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSearchOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeAmazonSearchCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.AmazonSearch)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeAmazonSearchCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeAmazonSearchCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeAmazonProduct scrapes amazon via Oxylabs E-Commerce API with amazon_product as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeAmazonProduct(
+	query string,
+	opts ...*AmazonProductOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeAmazonProductCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeAmazonProductCtx scrapes amazon via Oxylabs E-Commerce API with amazon_product as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeAmazonProductCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonProductOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeAmazonProductCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.AmazonProduct)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeAmazonProductCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeAmazonProductCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeAmazonPricing scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeAmazonPricing(
+	query string,
+	opts ...*AmazonPricingOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeAmazonPricingCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeAmazonPricingCtx scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeAmazonPricingCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonPricingOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeAmazonPricingCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.AmazonPricing)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeAmazonPricingCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeAmazonPricingCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeAmazonReviews scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeAmazonReviews(
+	query string,
+	opts ...*AmazonReviewsOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeAmazonReviewsCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeAmazonReviewsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeAmazonReviewsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonReviewsOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeAmazonReviewsCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.AmazonReviews)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeAmazonReviewsCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeAmazonReviewsCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeAmazonQuestions scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeAmazonQuestions(
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeAmazonQuestionsCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeAmazonQuestionsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeAmazonQuestionsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeAmazonQuestionsCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.AmazonQuestions)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeAmazonQuestionsCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeAmazonQuestionsCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeAmazonBestsellers scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeAmazonBestsellers(
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeAmazonBestsellersCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeAmazonBestsellersCtx scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeAmazonBestsellersCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeAmazonBestsellersCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.AmazonBestsellers)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeAmazonBestsellersCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeAmazonBestsellersCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeAmazonSellers scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeAmazonSellers(
+	query string,
+	opts ...*AmazonSellersOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeAmazonSellersCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeAmazonSellersCtx scrapes amazon via Oxylabs E-Commerce API with amazon_sellers as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeAmazonSellersCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSellersOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeAmazonSellersCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.AmazonSellers)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeAmazonSellersCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeAmazonSellersCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeGoogleShoppingUrl scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeGoogleShoppingUrl(
+	url string,
+	opts ...*GoogleShoppingUrlOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleShoppingUrlCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleShoppingUrlCtx scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeGoogleShoppingUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleShoppingUrlOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleShoppingUrlCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		opt := &GoogleShoppingUrlOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that defaults are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+		return c.proxy(ctx, url, "shopping.google", opt.fields(), opt.Validate, opt.Meta)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
+	})
+}
+
+// ScrapeGoogleShoppingSearch scrapes google shopping via Oxylabs E-Commerce API with google_shopping_search as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeGoogleShoppingSearch(
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleShoppingSearchCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleShoppingSearchCtx scrapes google shopping via Oxylabs E-Commerce API with google_shopping_search as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeGoogleShoppingSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleShoppingSearchCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleShoppingSearch)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeGoogleShoppingProduct scrapes google shopping via Oxylabs E-Commerce API with google_shopping_product as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeGoogleShoppingProduct(
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleShoppingProductCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleShoppingProductCtx scrapes google shopping via Oxylabs E-Commerce API with google_shopping_product as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeGoogleShoppingProductCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleShoppingProductCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleShoppingProduct)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeGoogleShoppingPricing scrapes google shopping via Oxylabs E-Commerce API with google_shopping_pricing as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeGoogleShoppingPricing(
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleShoppingPricingCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleShoppingPricingCtx scrapes google shopping via Oxylabs E-Commerce API with google_shopping_pricing as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeGoogleShoppingPricingCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleShoppingPricingCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleShoppingPricing)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeUniversalUrl scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeUniversalUrl(
+	url string,
+	opts ...*UniversalUrlOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeUniversalUrlCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeUniversalUrlCtx scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeUniversalUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*UniversalUrlOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeUniversalUrlCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		opt := &UniversalUrlOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that defaults are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+		return c.proxy(ctx, url, "", opt.fields(), opt.Validate, opt.Meta)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeUniversalUrlCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeUniversalUrlCtx(ctx, url, opts...)
+	})
+}
+
+// ScrapeWayfairSearch scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeWayfairSearch(
+	query string,
+	opts ...*WayfairSearchOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeWayfairSearchCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeWayfairSearchCtx scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeWayfairSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*WayfairSearchOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeWayfairSearchCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.WayfairSearch)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeWayfairSearchCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeWayfairSearchCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeWayfairUrl scrapes wayfair via Oxylabs E-Commerce API with wayfair as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeWayfairUrl(
+	url string,
+	opts ...*WayfairUrlOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeWayfairUrlCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeWayfairUrlCtx scrapes wayfair via Oxylabs E-Commerce API with wayfair as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeWayfairUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*WayfairUrlOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeWayfairUrlCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		opt := &WayfairUrlOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that defaults are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+		return c.proxy(ctx, url, "wayfair", opt.fields(), opt.Validate, opt.Meta)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeWayfairUrlCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeWayfairUrlCtx(ctx, url, opts...)
+	})
+}
+
+// ScrapeTargetUrl scrapes target via Oxylabs E-Commerce API with target as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeTargetUrl(
+	url string,
+	opts ...*TargetUrlOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeTargetUrlCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeTargetUrlCtx scrapes target via Oxylabs E-Commerce API with target as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeTargetUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*TargetUrlOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeTargetUrlCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		opt := &TargetUrlOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that defaults are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+		return c.proxy(ctx, url, "target.com", opt.fields(), opt.Validate, opt.Meta)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeTargetUrlCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeTargetUrlCtx(ctx, url, opts...)
+	})
+}
+
+// ScrapeTargetSearch scrapes target via Oxylabs E-Commerce API with target_search as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeTargetSearch(
+	query string,
+	opts ...*TargetSearchOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeTargetSearchCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeTargetSearchCtx scrapes target via Oxylabs E-Commerce API with target_search as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeTargetSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*TargetSearchOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeTargetSearchCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.TargetSearch)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeTargetSearchCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeTargetSearchCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeTargetProduct scrapes target via Oxylabs E-Commerce API with target_product as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeTargetProduct(
+	productId string,
+	opts ...*TargetProductOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeTargetProductCtx(ctx, productId, opts...), nil)
+
+	return future
+}
+
+// ScrapeTargetProductCtx scrapes target via Oxylabs E-Commerce API with target_product as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeTargetProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*TargetProductOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeTargetProductCtx(ctx, productId, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.TargetProduct)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeTargetProductCtx(ctx, productId, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeTargetProductCtx(ctx, productId, opts...)
+	})
+}
+
+// ScrapeEtsySearch scrapes etsy via Oxylabs E-Commerce API with etsy_search as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeEtsySearch(
+	query string,
+	opts ...*EtsySearchOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeEtsySearchCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeEtsySearchCtx scrapes etsy via Oxylabs E-Commerce API with etsy_search as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeEtsySearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*EtsySearchOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeEtsySearchCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.EtsySearch)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeEtsySearchCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeEtsySearchCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeEtsyProduct scrapes etsy via Oxylabs E-Commerce API with etsy_product as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeEtsyProduct(
+	productId string,
+	opts ...*EtsyProductOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeEtsyProductCtx(ctx, productId, opts...), nil)
+
+	return future
+}
+
+// ScrapeEtsyProductCtx scrapes etsy via Oxylabs E-Commerce API with etsy_product as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeEtsyProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*EtsyProductOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeEtsyProductCtx(ctx, productId, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.EtsyProduct)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeEtsyProductCtx(ctx, productId, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeEtsyProductCtx(ctx, productId, opts...)
+	})
+}
+
+// ScrapeKrogerUrl scrapes kroger via Oxylabs E-Commerce API with kroger as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeKrogerUrl(
+	url string,
+	opts ...*KrogerUrlOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeKrogerUrlCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeKrogerUrlCtx scrapes kroger via Oxylabs E-Commerce API with kroger as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeKrogerUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*KrogerUrlOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeKrogerUrlCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		opt := &KrogerUrlOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that defaults are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+		return c.proxy(ctx, url, "kroger.com", opt.fields(), opt.Validate, opt.Meta)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeKrogerUrlCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeKrogerUrlCtx(ctx, url, opts...)
+	})
+}
+
+// ScrapeKrogerSearch scrapes kroger via Oxylabs E-Commerce API with kroger_search as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeKrogerSearch(
+	query string,
+	opts ...*KrogerSearchOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeKrogerSearchCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeKrogerSearchCtx scrapes kroger via Oxylabs E-Commerce API with kroger_search as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeKrogerSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*KrogerSearchOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeKrogerSearchCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.KrogerSearch)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeKrogerSearchCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeKrogerSearchCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeKrogerProduct scrapes kroger via Oxylabs E-Commerce API with kroger_product as source
+// over the configured integration method.
+func (c *EcommerceUnifiedClient) ScrapeKrogerProduct(
+	productId string,
+	opts ...*KrogerProductOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeKrogerProductCtx(ctx, productId, opts...), nil)
+
+	return future
+}
+
+// ScrapeKrogerProductCtx scrapes kroger via Oxylabs E-Commerce API with kroger_product as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceUnifiedClient) ScrapeKrogerProductCtx(
+	ctx context.Context,
+	productId string,
+	opts ...*KrogerProductOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeKrogerProductCtx(ctx, productId, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.KrogerProduct)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeKrogerProductCtx(ctx, productId, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeKrogerProductCtx(ctx, productId, opts...)
+	})
+}
+
+// Close rejects new scrapes, cancels in-flight polling, waits for in-flight
+// realtime reqs to return and closes idle connections.
+func (c *EcommerceUnifiedClient) Close() error {
+	return c.C.Close()
+}
+
+// Shutdown rejects new scrapes and waits for in-flight polling and realtime reqs to finish,
+// see EcommerceClientAsync.Shutdown.
+func (c *EcommerceUnifiedClient) Shutdown(ctx context.Context) error {
+	return c.C.Shutdown(ctx)
+}
+
+// Ping validates the credentials and the reachability of the API.
+func (c *EcommerceUnifiedClient) Ping(ctx context.Context) error {
+	return c.C.Ping(ctx)
+}
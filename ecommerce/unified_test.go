@@ -0,0 +1,107 @@
+package ecommerce
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEcommerceUnifiedClient_Fallback(t *testing.T) {
+	done := make(chan struct{})
+	realtime := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer realtime.Close()
+	defer close(done)
+
+	var submitted int32
+	pushPull := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": [{"content": "push-pull", "job_id": "123", "status_code": 200}]}`))
+		default:
+			atomic.AddInt32(&submitted, 1)
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		}
+	}))
+	defer pushPull.Close()
+
+	c := InitUnified("username", "password", oxylabs.WithPushPullFallback(50*time.Millisecond))
+	c.C.HttpClient = &http.Client{Transport: hostTransport{
+		"realtime.oxylabs.io": mustParse(realtime.URL),
+		"data.oxylabs.io":     mustParse(pushPull.URL),
+	}}
+
+	resp, err := c.ScrapeAmazonSearch("adidas", &AmazonSearchOpts{PollInterval: time.Millisecond}).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "push-pull", resp.Results[0].Content)
+	assert.EqualValues(t, 1, submitted)
+}
+
+func TestEcommerceUnifiedClient_ProxyEndpoint(t *testing.T) {
+	var header http.Header
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Write([]byte("<html></html>"))
+	}))
+	defer proxy.Close()
+
+	c := InitUnified("username", "password", oxylabs.WithIntegration(oxylabs.ProxyEndpoint))
+	c.C.ProxyHost = proxy.Listener.Addr().String()
+
+	opts := &AmazonUrlOpts{Render: oxylabs.HTML}
+	resp, err := c.ScrapeAmazonUrl("http://www.amazon.com/dp/B0BSHF7WHW", opts).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+	assert.Equal(t, "html", header.Get("x-oxylabs-render"))
+	assert.Equal(t, "desktop", header.Get("x-oxylabs-user-agent-type"))
+	assert.Empty(t, opts.UserAgent)
+}
+
+func TestEcommerceUnifiedClient_ProxyEndpointInvalidOpts(t *testing.T) {
+	var reqs int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&reqs, 1)
+	}))
+	defer proxy.Close()
+
+	c := InitUnified("username", "password", oxylabs.WithIntegration(oxylabs.ProxyEndpoint))
+	c.C.ProxyHost = proxy.Listener.Addr().String()
+
+	// The opts are checked like over the other integrations.
+	_, err := c.ScrapeAmazonUrl("http://www.amazon.com/dp/B0BSHF7WHW", &AmazonUrlOpts{Render: "pdf"}).Get(context.Background())
+	assert.ErrorContains(t, err, "invalid render parameter")
+
+	_, err = c.ScrapeUniversalUrl("https://example.com", &UniversalUrlOpts{UserAgent: "fridge"}).Get(context.Background())
+	assert.ErrorContains(t, err, "invalid user agent parameter")
+	assert.EqualValues(t, 0, reqs)
+}
+
+// hostTransport sends the reqs to each host to its test server.
+type hostTransport map[string]*url.URL
+
+func (t hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target := t[req.URL.Hostname()]
+	req.URL.Scheme = target.Scheme
+	req.URL.Host = target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func mustParse(rawUrl string) *url.URL {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
@@ -2,8 +2,11 @@ package ecommerce
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
@@ -12,45 +15,156 @@ import (
 
 // UniversalUrlOpts contains all the query parameters available for universal url scrape.
 type UniversalUrlOpts struct {
-	UserAgent         oxylabs.UserAgent
-	CallbackUrl       string
-	GeoLocation       string
-	Locale            oxylabs.Locale
-	Render            oxylabs.Render
-	ContentEncoding   string
-	Context           []func(oxylabs.ContextOption)
-	CallbackURL       string
-	Parse             bool
-	ParserType        interface{}
-	ParseInstructions *map[string]interface{}
-	PollInterval      time.Duration
+	UserAgent       oxylabs.UserAgent
+	CustomUserAgent string
+	CallbackUrl     string
+	GeoLocation     oxylabs.GeoLocation
+	Locale          oxylabs.Locale
+	Render          oxylabs.Render
+	// BrowserInstructions are performed while rendering, e.g. waiting for an element.
+	BrowserInstructions []oxylabs.BrowserInstruction
+	// JavaScript set to false renders the html with JavaScript disabled,
+	// which is faster when the content does not depend on scripts.
+	JavaScript            *bool
+	ContentEncoding       string
+	Context               []func(oxylabs.ContextOption)
+	Headers               map[string]string
+	Cookies               []oxylabs.Cookie
+	SessionId             string
+	FollowRedirects       *bool
+	HttpMethod            string
+	Content               []byte
+	SuccessfulStatusCodes []int
+	CallbackURL           string
+	Parse                 bool
+	ParserType            interface{}
+	ParseInstructions     *map[string]interface{}
+	PollInterval          time.Duration
+	RequestTimeout        time.Duration
+	Meta                  map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *UniversalUrlOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:                  "UniversalUrlOpts",
+		UserAgent:             &opt.UserAgent,
+		CustomUserAgent:       &opt.CustomUserAgent,
+		CallbackUrl:           &opt.CallbackUrl,
+		GeoLocation:           &opt.GeoLocation,
+		Locale:                &opt.Locale,
+		Render:                &opt.Render,
+		ContentEncoding:       &opt.ContentEncoding,
+		Context:               &opt.Context,
+		Headers:               &opt.Headers,
+		Cookies:               &opt.Cookies,
+		SessionId:             &opt.SessionId,
+		HttpMethod:            &opt.HttpMethod,
+		Content:               &opt.Content,
+		SuccessfulStatusCodes: &opt.SuccessfulStatusCodes,
+		Parse:                 &opt.Parse,
+		ParseInstructions:     &opt.ParseInstructions,
+		PollInterval:          &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of UniversalUrlOpts parameters.
 func (opt *UniversalUrlOpts) checkParametersValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
+	if opt.CustomUserAgent != "" && !oxylabs.IsCustomUserAgentValid(opt.CustomUserAgent) {
+		errs = append(errs, fmt.Errorf("invalid custom user agent parameter: %q", opt.CustomUserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if (opt.BrowserInstructions != nil || opt.JavaScript != nil) && opt.Render != oxylabs.HTML {
+		errs = append(errs, fmt.Errorf("browser instructions and javascript require html render"))
+	}
+
+	if err := oxylabs.ValidateBrowserInstructions(opt.BrowserInstructions); err != nil {
+		errs = append(errs, err)
 	}
 
 	if ctx["http_method"] != "post" && ctx["http_method"] != "get" {
-		return fmt.Errorf("invalid http method")
+		errs = append(errs, fmt.Errorf("invalid http method"))
 	}
 
 	if ctx["content"] != nil && ctx["http_method"] != "post" {
-		return fmt.Errorf("content is useful only if http method is post")
+		errs = append(errs, fmt.Errorf("content is useful only if http method is post"))
+	}
+
+	if codes, ok := ctx["successful_status_codes"].([]int); ok {
+		for _, code := range codes {
+			if code < 100 || code > 599 {
+				errs = append(errs, fmt.Errorf("invalid successful_status_codes parameter: %v", code))
+			}
+		}
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
+}
+
+// applyContext applies the context modifiers and the typed context fields to ctx.
+// Typed fields take precedence over the context modifiers.
+func (opt *UniversalUrlOpts) applyContext(ctx oxylabs.ContextOption) {
+	for _, modifier := range opt.Context {
+		modifier(ctx)
+	}
+
+	if opt.Headers != nil {
+		oxylabs.Headers(opt.Headers)(ctx)
+	}
+	if opt.Cookies != nil {
+		oxylabs.Cookies(opt.Cookies)(ctx)
+	}
+	if opt.SessionId != "" {
+		oxylabs.SessionId(opt.SessionId)(ctx)
+	}
+	if opt.FollowRedirects != nil {
+		oxylabs.FollowRedirects(*opt.FollowRedirects)(ctx)
+	}
+	if opt.HttpMethod != "" {
+		oxylabs.HttpMethod(opt.HttpMethod)(ctx)
+	}
+	if opt.Content != nil {
+		// The API expects the req body base64 encoded.
+		oxylabs.Content(base64.StdEncoding.EncodeToString(opt.Content))(ctx)
+	}
+	if opt.SuccessfulStatusCodes != nil {
+		oxylabs.SuccessfulStatusCodes(opt.SuccessfulStatusCodes)(ctx)
+	}
+	if method, ok := ctx["http_method"].(string); ok {
+		ctx["http_method"] = strings.ToLower(method)
+	}
+
+	// A literal User-Agent is sent to the target as a header.
+	if opt.CustomUserAgent != "" {
+		headers := map[string]string{}
+		if h, ok := ctx["headers"].(map[string]string); ok {
+			for k, v := range h {
+				headers[k] = v
+			}
+		}
+		headers["User-Agent"] = opt.CustomUserAgent
+		oxylabs.Headers(headers)(ctx)
+	}
 }
 
 // ScrapeUniversalUrl scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source.
@@ -58,7 +172,7 @@ func (c *EcommerceClient) ScrapeUniversalUrl(
 	url string,
 	opts ...*UniversalUrlOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeUniversalUrlCtx(ctx, url, opts...)
@@ -71,22 +185,36 @@ func (c *EcommerceClient) ScrapeUniversalUrlCtx(
 	url string,
 	opts ...*UniversalUrlOpts,
 ) (*Resp, error) {
+	req, err := prepareUniversalUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareUniversalUrl applies the defaults to the opts of a scrape with universal_ecommerce as source,
+// checks them and returns the req.
+func prepareUniversalUrl(
+	c *internal.Client,
+	url string,
+	opts []*UniversalUrlOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &UniversalUrlOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map and apply each provided context modifier function.
 	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
+	opt.applyContext(context)
 
 	// Set defaults.
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 	internal.SetDefaultHttpMethod(context)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultContentEncoding(&opt.ContentEncoding)
 
 	// Check validity of parameters.
 	err := opt.checkParametersValidity(context)
@@ -138,6 +266,14 @@ func (c *EcommerceClient) ScrapeUniversalUrlCtx(
 		"parser_type":  opt.ParserType,
 	}
 
+	// Add the rendering options to the payload if provided.
+	if opt.BrowserInstructions != nil {
+		payload["browser_instructions"] = opt.BrowserInstructions
+	}
+	if opt.JavaScript != nil {
+		payload["js"] = *opt.JavaScript
+	}
+
 	// Add custom parsing instructions to the payload if provided.
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
@@ -150,18 +286,14 @@ func (c *EcommerceClient) ScrapeUniversalUrlCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
@@ -2,12 +2,8 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
-	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // ScrapeUniversalUrl scrapes all urls with async polling runtime via Oxylabs E-Commerce API
@@ -15,11 +11,11 @@ import (
 func (c *EcommerceClientAsync) ScrapeUniversalUrl(
 	url string,
 	opts ...*UniversalUrlOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeUniversalUrlCtx(ctx, url, opts...)
 
-	return c.ScrapeUniversalUrlCtx(ctx, url, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeUniversalUrlCtx scrapes all urls with async polling runtime via Oxylabs E-Commerce API
@@ -29,124 +25,35 @@ func (c *EcommerceClientAsync) ScrapeUniversalUrlCtx(
 	ctx context.Context,
 	url string,
 	opts ...*UniversalUrlOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &UniversalUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultHttpMethod(context)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultContentEncoding(&opt.ContentEncoding)
-
-	// Check validity of parameters.
-	err := opt.checkParametersValidity(context)
+) (*AsyncResult, error) {
+	job, err := c.submitUniversalUrl(ctx, url, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":           oxylabs.Universal,
-		"url":              url,
-		"user_agent_type":  opt.UserAgent,
-		"geo_location":     opt.GeoLocation,
-		"locale":           opt.Locale,
-		"render":           opt.Render,
-		"content_encoding": opt.ContentEncoding,
-		"context": []map[string]interface{}{
-			{
-				"key":   "content",
-				"value": context["content"],
-			},
-			{
-				"key":   "cookies",
-				"value": context["cookies"],
-			},
-			{
-				"key":   "follow_redirects",
-				"value": context["follow_redirects"],
-			},
-			{
-				"key":   "headers",
-				"value": context["headers"],
-			},
-			{
-				"key":   "http_method",
-				"value": context["http_method"],
-			},
-			{
-				"key":   "session_id",
-				"value": context["session_id"],
-			},
-			{
-				"key":   "successful_status_codes",
-				"value": context["successful_status_codes"],
-			},
-		},
-		"callback_url": opt.CallbackUrl,
-		"parse":        opt.Parse,
-		"parser_type":  opt.ParserType,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	/// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitUniversalUrl submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitUniversalUrl(
+	ctx context.Context,
+	url string,
+	opts ...*UniversalUrlOpts,
+) (*asyncJob, error) {
+	req, err := prepareUniversalUrl(c.C, url, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitUniversalUrl submits a job via Oxylabs E-Commerce API with universal_ecommerce as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitUniversalUrl(
+	ctx context.Context,
+	url string,
+	opts ...*UniversalUrlOpts,
+) (string, error) {
+	return c.submitOnly(c.submitUniversalUrl(ctx, url, opts...))
 }
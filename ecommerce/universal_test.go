@@ -0,0 +1,168 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeUniversalUrl_Rendering(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	js := false
+	_, err := c.ScrapeUniversalUrl("https://example.com", &UniversalUrlOpts{
+		Render:     oxylabs.HTML,
+		JavaScript: &js,
+		BrowserInstructions: []oxylabs.BrowserInstruction{
+			oxylabs.WaitForElement(oxylabs.Selector{Type: oxylabs.SelectorCss, Value: "#price"}, 1500*time.Millisecond),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, false, payload["js"])
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"type":      "wait_for_element",
+		"selector":  map[string]interface{}{"type": "css", "value": "#price"},
+		"timeout_s": float64(2),
+	}}, payload["browser_instructions"])
+
+	assert.EqualError(t, (&UniversalUrlOpts{JavaScript: &js}).Validate(),
+		"browser instructions and javascript require html render")
+	assert.EqualError(t, (&UniversalUrlOpts{
+		Render:              oxylabs.HTML,
+		BrowserInstructions: []oxylabs.BrowserInstruction{oxylabs.Click(oxylabs.Selector{Type: "id", Value: "buy"})},
+	}).Validate(), "invalid browser instruction 0: invalid selector type: id")
+}
+
+func TestScrapeUniversalUrl_CustomUserAgent(t *testing.T) {
+	var payload struct {
+		Context []struct {
+			Key   string      `json:"key"`
+			Value interface{} `json:"value"`
+		} `json:"context"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	opts, err := NewOpts[UniversalUrlOpts](WithCustomUserAgent("Mozilla/5.0 Firefox/128.0"))
+	assert.NoError(t, err)
+	opts.Headers = map[string]string{"Accept-Language": "de"}
+
+	_, err = c.ScrapeUniversalUrl("https://example.com", opts)
+	assert.NoError(t, err)
+	// The User-Agent is merged into the headers of the context.
+	var headers interface{}
+	for _, entry := range payload.Context {
+		if entry.Key == "headers" {
+			headers = entry.Value
+		}
+	}
+	assert.Equal(t, map[string]interface{}{
+		"Accept-Language": "de",
+		"User-Agent":      "Mozilla/5.0 Firefox/128.0",
+	}, headers)
+	assert.Equal(t, map[string]string{"Accept-Language": "de"}, opts.Headers)
+
+	_, err = c.ScrapeUniversalUrl("https://example.com", &UniversalUrlOpts{CustomUserAgent: "a\nb"})
+	assert.EqualError(t, err, `invalid custom user agent parameter: "a\nb"`)
+}
+
+func TestScrapeUniversalUrl_HttpMethod(t *testing.T) {
+	var payload struct {
+		Context []struct {
+			Key   string      `json:"key"`
+			Value interface{} `json:"value"`
+		} `json:"context"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	_, err := c.ScrapeUniversalUrl("https://example.com", &UniversalUrlOpts{
+		HttpMethod:            "POST",
+		Content:               []byte(`{"query": "adidas"}`),
+		SuccessfulStatusCodes: []int{200, 404},
+	})
+	assert.NoError(t, err)
+	context := map[string]interface{}{}
+	for _, entry := range payload.Context {
+		context[entry.Key] = entry.Value
+	}
+	// The method is lowercased and the content is sent base64 encoded.
+	assert.Equal(t, "post", context["http_method"])
+	assert.Equal(t, "eyJxdWVyeSI6ICJhZGlkYXMifQ==", context["content"])
+	assert.Equal(t, []interface{}{float64(200), float64(404)}, context["successful_status_codes"])
+
+	// The method defaults to get.
+	_, err = c.ScrapeUniversalUrl("https://example.com", &UniversalUrlOpts{})
+	assert.NoError(t, err)
+	for _, entry := range payload.Context {
+		if entry.Key == "http_method" {
+			assert.Equal(t, "get", entry.Value)
+		}
+	}
+
+	assert.ErrorContains(t, (&UniversalUrlOpts{HttpMethod: "put"}).Validate(), "invalid http method")
+	assert.ErrorContains(t, (&UniversalUrlOpts{Content: []byte("adidas")}).Validate(), "content is useful only if http method is post")
+	assert.ErrorContains(t, (&UniversalUrlOpts{SuccessfulStatusCodes: []int{99}}).Validate(), "invalid successful_status_codes parameter: 99")
+}
+
+func TestScrapeUniversalUrl_HeadersAndCookies(t *testing.T) {
+	var payload struct {
+		Context []struct {
+			Key   string      `json:"key"`
+			Value interface{} `json:"value"`
+		} `json:"context"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	opts, err := NewOpts[UniversalUrlOpts](
+		WithHeaders(map[string]string{"Authorization": "Bearer token"}),
+		WithCookies([]oxylabs.Cookie{{Key: "ab_bucket", Value: "b"}}),
+	)
+	assert.NoError(t, err)
+	_, err = c.ScrapeUniversalUrl("https://example.com", opts)
+	assert.NoError(t, err)
+	context := map[string]interface{}{}
+	for _, entry := range payload.Context {
+		context[entry.Key] = entry.Value
+	}
+	assert.Equal(t, map[string]interface{}{"Authorization": "Bearer token"}, context["headers"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"key": "ab_bucket", "value": "b"}}, context["cookies"])
+
+	// Sources whose context does not support them reject headers and cookies.
+	_, err = NewOpts[AmazonSearchOpts](WithHeaders(map[string]string{"Authorization": "Bearer token"}))
+	assert.EqualError(t, err, "option Headers is not supported by AmazonSearchOpts")
+	_, err = NewOpts[AmazonProductOpts](WithCookies([]oxylabs.Cookie{{Key: "ab_bucket", Value: "b"}}))
+	assert.EqualError(t, err, "option Cookies is not supported by AmazonProductOpts")
+}
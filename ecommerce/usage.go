@@ -0,0 +1,48 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// GetUsageStats retrieves the usage statistics of the account, the number of
+// results retrieved per source. Plan limits and the remaining quota are not included.
+func (c *EcommerceClient) GetUsageStats(
+	opts ...*oxylabs.UsageStatsOpts,
+) (*oxylabs.UsageStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.GetUsageStatsCtx(ctx, opts...)
+}
+
+// GetUsageStatsCtx retrieves the usage statistics of the account.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) GetUsageStatsCtx(
+	ctx context.Context,
+	opts ...*oxylabs.UsageStatsOpts,
+) (*oxylabs.UsageStats, error) {
+	return c.C.GetUsageStats(ctx, internal.LastOpts(opts))
+}
+
+// GetUsageStats retrieves the usage statistics of the account, the number of
+// results retrieved per source. Plan limits and the remaining quota are not included.
+func (c *EcommerceClientAsync) GetUsageStats(
+	opts ...*oxylabs.UsageStatsOpts,
+) (*oxylabs.UsageStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return c.GetUsageStatsCtx(ctx, opts...)
+}
+
+// GetUsageStatsCtx retrieves the usage statistics of the account.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) GetUsageStatsCtx(
+	ctx context.Context,
+	opts ...*oxylabs.UsageStatsOpts,
+) (*oxylabs.UsageStats, error) {
+	return c.C.GetUsageStats(ctx, internal.LastOpts(opts))
+}
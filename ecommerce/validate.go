@@ -0,0 +1,226 @@
+package ecommerce
+
+import (
+	"fmt"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+)
+
+// Validate checks the options with the SDK defaults applied, as ScrapeAmazonUrl would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *AmazonUrlOpts) Validate() error {
+	_, err := prepareAmazonUrl(nil, "", []*AmazonUrlOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeAmazonSearch would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *AmazonSearchOpts) Validate() error {
+	_, err := prepareAmazonSearch(nil, "", []*AmazonSearchOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeAmazonProduct would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *AmazonProductOpts) Validate() error {
+	_, err := prepareAmazonProduct(nil, "", []*AmazonProductOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeAmazonPricing would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *AmazonPricingOpts) Validate() error {
+	_, err := prepareAmazonPricing(nil, "", []*AmazonPricingOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeAmazonReviews would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *AmazonReviewsOpts) Validate() error {
+	_, err := prepareAmazonReviews(nil, "", []*AmazonReviewsOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeAmazonQuestions would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *AmazonQuestionsOpts) Validate() error {
+	_, err := prepareAmazonQuestions(nil, "", []*AmazonQuestionsOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeAmazonBestsellers would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *AmazonBestsellersOpts) Validate() error {
+	_, err := prepareAmazonBestsellers(nil, "", []*AmazonBestsellersOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeAmazonSellers would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *AmazonSellersOpts) Validate() error {
+	_, err := prepareAmazonSellers(nil, "", []*AmazonSellersOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleShoppingUrl would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleShoppingUrlOpts) Validate() error {
+	_, err := prepareGoogleShoppingUrl(nil, "", []*GoogleShoppingUrlOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleShoppingSearch would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleShoppingSearchOpts) Validate() error {
+	_, err := prepareGoogleShoppingSearch(nil, "", []*GoogleShoppingSearchOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleShoppingProduct would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleShoppingProductOpts) Validate() error {
+	_, err := prepareGoogleShoppingProduct(nil, "", []*GoogleShoppingProductOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleShoppingPricing would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleShoppingPricingOpts) Validate() error {
+	_, err := prepareGoogleShoppingPricing(nil, "", []*GoogleShoppingPricingOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeWayfairSearch would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *WayfairSearchOpts) Validate() error {
+	_, err := prepareWayfairSearch(nil, "", []*WayfairSearchOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeWayfairUrl would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *WayfairUrlOpts) Validate() error {
+	_, err := prepareWayfairUrl(nil, "", []*WayfairUrlOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeUniversalUrl would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *UniversalUrlOpts) Validate() error {
+	_, err := prepareUniversalUrl(nil, "", []*UniversalUrlOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeTargetUrl would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *TargetUrlOpts) Validate() error {
+	_, err := prepareTargetUrl(nil, "", []*TargetUrlOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeTargetSearch would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *TargetSearchOpts) Validate() error {
+	_, err := prepareTargetSearch(nil, "", []*TargetSearchOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeTargetProduct would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *TargetProductOpts) Validate() error {
+	_, err := prepareTargetProduct(nil, "", []*TargetProductOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeEtsySearch would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *EtsySearchOpts) Validate() error {
+	_, err := prepareEtsySearch(nil, "", []*EtsySearchOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeEtsyProduct would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *EtsyProductOpts) Validate() error {
+	_, err := prepareEtsyProduct(nil, "", []*EtsyProductOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeKrogerUrl would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *KrogerUrlOpts) Validate() error {
+	_, err := prepareKrogerUrl(nil, "", []*KrogerUrlOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeKrogerSearch would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *KrogerSearchOpts) Validate() error {
+	_, err := prepareKrogerSearch(nil, "", []*KrogerSearchOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeKrogerProduct would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *KrogerProductOpts) Validate() error {
+	_, err := prepareKrogerProduct(nil, "", []*KrogerProductOpts{opt})
+	return err
+}
+
+// validate checks the query and opts of a scrape with the defaults of the client
+// applied, without making the req.
+func validate(c *internal.Client, query string, opts interface{}) error {
+	var err error
+	switch opt := opts.(type) {
+	case *AmazonUrlOpts:
+		_, err = prepareAmazonUrl(c, query, []*AmazonUrlOpts{opt})
+	case *AmazonSearchOpts:
+		_, err = prepareAmazonSearch(c, query, []*AmazonSearchOpts{opt})
+	case *AmazonProductOpts:
+		_, err = prepareAmazonProduct(c, query, []*AmazonProductOpts{opt})
+	case *AmazonPricingOpts:
+		_, err = prepareAmazonPricing(c, query, []*AmazonPricingOpts{opt})
+	case *AmazonReviewsOpts:
+		_, err = prepareAmazonReviews(c, query, []*AmazonReviewsOpts{opt})
+	case *AmazonQuestionsOpts:
+		_, err = prepareAmazonQuestions(c, query, []*AmazonQuestionsOpts{opt})
+	case *AmazonBestsellersOpts:
+		_, err = prepareAmazonBestsellers(c, query, []*AmazonBestsellersOpts{opt})
+	case *AmazonSellersOpts:
+		_, err = prepareAmazonSellers(c, query, []*AmazonSellersOpts{opt})
+	case *GoogleShoppingUrlOpts:
+		_, err = prepareGoogleShoppingUrl(c, query, []*GoogleShoppingUrlOpts{opt})
+	case *GoogleShoppingSearchOpts:
+		_, err = prepareGoogleShoppingSearch(c, query, []*GoogleShoppingSearchOpts{opt})
+	case *GoogleShoppingProductOpts:
+		_, err = prepareGoogleShoppingProduct(c, query, []*GoogleShoppingProductOpts{opt})
+	case *GoogleShoppingPricingOpts:
+		_, err = prepareGoogleShoppingPricing(c, query, []*GoogleShoppingPricingOpts{opt})
+	case *WayfairSearchOpts:
+		_, err = prepareWayfairSearch(c, query, []*WayfairSearchOpts{opt})
+	case *WayfairUrlOpts:
+		_, err = prepareWayfairUrl(c, query, []*WayfairUrlOpts{opt})
+	case *UniversalUrlOpts:
+		_, err = prepareUniversalUrl(c, query, []*UniversalUrlOpts{opt})
+	case *TargetUrlOpts:
+		_, err = prepareTargetUrl(c, query, []*TargetUrlOpts{opt})
+	case *TargetSearchOpts:
+		_, err = prepareTargetSearch(c, query, []*TargetSearchOpts{opt})
+	case *TargetProductOpts:
+		_, err = prepareTargetProduct(c, query, []*TargetProductOpts{opt})
+	case *EtsySearchOpts:
+		_, err = prepareEtsySearch(c, query, []*EtsySearchOpts{opt})
+	case *EtsyProductOpts:
+		_, err = prepareEtsyProduct(c, query, []*EtsyProductOpts{opt})
+	case *KrogerUrlOpts:
+		_, err = prepareKrogerUrl(c, query, []*KrogerUrlOpts{opt})
+	case *KrogerSearchOpts:
+		_, err = prepareKrogerSearch(c, query, []*KrogerSearchOpts{opt})
+	case *KrogerProductOpts:
+		_, err = prepareKrogerProduct(c, query, []*KrogerProductOpts{opt})
+	default:
+		err = fmt.Errorf("unsupported opts type %T", opts)
+	}
+
+	return err
+}
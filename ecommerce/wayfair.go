@@ -3,6 +3,7 @@ package ecommerce
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,25 +13,27 @@ import (
 
 // checkParameterValidity checks validity of ScrapeWayfairSearch parameters.
 func (opt *WayfairSearchOpts) checkParametersValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("limit, pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.Limit != 24 && opt.Limit != 48 && opt.Limit != 96 {
-		return fmt.Errorf("invalid limit parameter: %v", opt.Limit)
+		errs = append(errs, fmt.Errorf("invalid limit parameter: %v", opt.Limit))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // WayfairSearchOpts contains all the query parameters available for wayfair_search.
@@ -40,8 +43,26 @@ type WayfairSearchOpts struct {
 	Limit             int
 	UserAgent         oxylabs.UserAgent
 	CallbackUrl       string
+	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *WayfairSearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "WayfairSearchOpts",
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Limit:             &opt.Limit,
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // ScrapeWayfairSearch scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source.
@@ -49,7 +70,7 @@ func (c *EcommerceClient) ScrapeWayfairSearch(
 	query string,
 	opts ...*WayfairSearchOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeWayfairSearchCtx(ctx, query, opts...)
@@ -62,20 +83,40 @@ func (c *EcommerceClient) ScrapeWayfairSearchCtx(
 	query string,
 	opts ...*WayfairSearchOpts,
 ) (*Resp, error) {
+	req, err := prepareWayfairSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareWayfairSearch applies the defaults to the opts of a scrape with wayfair_search as source,
+// checks them and returns the req.
+func prepareWayfairSearch(
+	c *internal.Client,
+	query string,
+	opts []*WayfairSearchOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &WayfairSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_ECOMMERCE)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParametersValidity()
+	err = opt.checkParametersValidity()
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +132,8 @@ func (c *EcommerceClient) ScrapeWayfairSearchCtx(
 		"callback_url":    opt.CallbackUrl,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
 		payload["parse"] = true
@@ -104,43 +146,56 @@ func (c *EcommerceClient) ScrapeWayfairSearchCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // WayfairUrlOpts contains all the query parameters available for wayfair.
 type WayfairUrlOpts struct {
 	UserAgent         oxylabs.UserAgent
 	CallbackUrl       string
+	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *WayfairUrlOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "WayfairUrlOpts",
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // checkParameterValidity checks validity of ScrapeWayfairUrl parameters.
 func (opt *WayfairUrlOpts) checkParametersValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // ScrapeWayfairUrl scrapes wayfair via Oxylabs E-Commerce API with wayfair as source.
@@ -148,7 +203,7 @@ func (c *EcommerceClient) ScrapeWayfairUrl(
 	url string,
 	opts ...*WayfairUrlOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeWayfairUrlCtx(ctx, url, opts...)
@@ -161,8 +216,23 @@ func (c *EcommerceClient) ScrapeWayfairUrlCtx(
 	url string,
 	opts ...*WayfairUrlOpts,
 ) (*Resp, error) {
+	req, err := prepareWayfairUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareWayfairUrl applies the defaults to the opts of a scrape with wayfair as source,
+// checks them and returns the req.
+func prepareWayfairUrl(
+	c *internal.Client,
+	url string,
+	opts []*WayfairUrlOpts,
+) (*scrapeReq, error) {
 	// Check validity of url.
-	err := internal.ValidateUrl(url, "wayfair")
+	err := c.CheckUrl(url, "wayfair")
 	if err != nil {
 		return nil, err
 	}
@@ -170,11 +240,13 @@ func (c *EcommerceClient) ScrapeWayfairUrlCtx(
 	// Prepare options.
 	opt := &WayfairUrlOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_ECOMMERCE)
 
 	// Check validity of parameters.
 	err = opt.checkParametersValidity()
@@ -189,7 +261,8 @@ func (c *EcommerceClient) ScrapeWayfairUrlCtx(
 		"user_agent_type": opt.UserAgent,
 		"callback_url":    opt.CallbackUrl,
 	}
-	// Add custom parsing instructions to the payload if provided.
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
 		payload["parse"] = true
@@ -202,18 +275,14 @@ func (c *EcommerceClient) ScrapeWayfairUrlCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
@@ -2,12 +2,8 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
-	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // ScrapeWayfairSearch scrapes wayfair with async polling runtime via Oxylabs E-Commerce API
@@ -15,11 +11,11 @@ import (
 func (c *EcommerceClientAsync) ScrapeWayfairSearch(
 	query string,
 	opts ...*WayfairSearchOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeWayfairSearchCtx(ctx, query, opts...)
 
-	return c.ScrapeWayfairSearchCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeWayfairSearchCtx scrapes wayfair with async polling runtime via Oxylabs E-Commerce API
@@ -29,89 +25,37 @@ func (c *EcommerceClientAsync) ScrapeWayfairSearchCtx(
 	ctx context.Context,
 	query string,
 	opts ...*WayfairSearchOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &WayfairSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_ECOMMERCE)
-
-	// Check validity of parameters.
-	err := opt.checkParametersValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.WayfairSearch,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"limit":           opt.Limit,
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitWayfairSearch(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+// submitWayfairSearch submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitWayfairSearch(
+	ctx context.Context,
+	query string,
+	opts ...*WayfairSearchOpts,
+) (*asyncJob, error) {
+	req, err := prepareWayfairSearch(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitWayfairSearch submits a job via Oxylabs E-Commerce API with wayfair_search as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitWayfairSearch(
+	ctx context.Context,
+	query string,
+	opts ...*WayfairSearchOpts,
+) (string, error) {
+	return c.submitOnly(c.submitWayfairSearch(ctx, query, opts...))
 }
 
 // ScrapeWayfairUrl scrapes wayfair with async polling runtime via Oxylabs E-Commerce API
@@ -119,11 +63,11 @@ func (c *EcommerceClientAsync) ScrapeWayfairSearchCtx(
 func (c *EcommerceClientAsync) ScrapeWayfairUrl(
 	url string,
 	opts ...*WayfairUrlOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeWayfairUrlCtx(ctx, url, opts...)
 
-	return c.ScrapeWayfairUrlCtx(ctx, url, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeWayfairUrlCtx scrapes wayfair with async polling runtime via Oxylabs E-Commerce API
@@ -133,87 +77,35 @@ func (c *EcommerceClientAsync) ScrapeWayfairUrlCtx(
 	ctx context.Context,
 	url string,
 	opts ...*WayfairUrlOpts,
-) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Check validity of url.
-	err := internal.ValidateUrl(url, "wayfair")
+) (*AsyncResult, error) {
+	job, err := c.submitWayfairUrl(ctx, url, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare options.
-	opt := &WayfairUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParametersValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.Wayfair,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+// submitWayfairUrl submits the job and returns it without polling.
+func (c *EcommerceClientAsync) submitWayfairUrl(
+	ctx context.Context,
+	url string,
+	opts ...*WayfairUrlOpts,
+) (*asyncJob, error) {
+	req, err := prepareWayfairUrl(c.C, url, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitWayfairUrl submits a job via Oxylabs E-Commerce API with wayfair as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *EcommerceClientAsync) SubmitWayfairUrl(
+	ctx context.Context,
+	url string,
+	opts ...*WayfairUrlOpts,
+) (string, error) {
+	return c.submitOnly(c.submitWayfairUrl(ctx, url, opts...))
 }
@@ -0,0 +1,151 @@
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/jobs"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// ManifestName is the name of the manifest in bundles written by Bundle.
+const ManifestName = "manifest.json"
+
+// Manifest indexes the results of a bundle.
+type Manifest struct {
+	CreatedAt time.Time       `json:"created_at"`
+	Jobs      int             `json:"jobs"`
+	Failed    int             `json:"failed"`
+	Entries   []ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry describes the result of a job of a bundle. File is the name of
+// the NDJSON file of its records, empty if the job failed with Error.
+type ManifestEntry struct {
+	Index   int               `json:"index"`
+	Name    string            `json:"name,omitempty"`
+	Source  oxylabs.Source    `json:"source"`
+	Query   string            `json:"query"`
+	JobID   string            `json:"job_id,omitempty"`
+	Status  oxylabs.JobStatus `json:"status,omitempty"`
+	Records int               `json:"records"`
+	File    string            `json:"file,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// Bundle writes the results of a batch run, e.g. from jobs.Runner, to w as a
+// tar.gz archive. The records of every result are written as NDJSON to
+// results/<index>-<job ID>.ndjson, preceded by a manifest.json indexing them.
+func Bundle(w io.Writer, results []*jobs.Result) error {
+	manifest := Manifest{
+		CreatedAt: time.Now().UTC(),
+		Jobs:      len(results),
+		Entries:   make([]ManifestEntry, 0, len(results)),
+	}
+
+	files := make([][]byte, 0, len(results))
+	for _, result := range results {
+		entry, file, err := bundleEntry(result)
+		if err != nil {
+			return err
+		}
+		if entry.Error != "" {
+			manifest.Failed++
+		}
+		manifest.Entries = append(manifest.Entries, entry)
+		files = append(files, file)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling manifest: %v", err)
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	if err = writeTarFile(tw, ManifestName, data, manifest.CreatedAt); err != nil {
+		return err
+	}
+	for i, entry := range manifest.Entries {
+		if entry.File == "" {
+			continue
+		}
+		if err = writeTarFile(tw, entry.File, files[i], manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return fmt.Errorf("error writing bundle: %v", err)
+	}
+	if err = gw.Close(); err != nil {
+		return fmt.Errorf("error writing bundle: %v", err)
+	}
+
+	return nil
+}
+
+// bundleEntry returns the manifest entry of result and the NDJSON of its records.
+func bundleEntry(result *jobs.Result) (ManifestEntry, []byte, error) {
+	entry := ManifestEntry{
+		Index:  result.Index,
+		Name:   result.Definition.Name,
+		Source: result.Definition.Source,
+		Query:  result.Definition.Query,
+	}
+
+	var records []Record
+	var err error
+	switch {
+	case result.Err != nil:
+		entry.Error = result.Err.Error()
+		return entry, nil, nil
+	case result.Serp != nil:
+		entry.JobID = result.Serp.Job.ID
+		entry.Status = result.Serp.Job.Status
+		records, err = SerpRecords(result.Serp)
+	case result.Ecommerce != nil:
+		entry.JobID = result.Ecommerce.Job.ID
+		entry.Status = result.Ecommerce.Job.Status
+		records, err = EcommerceRecords(result.Ecommerce)
+	default:
+		entry.Error = "job has no result"
+		return entry, nil, nil
+	}
+	if err != nil {
+		return entry, nil, err
+	}
+
+	var buf bytes.Buffer
+	if err = writeRecords(newEncoder(&buf), records); err != nil {
+		return entry, nil, err
+	}
+	entry.Records = len(records)
+	entry.File = fmt.Sprintf("results/%d-%s.ndjson", result.Index, entry.JobID)
+
+	return entry, buf.Bytes(), nil
+}
+
+// writeTarFile writes a regular file with the given name and contents to tw.
+func writeTarFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("error writing %s to bundle: %v", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("error writing %s to bundle: %v", name, err)
+	}
+
+	return nil
+}
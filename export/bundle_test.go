@@ -0,0 +1,57 @@
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/jobs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundle(t *testing.T) {
+	resp := &serp.Resp{
+		Results: []serp.Results{{Content: "<html>1</html>", Page: 1, StatusCode: 200}},
+	}
+	resp.Job.ID = "123"
+	resp.Job.Status = "done"
+
+	results := []*jobs.Result{
+		{Index: 0, Definition: jobs.Definition{Source: "google_search", Query: "adidas"}, Serp: resp},
+		{Index: 1, Definition: jobs.Definition{Source: "google_search", Query: "nike"}, Err: errors.New("faulted")},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, Bundle(&buf, results))
+
+	gr, err := gzip.NewReader(&buf)
+	assert.NoError(t, err)
+	tr := tar.NewReader(gr)
+
+	files := map[string][]byte{}
+	var names []string
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(tr)
+		files[header.Name] = data
+		names = append(names, header.Name)
+	}
+	assert.Equal(t, []string{ManifestName, "results/0-123.ndjson"}, names)
+
+	var manifest Manifest
+	assert.NoError(t, json.Unmarshal(files[ManifestName], &manifest))
+	assert.Equal(t, 2, manifest.Jobs)
+	assert.Equal(t, 1, manifest.Failed)
+	assert.Equal(t, 1, manifest.Entries[0].Records)
+	assert.Equal(t, "faulted", manifest.Entries[1].Error)
+	assert.Contains(t, string(files["results/0-123.ndjson"]), `"content":"<html>1</html>"`)
+}
@@ -0,0 +1,54 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+)
+
+// CSVHeader is the header row written by CSV.
+var CSVHeader = []string{"query", "page", "position", "title", "url", "description"}
+
+// CSV writes the organic results of a parsed SERP resp to w as CSV rows
+// of query, page, position, title, url and description, preceded by CSVHeader.
+func CSV(w io.Writer, resp *serp.Resp) error {
+	if !resp.Parse || resp.ParseInstructions {
+		return fmt.Errorf("resp of job %s is not parsed", resp.Job.ID)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(CSVHeader); err != nil {
+		return fmt.Errorf("error writing csv header: %v", err)
+	}
+
+	for _, result := range resp.Results {
+		page := result.ContentParsed.Page
+		if page == 0 {
+			page = result.Page
+		}
+
+		for _, organic := range result.ContentParsed.Results.Organic {
+			err := cw.Write([]string{
+				resp.Job.Query,
+				strconv.Itoa(page),
+				strconv.Itoa(organic.Pos),
+				organic.Title,
+				organic.Url,
+				organic.Desc,
+			})
+			if err != nil {
+				return fmt.Errorf("error writing csv row: %v", err)
+			}
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("error writing csv: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSV(t *testing.T) {
+	resp := &serp.Resp{Parse: true}
+	resp.Job.Query = "adidas"
+	result := serp.Results{Page: 1}
+	result.ContentParsed.Results.Organic = []serp.Organic{
+		{Pos: 1, Title: "Adidas", Url: "https://www.adidas.com", Desc: "Shoes, clothing"},
+	}
+	resp.Results = []serp.Results{result}
+
+	var buf bytes.Buffer
+	assert.NoError(t, CSV(&buf, resp))
+	assert.Equal(t,
+		"query,page,position,title,url,description\n"+
+			"adidas,1,1,Adidas,https://www.adidas.com,\"Shoes, clothing\"\n",
+		buf.String(),
+	)
+
+	assert.Error(t, CSV(&buf, &serp.Resp{}))
+}
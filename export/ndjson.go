@@ -0,0 +1,182 @@
+// Package export writes responses in formats consumed by analytics pipelines.
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/oxylabs/oxylabs-sdk-go/ecommerce"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+)
+
+// Record is a single result of a job with the job metadata flattened into it.
+type Record struct {
+	JobID          string            `json:"job_id"`
+	JobSource      string            `json:"job_source"`
+	JobQuery       string            `json:"job_query,omitempty"`
+	JobUrl         interface{}       `json:"job_url,omitempty"`
+	JobDomain      string            `json:"job_domain,omitempty"`
+	JobGeoLocation interface{}       `json:"job_geo_location,omitempty"`
+	JobLocale      interface{}       `json:"job_locale,omitempty"`
+	JobParse       bool              `json:"job_parse"`
+	JobStatus      oxylabs.JobStatus `json:"job_status"`
+	JobCreatedAt   string            `json:"job_created_at"`
+	JobUpdatedAt   string            `json:"job_updated_at"`
+	Page           int               `json:"page"`
+	Url            string            `json:"url"`
+	StatusCode     int               `json:"status_code"`
+	ParserType     string            `json:"parser_type,omitempty"`
+	CreatedAt      string            `json:"created_at"`
+	UpdatedAt      string            `json:"updated_at"`
+	Content        json.RawMessage   `json:"content"`
+}
+
+// SerpRecords returns a record per result of resp.
+func SerpRecords(resp *serp.Resp) ([]Record, error) {
+	job := resp.Job
+	records := make([]Record, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		content, err := marshalContent(result.CustomContentParsed, result.ContentParsed, result.Content, resp.Parse)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, Record{
+			JobID:          job.ID,
+			JobSource:      job.Source,
+			JobQuery:       job.Query,
+			JobUrl:         job.Url,
+			JobDomain:      job.Domain,
+			JobGeoLocation: job.GeoLocation,
+			JobLocale:      job.Locale,
+			JobParse:       job.Parse,
+			JobStatus:      job.Status,
+			JobCreatedAt:   job.CreatedAt,
+			JobUpdatedAt:   job.UpdatedAt,
+			Page:           result.Page,
+			Url:            result.Url,
+			StatusCode:     result.StatusCode,
+			ParserType:     result.ParserType,
+			CreatedAt:      result.CreatedAt,
+			UpdatedAt:      result.UpdatedAt,
+			Content:        content,
+		})
+	}
+
+	return records, nil
+}
+
+// EcommerceRecords returns a record per result of resp.
+func EcommerceRecords(resp *ecommerce.Resp) ([]Record, error) {
+	job := resp.Job
+	records := make([]Record, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		content, err := marshalContent(result.CustomContentParsed, result.ContentParsed, result.Content, resp.Parse)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, Record{
+			JobID:          job.ID,
+			JobSource:      job.Source,
+			JobQuery:       job.Query,
+			JobUrl:         job.Url,
+			JobDomain:      job.Domain,
+			JobGeoLocation: job.GeoLocation,
+			JobLocale:      job.Locale,
+			JobParse:       job.Parse,
+			JobStatus:      job.Status,
+			JobCreatedAt:   job.CreatedAt,
+			JobUpdatedAt:   job.UpdatedAt,
+			Page:           result.Page,
+			Url:            result.Url,
+			StatusCode:     result.StatusCode,
+			ParserType:     result.ParserType,
+			CreatedAt:      result.CreatedAt,
+			UpdatedAt:      result.UpdatedAt,
+			Content:        content,
+		})
+	}
+
+	return records, nil
+}
+
+// NDJSON writes a record per result of resps to w as newline-delimited JSON.
+func NDJSON(w io.Writer, resps []*serp.Resp) error {
+	enc := newEncoder(w)
+	for _, resp := range resps {
+		records, err := SerpRecords(resp)
+		if err != nil {
+			return err
+		}
+		if err = writeRecords(enc, records); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EcommerceNDJSON writes a record per result of resps to w as newline-delimited JSON.
+func EcommerceNDJSON(w io.Writer, resps []*ecommerce.Resp) error {
+	enc := newEncoder(w)
+	for _, resp := range resps {
+		records, err := EcommerceRecords(resp)
+		if err != nil {
+			return err
+		}
+		if err = writeRecords(enc, records); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newEncoder returns an encoder which does not escape HTML, as content is mostly HTML.
+func newEncoder(w io.Writer) *json.Encoder {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	return enc
+}
+
+func writeRecords(enc *json.Encoder, records []Record) error {
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("error encoding record of job %s: %v", record.JobID, err)
+		}
+	}
+
+	return nil
+}
+
+// marshalContent marshals the populated content of a result.
+func marshalContent(
+	customContentParsed map[string]interface{},
+	contentParsed interface{},
+	content string,
+	parse bool,
+) (json.RawMessage, error) {
+	var v interface{}
+	switch {
+	case customContentParsed != nil:
+		v = customContentParsed
+	case parse:
+		v = contentParsed
+	default:
+		v = content
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("error marshalling content: %v", err)
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
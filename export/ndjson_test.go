@@ -0,0 +1,31 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNDJSON(t *testing.T) {
+	resp := &serp.Resp{
+		Results: []serp.Results{
+			{Content: "<html>1</html>", Page: 1, StatusCode: 200},
+			{Content: "<html>2</html>", Page: 2, StatusCode: 200},
+		},
+	}
+	resp.Job.ID = "123"
+	resp.Job.Source = "google_search"
+	resp.Job.Query = "adidas"
+
+	var buf bytes.Buffer
+	err := NDJSON(&buf, []*serp.Resp{resp})
+	assert.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	assert.Len(t, lines, 2)
+	assert.Contains(t, string(lines[1]), `"job_id":"123","job_source":"google_search","job_query":"adidas"`)
+	assert.Contains(t, string(lines[1]), `"page":2`)
+	assert.Contains(t, string(lines[1]), `"content":"<html>2</html>"`)
+}
@@ -0,0 +1,49 @@
+// Package geo builds geo_location parameters in the formats the API documents.
+// The values are checked by oxylabs.IsGeoLocationValid when the opts of a
+// scrape are validated, so a malformed geo location fails before the req is made.
+package geo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Country returns the geo location of an ISO 3166-1 alpha-2 country code, e.g. US,
+// or of a country name, e.g. United States.
+func Country(country string) oxylabs.GeoLocation {
+	if len(country) == 2 {
+		country = strings.ToUpper(country)
+	}
+
+	return oxylabs.GeoLocation(country)
+}
+
+// City returns the geo location of a city, given as its canonical name
+// with the region and country, e.g. London,England,United Kingdom.
+// The city is passed through unchecked, as only the API knows the
+// canonical names, and it rejects the reqs of unknown ones.
+func City(city string) oxylabs.GeoLocation {
+	return oxylabs.GeoLocation(city)
+}
+
+// Zip returns the geo location of a zip or postal code, e.g. 10001.
+// The code is passed through unchecked, as the formats differ by country,
+// see oxylabs.IsPostalCodeValid for a check of its shape.
+func Zip(zip string) oxylabs.GeoLocation {
+	return oxylabs.GeoLocation(zip)
+}
+
+// Coords returns the geo location of the coordinates in degrees
+// and a radius in meters around them. Coordinates out of range and
+// a radius which is not positive fail validation.
+func Coords(lat, lng float64, radius int) oxylabs.GeoLocation {
+	return oxylabs.GeoLocation(fmt.Sprintf("lat: %g, lng: %g, rad: %d", lat, lng, radius))
+}
+
+// Raw returns the geo location as given, unchecked, for formats the
+// other constructors do not cover.
+func Raw(geoLocation string) oxylabs.GeoLocation {
+	return oxylabs.GeoLocation(geoLocation)
+}
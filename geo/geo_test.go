@@ -0,0 +1,48 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+func TestGeoLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		geoLocation oxylabs.GeoLocation
+		want        oxylabs.GeoLocation
+	}{
+		{name: "country code", geoLocation: Country("de"), want: "DE"},
+		{name: "country", geoLocation: Country("Germany"), want: "Germany"},
+		{name: "city", geoLocation: City("London,England,United Kingdom"), want: "London,England,United Kingdom"},
+		{name: "zip", geoLocation: Zip("10001"), want: "10001"},
+		{name: "coords", geoLocation: Coords(47.6205, -122.3493, 25000), want: "lat: 47.6205, lng: -122.3493, rad: 25000"},
+		{name: "raw", geoLocation: Raw("Seattle"), want: "Seattle"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.geoLocation)
+			assert.True(t, oxylabs.IsGeoLocationValid(tt.geoLocation))
+		})
+	}
+}
+
+func TestGeoLocation_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		geoLocation oxylabs.GeoLocation
+	}{
+		{name: "unknown country code", geoLocation: Country("xx")},
+		{name: "latitude out of range", geoLocation: Coords(91, 0, 25000)},
+		{name: "longitude out of range", geoLocation: Coords(0, -181, 25000)},
+		{name: "radius not positive", geoLocation: Coords(47.6205, -122.3493, 0)},
+		{name: "blank", geoLocation: Raw(" ")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.False(t, oxylabs.IsGeoLocationValid(tt.geoLocation))
+		})
+	}
+}
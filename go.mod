@@ -2,10 +2,15 @@ module github.com/oxylabs/oxylabs-sdk-go
 
 go 1.21.0
 
-require github.com/stretchr/testify v1.8.4
+require (
+	github.com/spf13/cobra v1.8.1
+	github.com/stretchr/testify v1.8.4
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	github.com/spf13/pflag v1.0.5 // indirect
 )
@@ -0,0 +1,16 @@
+module github.com/oxylabs/oxylabs-sdk-go/htmlutil
+
+go 1.23.0
+
+require (
+	github.com/PuerkitoBio/goquery v1.10.3
+	github.com/stretchr/testify v1.8.4
+	golang.org/x/net v0.42.0
+)
+
+require (
+	github.com/andybalholm/cascadia v1.3.3 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
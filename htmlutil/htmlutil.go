@@ -0,0 +1,224 @@
+// Package htmlutil extracts the text of html results and parses them into
+// goquery documents. It is a separate module, so that its html dependencies
+// are not dependencies of SDK consumers which do not need them.
+package htmlutil
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Elements whose content is not readable text.
+var skippedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+	atom.Iframe:   true,
+	atom.Head:     true,
+}
+
+// Elements which start a new line of text.
+var blockElements = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Blockquote: true,
+	atom.Br: true, atom.Dd: true, atom.Div: true, atom.Dl: true, atom.Dt: true,
+	atom.Figcaption: true, atom.Footer: true, atom.Form: true, atom.H1: true,
+	atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Header: true, atom.Hr: true, atom.Li: true, atom.Main: true, atom.Nav: true,
+	atom.Ol: true, atom.P: true, atom.Pre: true, atom.Section: true, atom.Table: true,
+	atom.Td: true, atom.Th: true, atom.Tr: true, atom.Ul: true,
+}
+
+// Elements which SanitizeHTML drops in addition to skippedElements, as they
+// embed active content.
+var unsafeElements = map[atom.Atom]bool{
+	atom.Object:   true,
+	atom.Embed:    true,
+	atom.Frame:    true,
+	atom.Frameset: true,
+	atom.Base:     true,
+}
+
+// Attributes whose value is a URL.
+var urlAttributes = map[string]bool{
+	"href": true, "src": true, "action": true, "formaction": true, "poster": true,
+	"background": true, "cite": true, "longdesc": true, "xlink:href": true, "srcset": true,
+}
+
+// URL schemes which run code when followed or loaded.
+var unsafeSchemes = []string{"javascript:", "vbscript:", "data:"}
+
+// HTMLToText extracts the readable text of html content, dropping scripts,
+// styles and other non-text elements. Whitespace is collapsed and block
+// elements, such as paragraphs, are separated by new lines.
+func HTMLToText(content string) (string, error) {
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("error parsing html: %v", err)
+	}
+
+	var lines []string
+	var line strings.Builder
+	flush := func() {
+		if text := strings.Join(strings.Fields(line.String()), " "); text != "" {
+			lines = append(lines, text)
+		}
+		line.Reset()
+	}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			line.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			if skippedElements[n.DataAtom] {
+				return
+			}
+		case html.DocumentNode:
+		default:
+			return
+		}
+
+		block := blockElements[n.DataAtom]
+		if block {
+			flush()
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+		if block {
+			flush()
+		}
+	}
+	walk(doc)
+	flush()
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// SanitizeHTML returns the html content without scripts, styles, comments,
+// embedded objects and other elements which are not rendered as text. Event
+// handler attributes, such as onclick, and URLs with the javascript, vbscript
+// and data schemes are removed as well.
+func SanitizeHTML(content string) (string, error) {
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("error parsing html: %v", err)
+	}
+	sanitizeNode(doc)
+
+	var buf bytes.Buffer
+	if err := html.Render(&buf, doc); err != nil {
+		return "", fmt.Errorf("error rendering html: %v", err)
+	}
+
+	return buf.String(), nil
+}
+
+// sanitizeNode removes the unsafe children and attributes of n, recursively.
+func sanitizeNode(n *html.Node) {
+	for child := n.FirstChild; child != nil; {
+		next := child.NextSibling
+		switch {
+		case child.Type == html.CommentNode,
+			// The head is kept, only its scripts and styles are dropped.
+			child.Type == html.ElementNode && child.DataAtom != atom.Head &&
+				(skippedElements[child.DataAtom] || unsafeElements[child.DataAtom]):
+			n.RemoveChild(child)
+		case child.Type == html.ElementNode:
+			child.Attr = safeAttributes(child.Attr)
+			sanitizeNode(child)
+		}
+		child = next
+	}
+}
+
+// safeAttributes returns attrs without event handlers and unsafe URLs.
+func safeAttributes(attrs []html.Attribute) []html.Attribute {
+	safe := attrs[:0]
+	for _, attr := range attrs {
+		key := strings.ToLower(attr.Key)
+		if attr.Namespace != "" {
+			key = attr.Namespace + ":" + key
+		}
+		if strings.HasPrefix(key, "on") || key == "srcdoc" ||
+			(urlAttributes[key] && unsafeURL(attr.Val)) {
+			continue
+		}
+		safe = append(safe, attr)
+	}
+
+	return safe
+}
+
+// unsafeURL reports whether the URL has an unsafe scheme. Browsers ignore
+// whitespace and control characters in schemes, so they are ignored as well.
+func unsafeURL(value string) bool {
+	scheme := strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, value)
+	for _, unsafe := range unsafeSchemes {
+		if strings.HasPrefix(scheme, unsafe) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseHTML parses html content into a goquery document. The underlying
+// x/net/html nodes are available in its Nodes field.
+func ParseHTML(content string) (*goquery.Document, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing html: %v", err)
+	}
+
+	return doc, nil
+}
+
+// Result is a scrape result with raw html content, such as serp.Results
+// and ecommerce.Results.
+type Result interface {
+	// HTML returns the html content of the result, or an error
+	// if its content is not html.
+	HTML() (string, error)
+}
+
+// ResultText returns the readable text of the html content of the result,
+// without scripts and styles, e.g. for NLP pipelines.
+func ResultText(r Result) (string, error) {
+	content, err := r.HTML()
+	if err != nil {
+		return "", err
+	}
+
+	return HTMLToText(content)
+}
+
+// ResultDocument returns the html content of the result parsed into a
+// goquery document, so that it can be queried without parsing boilerplate.
+//
+// It is a function taking the result rather than an HTMLDocument method of the
+// result types: those are in the SDK module, which would then depend on goquery
+// for every consumer. The result types only implement the Result interface.
+func ResultDocument(r Result) (*goquery.Document, error) {
+	content, err := r.HTML()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseHTML(content)
+}
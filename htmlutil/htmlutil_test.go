@@ -0,0 +1,73 @@
+package htmlutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testHTML = `<html><head><title>Shoes</title><style>p { color: red }</style></head>
+<body><!-- nav --><h1>Running   shoes</h1>
+<script>var x = "<p>hidden</p>";</script>
+<p>Light and <b>fast</b>.</p><ul><li>Red</li><li>Blue<br>Navy</li></ul>
+<noscript>Enable JavaScript</noscript></body></html>`
+
+func TestHTMLToText(t *testing.T) {
+	text, err := HTMLToText(testHTML)
+	assert.NoError(t, err)
+	assert.Equal(t, "Running shoes\nLight and fast.\nRed\nBlue\nNavy", text)
+}
+
+func TestSanitizeHTML(t *testing.T) {
+	sanitized, err := SanitizeHTML(testHTML)
+	assert.NoError(t, err)
+	assert.Contains(t, sanitized, "<title>Shoes</title>")
+	assert.Contains(t, sanitized, "<p>Light and <b>fast</b>.</p>")
+	for _, s := range []string{"<style>", "color: red", "<script>", "hidden", "nav", "Enable JavaScript"} {
+		assert.NotContains(t, sanitized, s)
+	}
+}
+
+func TestHTMLToText_MissingHead(t *testing.T) {
+	text, err := HTMLToText(`<html><head><title>Shoes</title><p>Light and <b>fast</b>.`)
+	assert.NoError(t, err)
+	assert.Equal(t, "Light and fast.", text)
+}
+
+func TestSanitizeHTML_UnsafeAttributes(t *testing.T) {
+	sanitized, err := SanitizeHTML(`<a href=" JavaScript:alert(1)" onclick="alert(2)" title="x">Shoes</a>` +
+		`<img src="/shoe.png" ONERROR="alert(3)"><a href="https://example.com">Home</a><object data="x.swf"></object>`)
+	assert.NoError(t, err)
+	assert.Contains(t, sanitized, `<a title="x">Shoes</a>`)
+	assert.Contains(t, sanitized, `<img src="/shoe.png"/>`)
+	assert.Contains(t, sanitized, `<a href="https://example.com">Home</a>`)
+	for _, s := range []string{"alert", "object"} {
+		assert.NotContains(t, sanitized, s)
+	}
+}
+
+// htmlResult is a Result with html content, or an error if content is empty.
+type htmlResult string
+
+func (r htmlResult) HTML() (string, error) {
+	if r == "" {
+		return "", errors.New("page 1: content of kind png is not html")
+	}
+	return string(r), nil
+}
+
+func TestResultDocument(t *testing.T) {
+	result := htmlResult(`<html><body><div class="g"><h3>Adidas</h3></div><script>x()</script></body></html>`)
+
+	doc, err := ResultDocument(result)
+	assert.NoError(t, err)
+	assert.Equal(t, "Adidas", doc.Find("div.g h3").Text())
+
+	text, err := ResultText(result)
+	assert.NoError(t, err)
+	assert.Equal(t, "Adidas", text)
+
+	_, err = ResultDocument(htmlResult(""))
+	assert.EqualError(t, err, "page 1: content of kind png is not html")
+}
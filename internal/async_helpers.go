@@ -4,39 +4,203 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal/payloads"
+	"github.com/oxylabs/oxylabs-sdk-go/jobstore"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // Helper function to make a POST req and retrieve the Job ID.
 func (c *Client) GetJobID(
 	jsonPayload []byte,
 ) (string, error) {
-	req, _ := http.NewRequest(
+	return c.GetJobIDCtx(context.Background(), jsonPayload)
+}
+
+// GetJobIDCtx makes a POST req and retrieves the Job ID.
+// If ctx carries an idempotency key, the job previously submitted with it is reused.
+func (c *Client) GetJobIDCtx(
+	ctx context.Context,
+	jsonPayload []byte,
+) (string, error) {
+	if err := c.checkOpen(); err != nil {
+		return "", err
+	}
+	if err := CheckPayload(jsonPayload); err != nil {
+		return "", err
+	}
+
+	// Reuse the job submitted with the same idempotency key, if any.
+	idempotencyKey := oxylabs.IdempotencyKey(ctx)
+	if idempotencyKey != "" {
+		unlock := c.idempotencyLocks().lock(idempotencyKey)
+		defer unlock()
+
+		jobID, err := c.idempotentJobID(idempotencyKey, jsonPayload)
+		if err != nil || jobID != "" {
+			return jobID, err
+		}
+	}
+
+	// Reuse the job of an identical req, if any.
+	key := c.reqKey(ctx, "job", jsonPayload)
+	if jobID, ok := c.cacheGet(context.Background(), key); ok {
+		return string(jobID), nil
+	}
+
+	// A job shared with other callers would not be tracked under the idempotency key.
+	if key == "" || !c.config().Deduplicate || idempotencyKey != "" {
+		return c.submitLimitedJob(ctx, jsonPayload, key, idempotencyKey)
+	}
+
+	// Share the job of an identical req in flight.
+	jobID, err := c.flights.do(ctx, key, func(ctx context.Context) (interface{}, error) {
+		return c.submitLimitedJob(ctx, jsonPayload, key, "")
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return jobID.(string), nil
+}
+
+// idempotentJobID returns the ID of the job submitted with the idempotency key,
+// or an empty string if there is none.
+func (c *Client) idempotentJobID(
+	idempotencyKey string,
+	jsonPayload []byte,
+) (string, error) {
+	finder, ok := c.config().JobStore.(jobstore.KeyFinder)
+	if !ok {
+		return "", fmt.Errorf("idempotency keys require a job store which implements jobstore.KeyFinder")
+	}
+
+	job, err := finder.FindByKey(idempotencyKey)
+	if errors.Is(err, jobstore.ErrNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("error finding job with idempotency key %q: %v", idempotencyKey, err)
+	}
+
+	payloadHash, err := payloads.Key(jsonPayload)
+	if err != nil {
+		return "", err
+	}
+	if job.PayloadHash != payloadHash {
+		return "", fmt.Errorf("idempotency key %q was used with a different payload", idempotencyKey)
+	}
+	if job.Pending {
+		return "", fmt.Errorf("%w: idempotency key %q", oxylabs.ErrSubmissionUnknown, idempotencyKey)
+	}
+
+	// The reused job is polled with the account it was submitted with.
+	if err = c.PinAccount(job.ID, job.Username); err != nil {
+		return "", err
+	}
+
+	return job.ID, nil
+}
+
+// submitLimitedJob submits the job once a slot of the plan limits is free.
+func (c *Client) submitLimitedJob(
+	ctx context.Context,
+	jsonPayload []byte,
+	key string,
+	idempotencyKey string,
+) (string, error) {
+	limiter := c.jobLimiter()
+	if err := c.acquireJobSlot(ctx); err != nil {
+		return "", fmt.Errorf("error waiting for a job slot of the plan limits: %w", err)
+	}
+
+	jobID, err := c.submitJob(ctx, jsonPayload, key, idempotencyKey)
+	if err != nil {
+		limiter.release()
+		return "", err
+	}
+	limiter.hold(jobID)
+
+	return jobID, nil
+}
+
+// submitJob submits the job and caches its ID under key.
+// The job is tracked in the job store under the idempotency key, if any.
+func (c *Client) submitJob(
+	ctx context.Context,
+	jsonPayload []byte,
+	key string,
+	idempotencyKey string,
+) (string, error) {
+	ctx, cancel := withTimeout(ctx, c.config().Timeouts.Submit)
+	defer cancel()
+
+	// The payload hash detects reuse of an idempotency key for another payload.
+	store := c.config().JobStore
+	payloadHash, _ := payloads.Key(jsonPayload)
+
+	// Record the idempotency key before submitting, so that a retry after a lost
+	// resp does not submit a second job.
+	if idempotencyKey != "" && store != nil {
+		err := store.Save(jobstore.Job{
+			Payload:        jsonPayload,
+			PayloadHash:    payloadHash,
+			IdempotencyKey: idempotencyKey,
+			SubmittedAt:    time.Now(),
+			Pending:        true,
+		})
+		if err != nil {
+			return "", fmt.Errorf("error saving idempotency key %q: %w", idempotencyKey, err)
+		}
+	}
+
+	req, _ := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		c.BaseUrl,
+		c.asyncUrl(),
 		bytes.NewBuffer(jsonPayload),
 	)
 	req.Header.Add("Content-type", "application/json")
-	req.SetBasicAuth(
-		c.ApiCredentials.Username,
-		c.ApiCredentials.Password,
-	)
-	resp, err := c.HttpClient.Do(req)
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if err := c.setAuth(req); err != nil {
+		c.releaseIdempotencyKey(idempotencyKey)
+		return "", err
+	}
+	// A ctx done before the req is sent has not reached the API.
+	if err := ctx.Err(); err != nil {
+		c.releaseIdempotencyKey(idempotencyKey)
+		return "", fmt.Errorf("error performing req: %w", err)
+	}
+	resp, err := c.do(req)
 	if err != nil {
-		return "", fmt.Errorf("error performing req: %v", err)
+		// A req which was not sent surely did not create a job, so the key
+		// can be used again. Otherwise the job may exist and the key stays pending.
+		if NotSent(err) {
+			c.releaseIdempotencyKey(idempotencyKey)
+		}
+		c.audit(req, jsonPayload, "", 0, err)
+		return "", fmt.Errorf("error performing req: %w", err)
 	}
+	defer resp.Body.Close()
 
+	// The job may exist even if its resp cannot be read, so the key stays pending.
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("error reading resp body: %v", err)
+		return "", fmt.Errorf("error reading resp body: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode >= 300 {
-		return "", fmt.Errorf("error with status code %s: %s", resp.Status, respBody)
+		// The API rejected the job, so the key can be used again.
+		c.releaseIdempotencyKey(idempotencyKey)
+		err = fmt.Errorf("error with status code %s: %s", resp.Status, respBody)
+		c.audit(req, jsonPayload, "", resp.StatusCode, err)
+		return "", err
 	}
 
 	// Unmarshal into job.
@@ -44,29 +208,69 @@ func (c *Client) GetJobID(
 	if err = json.Unmarshal(respBody, &job); err != nil {
 		return "", fmt.Errorf("error unmarshalling job resp body: %v", err)
 	}
+	c.audit(req, jsonPayload, job.ID, resp.StatusCode, nil)
+	c.pinCredentials(req, job.ID)
+	if c.listening() {
+		c.emit(&oxylabs.JobSubmittedEvent{Time: time.Now(), JobID: job.ID, Source: payloadSource(jsonPayload)})
+	}
+
+	// Persist the job so that polling can be resumed after a restart.
+	username, _, _ := req.BasicAuth()
+	// The job is already running, so a failed save is emitted but not returned:
+	// an error would make the caller submit and pay for the job again.
+	if store != nil {
+		err = store.Save(jobstore.Job{
+			ID:             job.ID,
+			Payload:        jsonPayload,
+			PayloadHash:    payloadHash,
+			IdempotencyKey: idempotencyKey,
+			SubmittedAt:    time.Now(),
+			Username:       username,
+		})
+		if err != nil {
+			c.emit(&oxylabs.JobStoreFailedEvent{
+				Time:           time.Now(),
+				JobID:          job.ID,
+				IdempotencyKey: idempotencyKey,
+				Err:            fmt.Errorf("error saving job %s: %v", job.ID, err),
+			})
+		}
+	}
+
+	c.cacheJob(key, job.ID)
 
 	return job.ID, nil
 }
 
-// Helper function for getting the http response from the request.
+// releaseIdempotencyKey drops the pending record of the idempotency key
+// after a submission which surely did not create a job.
+func (c *Client) releaseIdempotencyKey(idempotencyKey string) {
+	if idempotencyKey == "" {
+		return
+	}
+	if finder, ok := c.config().JobStore.(jobstore.KeyFinder); ok {
+		if err := finder.ReleaseKey(idempotencyKey); err != nil {
+			c.emit(&oxylabs.JobStoreFailedEvent{
+				Time:           time.Now(),
+				IdempotencyKey: idempotencyKey,
+				Err:            fmt.Errorf("error releasing idempotency key %q: %v", idempotencyKey, err),
+			})
+		}
+	}
+}
+
+// GetHttpResp fetches the results of the job and manages the resp/error channels
+// like PollJobStatus. Both channels must be buffered and are always closed before
+// it returns: errChan after the error, if any, and httpChan after the http resp.
 func (c *Client) GetHttpResp(
 	jobID string,
 	httpChan chan *http.Response,
 	errChan chan error,
 ) {
-	req, _ := http.NewRequest(
-		"GET",
-		fmt.Sprintf("https://data.oxylabs.io/v1/queries/%s/results", jobID),
-		nil,
-	)
-	req.Header.Add("Content-type", "application/json")
-	req.SetBasicAuth(
-		c.ApiCredentials.Username,
-		c.ApiCredentials.Password,
-	)
-	resp, err := c.HttpClient.Do(req)
+	resp, err := c.GetJobResult(context.Background(), jobID)
 	if err != nil {
 		errChan <- err
+		close(errChan)
 		close(httpChan)
 		return
 	}
@@ -74,9 +278,43 @@ func (c *Client) GetHttpResp(
 	// Return.
 	close(errChan)
 	httpChan <- resp
+	close(httpChan)
+}
+
+// GetJobResult gets the http resp with all results of the finished job, one per page.
+func (c *Client) GetJobResult(ctx context.Context, jobID string) (*http.Response, error) {
+	// The timeout covers reading the resp body, so it is canceled on close.
+	ctx, cancel := withTimeout(withJobID(ctx, jobID), c.config().Timeouts.Fetch)
+
+	req, _ := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.jobUrl(jobID, "/results"),
+		nil,
+	)
+	req.Header.Add("Content-type", "application/json")
+	if err := c.setAuth(req); err != nil {
+		cancel()
+		return nil, err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	if resp.StatusCode == http.StatusOK {
+		c.credentialPins().unpin(jobID)
+	}
+
+	return resp, nil
 }
 
 // PollJobStatus polls the job status and manages the resp/error channels.
+// Both channels must be buffered. On success errChan is closed and the http resp
+// is sent on httpRespChan, otherwise the error is sent on errChan.
+// Both channels are always closed before it returns: errChan after the error,
+// if any, and httpRespChan after the http resp, if any.
 // ctx is the context of the req.
 // jsonPayload is the payload for the req.
 // pollInterval is the time to wait between each subsequent polling req.
@@ -88,82 +326,317 @@ func (c *Client) PollJobStatus(
 	httpRespChan chan *http.Response,
 	errChan chan error,
 ) {
-	// Add default timeout if ctx has no deadline.
-	if _, ok := ctx.Deadline(); !ok {
-		context, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	resp, err := c.WaitJob(ctx, jobID, pollInterval)
+	if err != nil {
+		errChan <- err
+		close(errChan)
+		close(httpRespChan)
+		return
+	}
+
+	close(errChan)
+	httpRespChan <- resp
+	close(httpRespChan)
+}
+
+// WaitJob polls the job status until the job is done and returns the http resp
+// with its results. Unlike PollJobStatus it polls in the calling goroutine,
+// and the waits between polls are scheduled by the shared poll scheduler of
+// the client, so that polling many jobs does not spawn goroutines or timers per job.
+// The job is polled at the constant pollInterval, or the one of the client if 0.
+func (c *Client) WaitJob(
+	ctx context.Context,
+	jobID string,
+	pollInterval time.Duration,
+) (*http.Response, error) {
+	return c.WaitJobCurve(ctx, jobID, c.PollCurve(nil, pollInterval))
+}
+
+// WaitJobCurve polls the job status like WaitJob, with the waits between
+// polls following curve, e.g. the one returned by PollCurve for the job payload.
+func (c *Client) WaitJobCurve(
+	ctx context.Context,
+	jobID string,
+	curve oxylabs.PollCurve,
+) (*http.Response, error) {
+	// The slot of the job is freed once it finishes, not when polling returns.
+	defer c.jobLimiter().poll(jobID)()
+	defer c.credentialPins().unpin(jobID)
+	ctx = withJobID(ctx, jobID)
+	// Fetching is bounded by the caller's ctx and the fetch timeout, not by the poll timeout.
+	fetchCtx := ctx
+
+	// Register the poller, so that closing the client cancels it.
+	ctx, done, err := c.startPoller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	// Limit the polling duration, or add default timeout if ctx has no deadline.
+	maxPollDuration := c.config().MaxPollDuration
+	if timeout := c.config().Timeouts.Poll; timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	} else if _, ok := ctx.Deadline(); !ok && maxPollDuration == 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Settings().Timeout)
+		defer cancel()
+	}
+
+	// Give up with a typed error once the max poll duration is exceeded.
+	if maxPollDuration != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, maxPollDuration, &oxylabs.JobTimeoutError{
+			JobID:           jobID,
+			MaxPollDuration: maxPollDuration,
+		})
 		defer cancel()
-		ctx = context
 	}
 
-	// Set wait time between requests.
-	sleepTime := DefaultPollInterval
-	if pollInterval != 0 {
-		sleepTime = pollInterval
+	scheduler := c.pollScheduler()
+	waiter := newPollWaiter()
+	started := time.Now()
+
+	// Wait for the job to make progress before the first status req.
+	if curve.InitialDelay > 0 {
+		if err := scheduler.sleep(ctx, waiter, curve.InitialDelay); err != nil {
+			return nil, c.pollErr(ctx)
+		}
 	}
 
+	sleepTime := curve.Interval
+	if sleepTime <= 0 {
+		sleepTime = c.Settings().PollInterval
+	}
 	for {
 		// Perform a req to query job status.
-		req, _ := http.NewRequest(
+		req, _ := http.NewRequestWithContext(
+			ctx,
 			"GET",
-			fmt.Sprintf("https://data.oxylabs.io/v1/queries/%s", jobID),
+			c.jobUrl(jobID, ""),
 			nil,
 		)
 		req.Header.Add("Content-type", "application/json")
-		req.SetBasicAuth(
-			c.ApiCredentials.Username,
-			c.ApiCredentials.Password,
-		)
-		resp, err := c.HttpClient.Do(req)
+		if err := c.setAuth(req); err != nil {
+			return nil, err
+		}
+		resp, err := c.do(req)
 		if err != nil {
-			errChan <- err
-			close(httpRespChan)
-			return
+			if ctx.Err() != nil {
+				err = c.pollErr(ctx)
+			}
+			return nil, err
 		}
 
 		// Read the resp body into a buffer.
 		respBody, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
 		if err != nil {
-			err = fmt.Errorf("error reading resp body: %v", err)
-			errChan <- err
-			close(httpRespChan)
-			return
+			return nil, fmt.Errorf("error reading resp body: %v", err)
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			// The job expired, so identical reqs must not reuse it.
+			if resp.StatusCode == http.StatusNotFound {
+				c.uncacheJob(jobID, true)
+			}
+			return nil, fmt.Errorf("error with status code %s: %s", resp.Status, respBody)
 		}
 
 		// Unmarshal into job.
 		job := &Job{}
 		if err = json.Unmarshal(respBody, &job); err != nil {
-			err = fmt.Errorf("error unmarshalling job resp body: %v", err)
-			errChan <- err
-			close(httpRespChan)
-			return
+			return nil, fmt.Errorf("error unmarshalling job resp body: %v", err)
 		}
 
 		// Check job status.
-		if job.Status == "done" {
-			c.GetHttpResp(job.ID, httpRespChan, errChan)
-			return
-		} else if job.Status == "faulted" {
-			err = fmt.Errorf("there was an error processing your query")
-			errChan <- err
-			close(httpRespChan)
-			return
+		c.emit(&oxylabs.JobPolledEvent{Time: time.Now(), JobID: job.ID, Status: job.Status})
+		c.jobStatusChecked(jobID, job.Status)
+		if job.Status == oxylabs.JobDone {
+			c.emit(&oxylabs.JobCompletedEvent{Time: time.Now(), JobID: job.ID, Waited: time.Since(started)})
+			return c.GetJobResult(fetchCtx, job.ID)
+		} else if job.Status == oxylabs.JobFaulted {
+			fault := c.jobFault(ctx, job.ID, respBody)
+			c.emit(&oxylabs.JobFaultedEvent{Time: time.Now(), Err: fault})
+			return nil, fault
+		}
+
+		if err := scheduler.sleep(ctx, waiter, sleepTime); err != nil {
+			return nil, c.pollErr(ctx)
+		}
+		sleepTime = curve.Next(sleepTime)
+	}
+}
+
+// GetJob gets the job with a single req into v, e.g. to read the
+// options it was submitted with.
+func (c *Client) GetJob(ctx context.Context, jobID string, v interface{}) error {
+	return c.GetJSON(withJobID(ctx, jobID), c.jobUrl(jobID, ""), v)
+}
+
+// ResumedJob is a submitted job which polling is resumed for, e.g. after a restart.
+type ResumedJob struct {
+	// Payload is the payload the job was submitted with, as persisted in the job
+	// store, so that the job is resubmitted if it faults. It is nil if the store
+	// has no record of the job.
+	Payload []byte
+	// Strategy decodes the results as requested by the submitted job.
+	Strategy oxylabs.DecodeStrategy
+	// Curve is the poll curve of the submitted job, or the constant pollInterval.
+	Curve oxylabs.PollCurve
+}
+
+// ResumeJob loads the job from the job store, or from the job endpoint if the
+// store has no record of it, so that polling is resumed as the job was submitted.
+// The job is polled with username, or the account persisted in the job store if empty.
+func (c *Client) ResumeJob(
+	ctx context.Context,
+	jobID string,
+	username string,
+	pollInterval time.Duration,
+) (*ResumedJob, error) {
+	stored, ok := c.storedJob(jobID)
+	if ok && username == "" {
+		username = stored.Username
+	}
+	if err := c.PinAccount(jobID, username); err != nil {
+		return nil, err
+	}
+
+	// The submitted payload and the job record share the parse, parsing
+	// instructions, source and render fields.
+	record := []byte(stored.Payload)
+	if !ok {
+		var job json.RawMessage
+		if err := c.GetJob(ctx, jobID, &job); err != nil {
+			return nil, err
 		}
+		record = job
+	}
+
+	var job struct {
+		Parse               bool            `json:"parse"`
+		ParsingInstructions json.RawMessage `json:"parsing_instructions"`
+	}
+	if err := json.Unmarshal(record, &job); err != nil {
+		return nil, fmt.Errorf("error unmarshalling job %s: %w", jobID, err)
+	}
+	customParser := len(job.ParsingInstructions) != 0 && string(job.ParsingInstructions) != "null"
+
+	resumed := &ResumedJob{
+		Strategy: oxylabs.DecodeStrategyFor(job.Parse, customParser),
+		Curve:    c.PollCurve(record, pollInterval),
+	}
+	if ok {
+		resumed.Payload = stored.Payload
+	}
+
+	return resumed, nil
+}
+
+// storedJob returns the record of the job in the job store, if it is not done.
+func (c *Client) storedJob(jobID string) (jobstore.Job, bool) {
+	store := c.config().JobStore
+	if store == nil {
+		return jobstore.Job{}, false
+	}
 
-		select {
-		case <-ctx.Done():
-			err = fmt.Errorf("timeout exceeded")
-			errChan <- err
-			close(httpRespChan)
-			return
-		default:
-			time.Sleep(sleepTime)
+	jobs, err := store.Load()
+	if err != nil {
+		return jobstore.Job{}, false
+	}
+	for _, job := range jobs {
+		if job.ID == jobID {
+			return job, true
 		}
 	}
+
+	return jobstore.Job{}, false
+}
+
+// CheckJobStatus gets the status of the job with a single req, without polling,
+// e.g. for external schedulers driving their own polling cadence.
+func (c *Client) CheckJobStatus(ctx context.Context, jobID string) (oxylabs.JobStatus, error) {
+	job := &Job{}
+	if err := c.GetJob(ctx, jobID, job); err != nil {
+		return "", err
+	}
+
+	c.emit(&oxylabs.JobPolledEvent{Time: time.Now(), JobID: job.ID, Status: job.Status})
+	c.jobStatusChecked(jobID, job.Status)
+
+	return job.Status, nil
+}
+
+// jobStatusChecked updates the job store, the plan limits and the cache once
+// a status req observes that the job finished. A faulted job is evicted from
+// the cache, so that identical reqs submit a new job.
+func (c *Client) jobStatusChecked(jobID string, status oxylabs.JobStatus) {
+	if !status.Finished() {
+		return
+	}
+
+	c.markJobDone(jobID)
+	c.ReleaseJob(jobID)
+	c.uncacheJob(jobID, status != oxylabs.JobDone)
+}
+
+// markJobDone marks the job as done in the job store, if one is configured.
+// The job has already finished so a failure to persist it is not surfaced.
+func (c *Client) markJobDone(jobID string) {
+	if store := c.config().JobStore; store != nil {
+		store.MarkDone(jobID)
+	}
 }
 
 // Job struct to get job id and status for the async polling.
 type Job struct {
-	ID     string `json:"id"`
-	Status string `json:"status"`
+	ID     string            `json:"id"`
+	Status oxylabs.JobStatus `json:"status"`
+}
+
+// AsyncTimeout returns the timeout of async scrapes made without a context:
+// the sum of the phase timeouts if any is set, counting unset phases as
+// the client timeout, otherwise the client timeout.
+func (c *Client) AsyncTimeout() time.Duration {
+	timeouts := c.config().Timeouts
+	if timeouts.Poll == 0 {
+		timeouts.Poll = c.config().MaxPollDuration
+	}
+	if timeouts == (oxylabs.Timeouts{}) {
+		return c.Settings().Timeout
+	}
+
+	var total time.Duration
+	for _, timeout := range []time.Duration{timeouts.Submit, timeouts.Poll, timeouts.Fetch} {
+		if timeout == 0 {
+			timeout = c.Settings().Timeout
+		}
+		total += timeout
+	}
+
+	return total
+}
+
+// withTimeout returns a copy of ctx with the timeout, if it is not zero.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// cancelBody cancels the context of a req once its resp body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
 }
@@ -0,0 +1,430 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/jobstore"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_PollJobStatus_PollTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "123", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "user", "pass", oxylabs.WithTimeouts(oxylabs.Timeouts{Poll: 20 * time.Millisecond}))
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	httpRespChan := make(chan *http.Response, 1)
+	errChan := make(chan error, 1)
+	start := time.Now()
+	c.PollJobStatus(context.Background(), "123", time.Millisecond, httpRespChan, errChan)
+
+	assert.EqualError(t, <-errChan, "timeout exceeded")
+	assert.Less(t, time.Since(start), time.Second)
+	_, ok := <-httpRespChan
+	assert.False(t, ok)
+}
+
+func TestClient_PollJobStatus_MaxPollDuration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "123", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "user", "pass", oxylabs.WithMaxPollDuration(20*time.Millisecond))
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	// The max poll duration applies even if the ctx has a later deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	httpRespChan := make(chan *http.Response, 1)
+	errChan := make(chan error, 1)
+	c.PollJobStatus(ctx, "123", time.Millisecond, httpRespChan, errChan)
+
+	err := <-errChan
+	assert.ErrorIs(t, err, oxylabs.ErrJobTimeout)
+	var timeoutErr *oxylabs.JobTimeoutError
+	if assert.ErrorAs(t, err, &timeoutErr) {
+		assert.Equal(t, "123", timeoutErr.JobID)
+	}
+	assert.Equal(t, 20*time.Millisecond+2*DefaultTimeout, c.AsyncTimeout())
+}
+
+func TestClient_AsyncTimeout(t *testing.T) {
+	assert.Equal(t, DefaultTimeout, NewClient("", "", "").AsyncTimeout())
+
+	c := NewClient("", "", "", oxylabs.WithTimeouts(oxylabs.Timeouts{Submit: time.Second, Poll: time.Minute}))
+	assert.Equal(t, time.Second+time.Minute+DefaultTimeout, c.AsyncTimeout())
+}
+
+func TestClient_WaitJob_FetchCanceledWithCtx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/results") {
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`{"id": "123", "status": "done"}`))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "user", "pass", oxylabs.WithTimeouts(oxylabs.Timeouts{Fetch: time.Minute}))
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	// The fetch timeout does not outlive the caller's ctx.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	_, err := c.WaitJob(ctx, "123", time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(started), 10*time.Second)
+}
+
+func TestClient_GetJobIDCtx_IdempotencyKey(t *testing.T) {
+	submissions := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		submissions++
+		w.Write([]byte(`{"id": "123", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL+"/v1/queries", "user", "pass", oxylabs.WithJobStore(jobstore.NewMemoryStore()))
+	ctx := oxylabs.WithIdempotencyKey(context.Background(), "order-1")
+
+	for i := 0; i < 2; i++ {
+		jobID, err := c.GetJobIDCtx(ctx, []byte(`{"source": "google_search", "query": "adidas"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "123", jobID)
+	}
+	assert.Equal(t, 1, submissions)
+
+	_, err := c.GetJobIDCtx(ctx, []byte(`{"source": "google_search", "query": "nike"}`))
+	assert.ErrorContains(t, err, "used with a different payload")
+
+	_, err = NewClient(server.URL+"/v1/queries", "user", "pass").GetJobIDCtx(ctx, []byte(`{}`))
+	assert.ErrorContains(t, err, "require a job store")
+}
+
+func TestClient_ResubmitFaulted_IdempotencyKey(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		fmt.Fprintf(w, `{"id": "%d", "status": "pending"}`, len(keys))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL+"/v1/queries", "user", "pass",
+		oxylabs.WithJobStore(jobstore.NewMemoryStore()),
+		oxylabs.WithRetryFaultedJobs(2),
+	)
+	ctx := oxylabs.WithIdempotencyKey(context.Background(), "order-1")
+	payload := []byte(`{"source": "google_search", "query": "adidas"}`)
+
+	jobID, err := c.GetJobIDCtx(ctx, payload)
+	assert.NoError(t, err)
+
+	// Each resubmission is made once, with a key of its own.
+	for attempt := 1; attempt <= 2; attempt++ {
+		for i := 0; i < 2; i++ {
+			newJobID, err := c.ResubmitFaulted(ctx, payload, attempt, &oxylabs.JobFaultError{JobID: jobID})
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprint(attempt+1), newJobID)
+		}
+		jobID = fmt.Sprint(attempt + 1)
+	}
+	assert.Equal(t, []string{"order-1", "order-1-retry-1", "order-1-retry-2"}, keys)
+
+	// The original key still maps to the first job.
+	jobID, err = c.GetJobIDCtx(ctx, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", jobID)
+}
+
+func TestClient_ResubmitFaulted_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL+"/v1/queries", "user", "pass", oxylabs.WithRetryFaultedJobs(1))
+	payload := []byte(`{"source": "google_search", "query": "adidas"}`)
+	fault := &oxylabs.JobFaultError{JobID: "123", Reason: "blocked"}
+
+	// The fault is kept along with the error of the resubmission.
+	_, err := c.ResubmitFaulted(context.Background(), payload, 1, fault)
+	var faultErr *oxylabs.JobFaultError
+	assert.ErrorAs(t, err, &faultErr)
+	assert.Equal(t, "blocked", faultErr.Reason)
+	assert.ErrorContains(t, err, "error resubmitting faulted job 123")
+	assert.ErrorContains(t, err, "500")
+
+	// The error of the resubmission is kept too.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.ResubmitFaulted(ctx, payload, 1, fault)
+	assert.ErrorAs(t, err, &faultErr)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestClient_GetJobIDCtx_IdempotencyKeyPending(t *testing.T) {
+	var mu sync.Mutex
+	var keys []string
+	status := http.StatusBadRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte(`{"id": "123", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	store := jobstore.NewMemoryStore()
+	c := NewClient(server.URL+"/v1/queries", "user", "pass", oxylabs.WithJobStore(store))
+	ctx := oxylabs.WithIdempotencyKey(context.Background(), "order-1")
+	payload := []byte(`{"source": "google_search", "query": "adidas"}`)
+
+	// A rejected job releases the key.
+	_, err := c.GetJobIDCtx(ctx, payload)
+	assert.ErrorContains(t, err, "400")
+	_, err = store.FindByKey("order-1")
+	assert.ErrorIs(t, err, jobstore.ErrNotFound)
+
+	// Concurrent submissions with the key create one job.
+	mu.Lock()
+	status = http.StatusOK
+	mu.Unlock()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			jobID, err := c.GetJobIDCtx(ctx, payload)
+			assert.NoError(t, err)
+			assert.Equal(t, "123", jobID)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, []string{"order-1", "order-1"}, keys)
+
+	// A submission without a resp leaves the key pending.
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	})
+	ctx = oxylabs.WithIdempotencyKey(context.Background(), "order-2")
+	_, err = c.GetJobIDCtx(ctx, payload)
+	assert.ErrorContains(t, err, "error performing req")
+	_, err = c.GetJobIDCtx(ctx, payload)
+	assert.ErrorIs(t, err, oxylabs.ErrSubmissionUnknown)
+
+	job, err := store.FindByKey("order-2")
+	assert.NoError(t, err)
+	assert.True(t, job.Pending)
+	assert.NoError(t, store.ReleaseKey("order-2"))
+	_, err = store.FindByKey("order-2")
+	assert.ErrorIs(t, err, jobstore.ErrNotFound)
+}
+
+func TestClient_GetJobIDCtx_IdempotencyKeyNotSent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "123", "status": "pending"}`))
+	}))
+	serverUrl := server.URL
+	server.Close()
+
+	store := jobstore.NewMemoryStore()
+	c := NewClient(serverUrl, "user", "pass", oxylabs.WithJobStore(store))
+	ctx := oxylabs.WithIdempotencyKey(context.Background(), "order-1")
+	payload := []byte(`{"source": "google_search", "query": "adidas"}`)
+
+	// A req which failed to dial did not create a job, so the key is released.
+	_, err := c.GetJobIDCtx(ctx, payload)
+	assert.True(t, NotSent(err))
+	_, err = store.FindByKey("order-1")
+	assert.ErrorIs(t, err, jobstore.ErrNotFound)
+
+	// A ctx canceled before the req is sent releases the key too.
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = c.GetJobIDCtx(canceledCtx, payload)
+	assert.ErrorIs(t, err, context.Canceled)
+	_, err = store.FindByKey("order-1")
+	assert.ErrorIs(t, err, jobstore.ErrNotFound)
+
+	// The retry submits the job with the same key.
+	server = httptest.NewServer(server.Config.Handler)
+	defer server.Close()
+	c.BaseUrl = server.URL
+	jobID, err := c.GetJobIDCtx(ctx, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", jobID)
+}
+
+func TestClient_PollJobStatus_JobFaultError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "source": "google_search", "status": "faulted"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": [{"content": "Faulted after too many retries", "status_code": 613}]}`))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "user", "pass")
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	httpRespChan := make(chan *http.Response, 1)
+	errChan := make(chan error, 1)
+	c.PollJobStatus(context.Background(), "123", time.Millisecond, httpRespChan, errChan)
+
+	var fault *oxylabs.JobFaultError
+	if assert.ErrorAs(t, <-errChan, &fault) {
+		assert.Equal(t, "google_search", fault.Source)
+		assert.Equal(t, 613, fault.StatusCode)
+		assert.True(t, fault.Blocked())
+		assert.False(t, fault.BadParameters())
+		assert.EqualError(t, fault, "there was an error processing your query: job 123 faulted with status code 613: Faulted after too many retries")
+	}
+}
+
+func TestClient_jobFault_AsyncUrl(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/jobs/123/results" {
+			w.Write([]byte(`{"results": [{"content": "Faulted after too many retries", "status_code": 613}]}`))
+		}
+	}))
+	defer server.Close()
+
+	// The results of the faulted job are fetched from the custom async url.
+	c := NewClient(SyncBaseUrl, "user", "pass")
+	c.AsyncUrl = server.URL + "/jobs"
+	fault := c.jobFault(context.Background(), "123", []byte(`{"id": "123", "status": "faulted"}`))
+	assert.Equal(t, 613, fault.StatusCode)
+	assert.Equal(t, "Faulted after too many retries", fault.Reason)
+}
+
+func TestClient_WaitJob_ErrorStatus(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusBadGateway} {
+		var polls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&polls, 1)
+			w.WriteHeader(status)
+			w.Write([]byte(`{"message": "error"}`))
+		}))
+
+		// The error status ends polling instead of being polled until the timeout.
+		c := NewClient(SyncBaseUrl, "user", "pass")
+		c.AsyncUrl = server.URL
+		_, err := c.WaitJob(context.Background(), "123", time.Millisecond)
+		assert.ErrorContains(t, err, fmt.Sprintf("error with status code %d", status))
+		assert.EqualValues(t, 1, polls)
+		server.Close()
+	}
+}
+
+func TestClient_WaitJob_AsyncUrl(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/jobs":
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		case "/jobs/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/jobs/123/results":
+			w.Write([]byte(`{"results": [{"content": "ok"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// The job is submitted to, polled at and fetched from the custom async url.
+	c := NewClient(SyncBaseUrl, "user", "pass")
+	c.AsyncUrl = server.URL + "/jobs"
+	jobID, err := c.GetJobIDCtx(context.Background(), []byte(`{"source": "google_search", "query": "adidas"}`))
+	assert.NoError(t, err)
+
+	status, err := c.CheckJobStatus(context.Background(), jobID)
+	assert.NoError(t, err)
+	assert.Equal(t, oxylabs.JobDone, status)
+
+	resp, err := c.WaitJob(context.Background(), jobID, time.Millisecond)
+	if assert.NoError(t, err) {
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestClient_PollJobStatus_ClosesChannels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": []}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "user", "pass")
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	// On success the error channel is closed without an error.
+	httpRespChan := make(chan *http.Response, 1)
+	errChan := make(chan error, 1)
+	c.PollJobStatus(context.Background(), "123", time.Millisecond, httpRespChan, errChan)
+	_, ok := <-errChan
+	assert.False(t, ok)
+	resp, ok := <-httpRespChan
+	assert.True(t, ok)
+	resp.Body.Close()
+	_, ok = <-httpRespChan
+	assert.False(t, ok)
+
+	// On failure both channels are closed after the error.
+	httpRespChan = make(chan *http.Response, 1)
+	errChan = make(chan error, 1)
+	server.Close()
+	c.GetHttpResp("123", httpRespChan, errChan)
+	assert.Error(t, <-errChan)
+	_, ok = <-errChan
+	assert.False(t, ok)
+	_, ok = <-httpRespChan
+	assert.False(t, ok)
+}
@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are the headers whose values are redacted from audit records.
+var sensitiveHeaders = map[string]bool{
+	"authorization":       true,
+	"proxy-authorization": true,
+	"cookie":              true,
+}
+
+// audit calls the audit hook of the client, if one is configured.
+func (c *Client) audit(
+	req *http.Request,
+	jsonPayload []byte,
+	jobID string,
+	statusCode int,
+	err error,
+) {
+	hook := c.config().AuditHook
+	if hook == nil {
+		return
+	}
+
+	username, _, _ := req.BasicAuth()
+	hook(oxylabs.AuditRecord{
+		Time:       time.Now(),
+		Url:        req.URL.String(),
+		Username:   username,
+		Payload:    redactPayload(jsonPayload),
+		JobID:      jobID,
+		StatusCode: statusCode,
+		Err:        err,
+	})
+}
+
+// redactPayload returns a copy of the payload with the values of
+// sensitive headers and cookies in its context redacted.
+func redactPayload(jsonPayload []byte) json.RawMessage {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return jsonPayload
+	}
+
+	context, _ := payload["context"].([]interface{})
+	for _, item := range context {
+		kv, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch kv["key"] {
+		case "headers":
+			headers, _ := kv["value"].(map[string]interface{})
+			for name := range headers {
+				if sensitiveHeaders[strings.ToLower(name)] {
+					headers[name] = redacted
+				}
+			}
+		case "cookies":
+			cookies, _ := kv["value"].([]interface{})
+			for _, cookie := range cookies {
+				if cookie, ok := cookie.(map[string]interface{}); ok {
+					cookie["value"] = redacted
+				}
+			}
+		}
+	}
+
+	redactedPayload, err := json.Marshal(payload)
+	if err != nil {
+		return jsonPayload
+	}
+
+	return redactedPayload
+}
+
+// respJobID returns the job ID of a realtime resp body.
+func respJobID(body []byte) string {
+	var resp struct {
+		Job struct {
+			ID string `json:"id"`
+		} `json:"job"`
+		Results []struct {
+			JobID string `json:"job_id"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return ""
+	}
+
+	if resp.Job.ID == "" && len(resp.Results) > 0 {
+		return resp.Results[0].JobID
+	}
+
+	return resp.Job.ID
+}
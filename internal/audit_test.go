@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Req_AuditHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": [{"job_id": "123"}], "job": {"id": "123"}}`))
+	}))
+	defer server.Close()
+
+	var records []oxylabs.AuditRecord
+	c := NewClient(server.URL, "user", "pass", oxylabs.WithAuditHook(func(record oxylabs.AuditRecord) {
+		records = append(records, record)
+	}))
+
+	payload := `{"context":[` +
+		`{"key":"headers","value":{"Authorization":"Bearer secret","Accept":"text/html"}},` +
+		`{"key":"cookies","value":[{"key":"session","value":"secret"}]}` +
+		`],"source":"universal"}`
+	resp, err := c.Req(context.Background(), []byte(payload), "POST")
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Len(t, records, 1)
+	assert.Equal(t, "user", records[0].Username)
+	assert.Equal(t, "123", records[0].JobID)
+	assert.Equal(t, http.StatusOK, records[0].StatusCode)
+	assert.JSONEq(t, `{"context":[`+
+		`{"key":"headers","value":{"Authorization":"[REDACTED]","Accept":"text/html"}},`+
+		`{"key":"cookies","value":[{"key":"session","value":"[REDACTED]"}]}`+
+		`],"source":"universal"}`, string(records[0].Payload))
+	assert.NotContains(t, string(records[0].Payload), "pass")
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Buffers grown larger are not pooled, so that a single huge resp
+// does not stay in memory.
+const maxPooledBufferSize = 64 << 20
+
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// ReadBody reads r for decoding with codec. With the default codec, r is read
+// into a pooled buffer, which reduces the allocations of reading multi-MB resp
+// bodies. release returns the buffer to the pool and must be called once the
+// body is no longer used. The std codec copies the bytes it keeps, while custom
+// codecs may decode without copying, e.g. sonic, so that the decoded values would
+// point into the reused buffer; their bodies are not pooled.
+func ReadBody(r io.Reader, codec oxylabs.Codec) (body []byte, release func(), err error) {
+	if _, ok := codec.(oxylabs.StdCodec); !ok {
+		body, err = io.ReadAll(r)
+		return body, func() {}, err
+	}
+
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	if _, err := buf.ReadFrom(r); err != nil {
+		releaseBody(buf)
+		return nil, nil, err
+	}
+
+	return buf.Bytes(), func() { releaseBody(buf) }, nil
+}
+
+// releaseBody returns a buffer read with ReadBody to the pool.
+func releaseBody(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBufferSize {
+		bufferPool.Put(buf)
+	}
+}
+
+// limitResp limits the body of resp to the maximum resp size of the client.
+func (c *Client) limitResp(resp *http.Response) error {
+	maxSize := c.config().MaxRespSize
+	if maxSize <= 0 {
+		return nil
+	}
+	if resp.ContentLength > maxSize {
+		resp.Body.Close()
+		return fmt.Errorf("%w: %d bytes exceed the maximum of %d", oxylabs.ErrRespTooLarge, resp.ContentLength, maxSize)
+	}
+	resp.Body = &limitedBody{ReadCloser: resp.Body, max: maxSize, remaining: maxSize}
+
+	return nil
+}
+
+// limitedBody fails reads with ErrRespTooLarge once more than max bytes are read.
+type limitedBody struct {
+	io.ReadCloser
+	max       int64
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		// Check whether the body ends at the maximum.
+		var one [1]byte
+		if n, err := b.ReadCloser.Read(one[:]); n == 0 {
+			return 0, err
+		}
+		return 0, fmt.Errorf("%w: exceeds the maximum of %d bytes", oxylabs.ErrRespTooLarge, b.max)
+	}
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+
+	return n, err
+}
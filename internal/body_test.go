@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_MaxRespSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := strings.Repeat("x", 100)
+		if r.URL.Query().Has("chunked") {
+			// Flushing before writing the body omits the Content-Length.
+			w.(http.Flusher).Flush()
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user", "pass", oxylabs.WithMaxRespSize(10))
+	_, err := c.Req(context.Background(), []byte(`{}`), "POST")
+	assert.ErrorIs(t, err, oxylabs.ErrRespTooLarge)
+
+	c.BaseUrl = server.URL + "?chunked=1"
+	resp, err := c.Req(context.Background(), []byte(`{}`), "POST")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	_, _, err = ReadBody(resp.Body, oxylabs.StdCodec{})
+	assert.ErrorIs(t, err, oxylabs.ErrRespTooLarge)
+
+	c = c.Clone(oxylabs.WithMaxRespSize(100))
+	resp, err = c.Req(context.Background(), []byte(`{}`), "POST")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Len(t, body, 100)
+}
+
+type aliasingCodec struct{ oxylabs.StdCodec }
+
+func TestReadBody_CustomCodec(t *testing.T) {
+	// A zero-copy codec keeps referencing the body after decoding.
+	body, release, err := ReadBody(strings.NewReader("first"), aliasingCodec{})
+	assert.NoError(t, err)
+	release()
+
+	for i := 0; i < 10; i++ {
+		_, release, err := ReadBody(strings.NewReader("other"), oxylabs.StdCodec{})
+		assert.NoError(t, err)
+		release()
+	}
+	assert.Equal(t, "first", string(body))
+}
@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal/payloads"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// reqKey returns the key of the payload with the given prefix used for caching and
+// deduplication, or an empty string if neither is configured or the payload
+// cannot be normalized. Keys are scoped by the endpoint and account of the req,
+// see scopeKey, so that a cache shared by several clients does not serve the
+// results and jobs of one account to another.
+func (c *Client) reqKey(ctx context.Context, prefix string, jsonPayload []byte) string {
+	if c.config().Cache == nil && !c.config().Deduplicate {
+		return ""
+	}
+
+	key, err := payloads.Key(jsonPayload)
+	if err != nil {
+		return ""
+	}
+
+	return prefix + ":" + c.scopeKey(ctx) + ":" + key
+}
+
+// scopeKey returns a hash of the base url of the client and the credentials of
+// the req, including the password, as cache hits are served without authenticating.
+// Clients with a credentials provider are scoped by the provider instead, as the
+// account of a req is only known once it is sent.
+func (c *Client) scopeKey(ctx context.Context) string {
+	var account string
+	if username, password, ok := oxylabs.ReqCredentials(ctx); ok {
+		account = username + "\x00" + password
+	} else if provider := c.config().Credentials; provider != nil {
+		account = fmt.Sprintf("%T:%p", provider, provider)
+	} else {
+		credentials := c.credentials()
+		account = credentials.Username + "\x00" + credentials.Password
+	}
+	sum := sha256.Sum256([]byte(c.BaseUrl + "\x00" + account))
+
+	return hex.EncodeToString(sum[:16])
+}
+
+// cacheGet returns the cached value for key. The cache only saves credits,
+// so cache failures are treated as misses.
+func (c *Client) cacheGet(ctx context.Context, key string) ([]byte, bool) {
+	if key == "" || c.config().Cache == nil {
+		return nil, false
+	}
+
+	value, ok, err := c.config().Cache.Get(ctx, key)
+	if err != nil {
+		return nil, false
+	}
+
+	return value, ok
+}
+
+// cacheSet stores value for key, ignoring cache failures.
+func (c *Client) cacheSet(ctx context.Context, key string, value []byte) {
+	if key == "" || c.config().Cache == nil {
+		return
+	}
+
+	c.config().Cache.Set(ctx, key, value, c.config().CacheTTL)
+}
+
+// cachedResp returns a successful http resp with the cached body.
+func cachedResp(body []byte) *http.Response {
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+}
@@ -0,0 +1,226 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/cache"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Req_Cache(t *testing.T) {
+	reqs := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs++
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "username", "password", oxylabs.WithCache(cache.NewLRU(10), time.Minute))
+
+	for i := 0; i < 3; i++ {
+		resp, err := c.Req(context.Background(), []byte(`{"query": "adidas"}`), "POST")
+		assert.NoError(t, err)
+
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, `{"results": []}`, string(body))
+	}
+	assert.Equal(t, 1, reqs)
+}
+
+func TestClient_Req_SharedCache(t *testing.T) {
+	var reqs atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs.Add(1)
+		username, _, _ := r.BasicAuth()
+		w.Write([]byte(`{"results": [{"content": "` + username + `"}]}`))
+	}))
+	defer server.Close()
+
+	shared := cache.NewLRU(10)
+	req := func(c *Client) string {
+		resp, err := c.Req(context.Background(), []byte(`{"query": "adidas"}`), "POST")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	a := NewClient(server.URL, "team-a", "password", oxylabs.WithCache(shared, time.Minute))
+	b := NewClient(server.URL, "team-b", "password", oxylabs.WithCache(shared, time.Minute))
+	assert.Equal(t, `{"results": [{"content": "team-a"}]}`, req(a))
+	assert.Equal(t, `{"results": [{"content": "team-b"}]}`, req(b))
+	assert.Equal(t, int32(2), reqs.Load())
+
+	// The same account is scoped by endpoint and password too.
+	assert.Equal(t, `{"results": [{"content": "team-a"}]}`, req(NewClient(server.URL, "team-a", "password", oxylabs.WithCache(shared, time.Minute))))
+	assert.Equal(t, int32(2), reqs.Load())
+	req(NewClient(server.URL, "team-a", "wrong", oxylabs.WithCache(shared, time.Minute)))
+	req(NewClient(server.URL+"/", "team-a", "password", oxylabs.WithCache(shared, time.Minute)))
+	assert.Equal(t, int32(4), reqs.Load())
+}
+
+func TestClient_Req_Deduplicate(t *testing.T) {
+	var reqs int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&reqs, 1)
+		<-release
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "username", "password", oxylabs.WithDeduplication())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := c.Req(context.Background(), []byte(`{"query": "adidas"}`), "POST")
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			assert.Equal(t, `{"results": []}`, string(body))
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reqs))
+}
+
+func TestClient_Req_DeduplicateCanceledCaller(t *testing.T) {
+	var reqs int32
+	release := make(chan struct{})
+	canceled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&reqs, 1)
+		// The server only notices a closed connection once the body is read.
+		io.ReadAll(r.Body)
+		select {
+		case <-release:
+			w.Write([]byte(`{"results": []}`))
+		case <-r.Context().Done():
+			close(canceled)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "username", "password", oxylabs.WithDeduplication())
+	payload := []byte(`{"query": "adidas"}`)
+
+	// The caller which started the shared req stops waiting once its ctx is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := c.Req(ctx, payload, "POST")
+		errChan <- err
+	}()
+	respChan := make(chan *http.Response, 1)
+	go func() {
+		resp, err := c.Req(context.Background(), payload, "POST")
+		assert.NoError(t, err)
+		respChan <- resp
+	}()
+	waitFlightWaiters(&c.flights, 2)
+	cancel()
+	assert.ErrorIs(t, <-errChan, context.Canceled)
+
+	// The shared req is not canceled while another caller waits for it.
+	close(release)
+	resp := <-respChan
+	if resp != nil {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, `{"results": []}`, string(body))
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reqs))
+
+	// The shared req is canceled once no caller waits for it.
+	release = make(chan struct{})
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		_, err := c.Req(ctx, []byte(`{"query": "nike"}`), "POST")
+		errChan <- err
+	}()
+	waitFlightWaiters(&c.flights, 1)
+	cancel()
+	assert.ErrorIs(t, <-errChan, context.Canceled)
+	<-canceled
+}
+
+// waitFlightWaiters waits until a call of g has n waiters.
+func waitFlightWaiters(g *flightGroup, n int) {
+	for {
+		g.mu.Lock()
+		for _, f := range g.calls {
+			if f.waiters == n {
+				g.mu.Unlock()
+				return
+			}
+		}
+		g.mu.Unlock()
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestClient_GetJobIDCtx_CacheEvictsFinishedJobs(t *testing.T) {
+	var submitted atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/1":
+			w.Write([]byte(`{"id": "1", "status": "faulted"}`))
+		case "/v1/queries/2":
+			w.WriteHeader(http.StatusNotFound)
+		case "/v1/queries/3":
+			w.Write([]byte(`{"id": "3", "status": "done"}`))
+		case "/v1/queries/1/results", "/v1/queries/3/results":
+			w.Write([]byte(`{"results": []}`))
+		default:
+			fmt.Fprintf(w, `{"id": "%d", "status": "pending"}`, submitted.Add(1))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	lru := cache.NewLRU(10)
+	c := NewClient(server.URL+"/v1/queries", "username", "password", oxylabs.WithCache(lru, 0))
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+	payload := []byte(`{"query": "adidas"}`)
+
+	// Faulted and expired jobs are evicted, so identical reqs submit a new job.
+	for _, jobID := range []string{"1", "2"} {
+		id, err := c.GetJobIDCtx(context.Background(), payload)
+		assert.NoError(t, err)
+		assert.Equal(t, jobID, id)
+		_, err = c.WaitJob(context.Background(), jobID, time.Millisecond)
+		assert.Error(t, err)
+	}
+
+	// A done job is reused.
+	for i := 0; i < 2; i++ {
+		id, err := c.GetJobIDCtx(context.Background(), payload)
+		assert.NoError(t, err)
+		assert.Equal(t, "3", id)
+		resp, err := c.WaitJob(context.Background(), id, time.Millisecond)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, int32(3), submitted.Load())
+	assert.Empty(t, c.jobCacheKeys().keys)
+}
@@ -1,14 +1,259 @@
 package internal
 
-import "net/http"
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
 
 type ApiCredentials struct {
 	Username string
 	Password string
 }
 
+// Client is the low level API client shared by the high level clients.
+// It is safe for concurrent use.
 type Client struct {
 	BaseUrl        string
 	ApiCredentials *ApiCredentials
 	HttpClient     *http.Client
+	Config         *oxylabs.ClientConfig
+
+	// AsyncUrl is the url which async jobs are submitted to, so that a client
+	// scraping realtime at BaseUrl also submits jobs. Jobs are submitted to
+	// BaseUrl if it is empty.
+	AsyncUrl string
+	// ProxyHost is the host of the proxy endpoint, ProxyEndpointHost if empty.
+	ProxyHost string
+
+	credentialsMu sync.RWMutex
+	flights       flightGroup
+	proxyOnce     sync.Once
+	proxyClient   atomic.Pointer[http.Client]
+
+	// The poll scheduler, the job limiter, the credential pins, the job keys
+	// and the idempotency locks are shared with clones.
+	schedulerOnce   sync.Once
+	scheduler       *pollScheduler
+	limiterOnce     sync.Once
+	limiter         *jobLimiter
+	pinsOnce        sync.Once
+	pins            *credentialPins
+	jobKeysOnce     sync.Once
+	jobKeys         *jobKeys
+	idempotencyOnce sync.Once
+	idempotency     *idempotencyLocks
+
+	// sharedHttpClient is set on clones which share the http client, and so
+	// its connection pool, with the client they were cloned from.
+	sharedHttpClient bool
+
+	shutdownMu sync.Mutex
+	closed     bool
+	pollers    sync.WaitGroup
+	reqs       sync.WaitGroup
+	stopOnce   sync.Once
+	stopCtx    context.Context
+	stopPolls  context.CancelFunc
+}
+
+// NewClient returns a Client for the given base url and credentials
+// with the provided client options applied.
+func NewClient(
+	baseUrl string,
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) *Client {
+	cfg := &oxylabs.ClientConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &Client{
+		BaseUrl: baseUrl,
+		ApiCredentials: &ApiCredentials{
+			Username: username,
+			Password: password,
+		},
+		HttpClient: newHttpClient(cfg),
+		Config:     cfg,
+	}
+}
+
+// NewClientCtx returns a Client like NewClient, but checks that credentials are set
+// and, with WithVerifyCredentials, that the API accepts them.
+func NewClientCtx(
+	ctx context.Context,
+	baseUrl string,
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*Client, error) {
+	c := NewClient(baseUrl, username, password, opts...)
+	if c.Config.Credentials == nil && (username == "" || password == "") {
+		return nil, fmt.Errorf("%w: username and password must not be empty", oxylabs.ErrMissingCredentials)
+	}
+
+	if c.Config.VerifyCredentials {
+		// Add default timeout if ctx has no deadline.
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, c.Settings().Timeout)
+			defer cancel()
+		}
+		if err := c.Ping(ctx); err != nil {
+			return nil, fmt.Errorf("error verifying credentials: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// Clone returns a copy of the client with the given client options applied on
+// top of its config. The http client and its connection pool are shared with
+// the copy unless the options change the transport config. The copy is closed
+// independently of the client, and closing it leaves a shared pool open.
+func (c *Client) Clone(opts ...oxylabs.ClientOption) *Client {
+	cfg := *c.config()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	httpClient := c.HttpClient
+	if transportChanged(c.config(), &cfg) {
+		httpClient = newHttpClient(&cfg)
+	}
+
+	// A clone with another plan limit gets its own job limiter.
+	limiter := c.jobLimiter()
+	if limiter != nil && limiter.limit != cfg.ConcurrentJobs {
+		limiter = nil
+	}
+
+	credentials := c.credentials()
+
+	return &Client{
+		BaseUrl:          c.BaseUrl,
+		AsyncUrl:         c.AsyncUrl,
+		ProxyHost:        c.ProxyHost,
+		ApiCredentials:   &credentials,
+		HttpClient:       httpClient,
+		Config:           &cfg,
+		sharedHttpClient: httpClient == c.HttpClient,
+		scheduler:        c.pollScheduler(),
+		limiter:          limiter,
+		pins:             c.credentialPins(),
+		jobKeys:          c.jobCacheKeys(),
+		idempotency:      c.idempotencyLocks(),
+	}
+}
+
+// transportChanged reports whether the config fields used by newHttpClient differ.
+func transportChanged(a, b *oxylabs.ClientConfig) bool {
+	return a.MaxIdleConns != b.MaxIdleConns ||
+		a.MaxIdleConnsPerHost != b.MaxIdleConnsPerHost ||
+		a.MaxConnsPerHost != b.MaxConnsPerHost ||
+		a.IdleConnTimeout != b.IdleConnTimeout ||
+		a.DisableHTTP2 != b.DisableHTTP2 ||
+		proxyURL(a) != proxyURL(b) ||
+		a.TLSConfig != b.TLSConfig ||
+		a.RootCAs != b.RootCAs ||
+		!sameCertificates(a.Certificates, b.Certificates)
+}
+
+// sameCertificates reports whether a and b hold the same DER encoded chains.
+func sameCertificates(a, b []tls.Certificate) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i].Certificate) != len(b[i].Certificate) {
+			return false
+		}
+		for j := range a[i].Certificate {
+			if !bytes.Equal(a[i].Certificate[j], b[i].Certificate[j]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func proxyURL(cfg *oxylabs.ClientConfig) string {
+	if cfg.ProxyURL == nil {
+		return ""
+	}
+
+	return cfg.ProxyURL.String()
+}
+
+// newHttpClient returns an http client with the transport tuned by cfg.
+// Connections are kept alive and negotiate HTTP/2 unless it is disabled.
+func newHttpClient(cfg *oxylabs.ClientConfig) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableKeepAlives = false
+	transport.ForceAttemptHTTP2 = true
+
+	if cfg.DisableHTTP2 {
+		// A non-nil empty TLSNextProto map disables HTTP/2.
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	if cfg.TLSConfig != nil || cfg.Certificates != nil || cfg.RootCAs != nil {
+		tlsConfig := &tls.Config{}
+		if cfg.TLSConfig != nil {
+			tlsConfig = cfg.TLSConfig.Clone()
+		}
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cfg.Certificates...)
+		if cfg.RootCAs != nil {
+			tlsConfig.RootCAs = cfg.RootCAs
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if cfg.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(cfg.ProxyURL)
+	}
+
+	if cfg.MaxIdleConns != 0 {
+		transport.MaxIdleConns = cfg.MaxIdleConns
+	}
+	if cfg.MaxIdleConnsPerHost != 0 {
+		transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	}
+	if cfg.MaxConnsPerHost != 0 {
+		transport.MaxConnsPerHost = cfg.MaxConnsPerHost
+	}
+	if cfg.IdleConnTimeout != 0 {
+		transport.IdleConnTimeout = cfg.IdleConnTimeout
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+// config returns the client config, or an empty one if it is not set.
+func (c *Client) config() *oxylabs.ClientConfig {
+	if c == nil || c.Config == nil {
+		return &oxylabs.ClientConfig{}
+	}
+
+	return c.Config
+}
+
+// Codec returns the JSON codec of the client, encoding/json by default.
+func (c *Client) Codec() oxylabs.Codec {
+	if codec := c.config().Codec; codec != nil {
+		return codec
+	}
+
+	return oxylabs.StdCodec{}
 }
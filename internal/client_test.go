@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient_HTTP2AndKeepAlive(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []oxylabs.ClientOption
+		wantProto int
+	}{
+		{name: "http2 by default", wantProto: 2},
+		{name: "http2 disabled", opts: []oxylabs.ClientOption{oxylabs.WithDisableHTTP2()}, wantProto: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conns int32
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("{}"))
+			}))
+			server.EnableHTTP2 = true
+			server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					atomic.AddInt32(&conns, 1)
+				}
+			}
+			server.StartTLS()
+			defer server.Close()
+
+			c := NewClient(server.URL, "user", "pass", tt.opts...)
+			transport := c.HttpClient.Transport.(*http.Transport)
+			transport.TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig
+
+			for i := 0; i < 3; i++ {
+				resp, err := c.Req(context.Background(), nil, "POST")
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantProto, resp.ProtoMajor)
+				io.ReadAll(resp.Body)
+				resp.Body.Close()
+			}
+
+			// All reqs must reuse a single connection.
+			assert.Equal(t, int32(1), atomic.LoadInt32(&conns))
+		})
+	}
+}
+
+func TestNewClient_ProxyURL(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Write([]byte("{}"))
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	c := NewClient("http://realtime.oxylabs.invalid/v1/queries", "user", "pass", oxylabs.WithProxyURL(proxyURL))
+
+	resp, err := c.Req(context.Background(), nil, "POST")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "http://realtime.oxylabs.invalid/v1/queries", proxied)
+}
+
+func TestNewClient_RootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "user", "pass").Req(context.Background(), nil, "POST")
+	assert.Error(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	c := NewClient(server.URL, "user", "pass", oxylabs.WithRootCAs(pool))
+
+	resp, err := c.Req(context.Background(), nil, "POST")
+	assert.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestClient_Clone_ClientCertificates(t *testing.T) {
+	cert := tls.Certificate{Certificate: [][]byte{[]byte("cert")}}
+	c := NewClient("", "user", "pass", oxylabs.WithClientCertificates(cert))
+
+	// Equal certificates keep the http client, even in a new slice.
+	same := tls.Certificate{Certificate: [][]byte{[]byte("cert")}}
+	assert.Same(t, c.HttpClient, c.Clone(oxylabs.WithClientCertificates(same)).HttpClient)
+
+	other := tls.Certificate{Certificate: [][]byte{[]byte("other")}}
+	assert.NotSame(t, c.HttpClient, c.Clone(oxylabs.WithClientCertificates(other)).HttpClient)
+}
+
+func TestClient_ProxyHttpClient_InsecureTLS(t *testing.T) {
+	transport := NewClient("", "user", "pass").proxyHttpClient().Transport.(*http.Transport)
+	assert.False(t, transport.TLSClientConfig != nil && transport.TLSClientConfig.InsecureSkipVerify)
+
+	transport = NewClient("", "user", "pass", oxylabs.WithInsecureProxyTLS()).proxyHttpClient().Transport.(*http.Transport)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+}
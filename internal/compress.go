@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// AcceptEncoding is the value of the Accept-Encoding header sent with every req.
+const AcceptEncoding = "gzip, deflate"
+
+// decompressedBody wraps a decompressing reader so that closing it
+// also closes the underlying resp body.
+type decompressedBody struct {
+	io.Reader
+	decompressor io.Closer
+	body         io.Closer
+}
+
+func (b *decompressedBody) Close() error {
+	b.decompressor.Close()
+	return b.body.Close()
+}
+
+// decompressResp replaces the resp body with a decompressing reader
+// if the API returned a gzip or deflate encoded body.
+func decompressResp(resp *http.Response) error {
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+
+	var reader io.ReadCloser
+	switch encoding {
+	case "gzip":
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			resp.Body.Close()
+			return fmt.Errorf("error creating gzip reader: %v", err)
+		}
+		reader = gzipReader
+	case "deflate":
+		// The deflate content coding is the zlib format (RFC 1950), not raw deflate.
+		zlibReader, err := zlib.NewReader(resp.Body)
+		if err != nil {
+			resp.Body.Close()
+			return fmt.Errorf("error creating zlib reader: %v", err)
+		}
+		reader = zlibReader
+	default:
+		return nil
+	}
+
+	resp.Body = &decompressedBody{
+		Reader:       reader,
+		decompressor: reader,
+		body:         resp.Body,
+	}
+
+	// The decompressed length is unknown.
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientDo_Decompression(t *testing.T) {
+	payload := []byte(`{"results":[{"content":"hello"}]}`)
+
+	var gzipped bytes.Buffer
+	gw := gzip.NewWriter(&gzipped)
+	gw.Write(payload)
+	gw.Close()
+
+	var deflated bytes.Buffer
+	fw := zlib.NewWriter(&deflated)
+	fw.Write(payload)
+	fw.Close()
+
+	tests := []struct {
+		name     string
+		encoding string
+		body     []byte
+	}{
+		{name: "gzip", encoding: "gzip", body: gzipped.Bytes()},
+		{name: "deflate", encoding: "deflate", body: deflated.Bytes()},
+		{name: "identity", encoding: "", body: payload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, AcceptEncoding, r.Header.Get("Accept-Encoding"))
+				if tt.encoding != "" {
+					w.Header().Set("Content-Encoding", tt.encoding)
+				}
+				w.Write(tt.body)
+			}))
+			defer server.Close()
+
+			c := &Client{
+				BaseUrl:        server.URL,
+				ApiCredentials: &ApiCredentials{Username: "user", Password: "pass"},
+				HttpClient:     server.Client(),
+			}
+			resp, err := c.Req(context.Background(), nil, "POST")
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, payload, body)
+			assert.Empty(t, resp.Header.Get("Content-Encoding"))
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// SetCredentials replaces the API credentials of the client. Reqs made after
+// it returns use the new credentials, connections are kept. It is safe to call
+// concurrently with reqs.
+func (c *Client) SetCredentials(username string, password string) {
+	c.credentialsMu.Lock()
+	defer c.credentialsMu.Unlock()
+
+	c.ApiCredentials = &ApiCredentials{
+		Username: username,
+		Password: password,
+	}
+}
+
+// credentials returns the current API credentials of the client.
+func (c *Client) credentials() ApiCredentials {
+	c.credentialsMu.RLock()
+	defer c.credentialsMu.RUnlock()
+
+	return *c.ApiCredentials
+}
+
+// setAuth sets the basic auth of the req, using the credentials of its
+// context or the credentials provider of the client if one is configured.
+func (c *Client) setAuth(req *http.Request) error {
+	// Credentials of the req override those of the client.
+	if username, password, ok := oxylabs.ReqCredentials(req.Context()); ok {
+		req.SetBasicAuth(username, password)
+		return nil
+	}
+
+	if provider := c.config().Credentials; provider != nil {
+		// The reqs of a job must use the account it was submitted with.
+		if credentials, ok := c.credentialPins().get(jobIDFromContext(req.Context())); ok {
+			req.SetBasicAuth(credentials.Username, credentials.Password)
+			return nil
+		}
+
+		username, password, err := provider.Credentials(req.Context())
+		if err != nil {
+			return fmt.Errorf("error getting credentials: %v", err)
+		}
+		req.SetBasicAuth(username, password)
+
+		return nil
+	}
+
+	credentials := c.credentials()
+	req.SetBasicAuth(credentials.Username, credentials.Password)
+
+	return nil
+}
+
+type jobIDCtxKey struct{}
+
+// withJobID returns a copy of ctx carrying the ID of the job a req belongs to.
+func withJobID(ctx context.Context, jobID string) context.Context {
+	return context.WithValue(ctx, jobIDCtxKey{}, jobID)
+}
+
+// jobIDFromContext returns the job ID of ctx, if any.
+func jobIDFromContext(ctx context.Context) string {
+	jobID, _ := ctx.Value(jobIDCtxKey{}).(string)
+	return jobID
+}
+
+// pinTTL is how long a job stays pinned to its account if its results are never
+// fetched, e.g. a job delivered to a callback. The API keeps results for 24 hours.
+const pinTTL = 24 * time.Hour
+
+// credentialPins maps the jobs submitted with an account of a credentials pool
+// to the credentials of the account. It is shared with clones.
+type credentialPins struct {
+	mu       sync.Mutex
+	pins     map[string]credentialPin
+	prunedAt time.Time
+}
+
+type credentialPin struct {
+	credentials ApiCredentials
+	pinnedAt    time.Time
+}
+
+// credentialPins returns the credential pins of the client, initializing them on first use.
+func (c *Client) credentialPins() *credentialPins {
+	c.pinsOnce.Do(func() {
+		if c.pins == nil {
+			c.pins = &credentialPins{pins: map[string]credentialPin{}, prunedAt: time.Now()}
+		}
+	})
+
+	return c.pins
+}
+
+// pinCredentials pins the job to the account of the req which submitted it,
+// if the client spreads reqs across the accounts of a credentials pool.
+func (c *Client) pinCredentials(req *http.Request, jobID string) {
+	if _, ok := c.config().Credentials.(*oxylabs.CredentialsPool); !ok {
+		return
+	}
+
+	username, password, _ := req.BasicAuth()
+	c.credentialPins().pin(jobID, ApiCredentials{Username: username, Password: password})
+}
+
+func (p *credentialPins) pin(jobID string, credentials ApiCredentials) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Drop the pins of jobs whose results were never fetched.
+	now := time.Now()
+	if now.Sub(p.prunedAt) >= time.Hour {
+		p.prunedAt = now
+		for id, pin := range p.pins {
+			if now.Sub(pin.pinnedAt) >= pinTTL {
+				delete(p.pins, id)
+			}
+		}
+	}
+
+	p.pins[jobID] = credentialPin{credentials: credentials, pinnedAt: now}
+}
+
+func (p *credentialPins) get(jobID string) (ApiCredentials, bool) {
+	if jobID == "" {
+		return ApiCredentials{}, false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pin, ok := p.pins[jobID]
+
+	return pin.credentials, ok
+}
+
+func (p *credentialPins) unpin(jobID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.pins, jobID)
+}
+
+// JobAccount returns the username of the account the job was submitted with,
+// so that it can be persisted with the job. It is empty if the account is unknown,
+// e.g. with credentials provided per req by a custom provider.
+func (c *Client) JobAccount(ctx context.Context, jobID string) string {
+	if username, _, ok := oxylabs.ReqCredentials(ctx); ok {
+		return username
+	}
+	if c.config().Credentials != nil {
+		credentials, _ := c.credentialPins().get(jobID)
+		return credentials.Username
+	}
+
+	return c.credentials().Username
+}
+
+// PinAccount pins the job to the account with username, e.g. the account persisted
+// with a job resumed after a restart, so that its reqs use the account it was
+// submitted with. Only the accounts of a credentials pool need to be pinned.
+func (c *Client) PinAccount(jobID string, username string) error {
+	pool, ok := c.config().Credentials.(*oxylabs.CredentialsPool)
+	if !ok || username == "" {
+		return nil
+	}
+
+	account, ok := pool.Account(username)
+	if !ok {
+		return fmt.Errorf("account %q of job %s is not in the credentials pool", username, jobID)
+	}
+	c.credentialPins().pin(jobID, ApiCredentials{Username: account.Username, Password: account.Password})
+
+	return nil
+}
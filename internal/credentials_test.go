@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/jobstore"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_SetCredentials(t *testing.T) {
+	var username string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, _, _ = r.BasicAuth()
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL+"/v1/queries", "old", "pass")
+	c.SetCredentials("new", "pass")
+
+	resp, err := c.Req(context.Background(), nil, "POST")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "new", username)
+}
+
+func TestClient_CredentialsProvider(t *testing.T) {
+	provider := oxylabs.CredentialsProviderFunc(func(ctx context.Context) (string, string, error) {
+		return "", "", errors.New("secret not found")
+	})
+	c := NewClient("http://localhost", "user", "pass", oxylabs.WithCredentialsProvider(provider))
+
+	_, err := c.Req(context.Background(), nil, "POST")
+	assert.EqualError(t, err, "error getting credentials: secret not found")
+}
+
+func TestClient_CredentialsPool_PinsJobs(t *testing.T) {
+	var mu sync.Mutex
+	submitted := map[string]string{}
+	mismatches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, _, _ := r.BasicAuth()
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == http.MethodPost {
+			jobID := fmt.Sprint(len(submitted) + 1)
+			submitted[jobID] = username
+			fmt.Fprintf(w, `{"id": "%s", "status": "pending"}`, jobID)
+			return
+		}
+
+		jobID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/queries/"), "/results")
+		if submitted[jobID] != username {
+			mismatches++
+		}
+		fmt.Fprintf(w, `{"id": "%s", "status": "done", "results": []}`, jobID)
+	}))
+	defer server.Close()
+
+	pool, err := oxylabs.NewCredentialsPool(
+		oxylabs.PooledCredentials{Username: "a", Password: "pass"},
+		oxylabs.PooledCredentials{Username: "b", Password: "pass"},
+	)
+	assert.NoError(t, err)
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "", "", oxylabs.WithCredentialsProvider(pool))
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	for i := 0; i < 4; i++ {
+		jobID, err := c.GetJobIDCtx(context.Background(), []byte(fmt.Sprintf(`{"query": "%d"}`, i)))
+		assert.NoError(t, err)
+		resp, err := c.WaitJob(context.Background(), jobID, time.Millisecond)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, map[string]string{"1": "a", "2": "b", "3": "a", "4": "b"}, submitted)
+	assert.Equal(t, 0, mismatches)
+	assert.Empty(t, c.credentialPins().pins)
+}
+
+func TestClient_CredentialsPool_ResumedJob(t *testing.T) {
+	var mu sync.Mutex
+	var usernames []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, _, _ := r.BasicAuth()
+		mu.Lock()
+		usernames = append(usernames, username)
+		mu.Unlock()
+		w.Write([]byte(`{"id": "123", "status": "done"}`))
+	}))
+	defer server.Close()
+
+	pool, err := oxylabs.NewCredentialsPool(
+		oxylabs.PooledCredentials{Username: "a", Password: "pass"},
+		oxylabs.PooledCredentials{Username: "b", Password: "pass"},
+	)
+	assert.NoError(t, err)
+
+	// The job is submitted with account a and persisted with it.
+	store := jobstore.NewMemoryStore()
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "", "", oxylabs.WithCredentialsProvider(pool), oxylabs.WithJobStore(store))
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+	_, err = c.GetJobIDCtx(context.Background(), []byte(`{"query": "adidas"}`))
+	assert.NoError(t, err)
+
+	jobs, err := store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", jobs[0].Username)
+
+	// After a restart, the job is polled with account a, not the next one of the pool.
+	c = NewClient(server.URL+"/v1/queries", "", "", oxylabs.WithCredentialsProvider(pool))
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+	assert.NoError(t, c.PinAccount(jobs[0].ID, jobs[0].Username))
+	_, err = c.CheckJobStatus(context.Background(), jobs[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "a"}, usernames)
+
+	assert.EqualError(t, c.PinAccount("456", "c"), `account "c" of job 456 is not in the credentials pool`)
+}
+
+func TestClient_ReqCredentials(t *testing.T) {
+	var mu sync.Mutex
+	var usernames []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, _, _ := r.BasicAuth()
+		mu.Lock()
+		usernames = append(usernames, username)
+		mu.Unlock()
+		w.Write([]byte(`{"id": "123", "status": "done", "results": []}`))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "owner", "pass", oxylabs.WithDeduplication())
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	// All reqs of the job use the credentials of the ctx.
+	ctx := oxylabs.WithReqCredentials(context.Background(), "customer", "secret")
+	jobID, err := c.GetJobIDCtx(ctx, []byte(`{"query": "adidas"}`))
+	assert.NoError(t, err)
+	resp, err := c.WaitJob(ctx, jobID, time.Millisecond)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, []string{"customer", "customer", "customer"}, usernames)
+
+	// Reqs of different accounts are not deduplicated with each other.
+	assert.NotEqual(t, c.reqKey(ctx, "job", []byte(`{}`)), c.reqKey(context.Background(), "job", []byte(`{}`)))
+	// Nor are reqs of the same username with another password.
+	wrong := oxylabs.WithReqCredentials(context.Background(), "customer", "wrong")
+	assert.NotEqual(t, c.reqKey(ctx, "job", []byte(`{}`)), c.reqKey(wrong, "job", []byte(`{}`)))
+}
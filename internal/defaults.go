@@ -7,8 +7,9 @@ import (
 )
 
 const (
-	DefaultUserAgent oxylabs.UserAgent = oxylabs.UA_DESKTOP
-	DefaultDomain    oxylabs.Domain    = oxylabs.DOMAIN_COM
+	DefaultUserAgent       oxylabs.UserAgent = oxylabs.UA_DESKTOP
+	DefaultDomain          oxylabs.Domain    = oxylabs.DOMAIN_COM
+	DefaultContentEncoding string            = "base64"
 
 	DefaultStartPage int = 1
 	DefaultPages     int = 1
@@ -18,6 +19,7 @@ const (
 
 	SyncBaseUrl  string = "https://realtime.oxylabs.io/v1/queries"
 	AsyncBaseUrl string = "https://data.oxylabs.io/v1/queries"
+	StatsUrl     string = "https://data.oxylabs.io/v2/stats"
 )
 
 var (
@@ -25,41 +27,6 @@ var (
 	DefaultPollInterval = 2 * time.Second
 )
 
-// SetDefaultDomain sets the domain parameter if it is not set.
-func SetDefaultDomain(domain *oxylabs.Domain) {
-	if *domain == "" {
-		*domain = oxylabs.DOMAIN_COM
-	}
-}
-
-// SetDefaultStartPage sets the start_page parameter if it is not set.
-func SetDefaultStartPage(startPage *int) {
-	if *startPage == 0 {
-		*startPage = 1
-	}
-}
-
-// SetDefaultPages sets the pages parameter if it is not set.
-func SetDefaultPages(pages *int) {
-	if *pages == 0 {
-		*pages = 1
-	}
-}
-
-// SetDefaultLimit sets the limit parameter if it is not set.
-func SetDefaultLimit(limit *int, defaultLimit int) {
-	if *limit == 0 {
-		*limit = defaultLimit
-	}
-}
-
-// SetDefaultUserAgent sets the user_agent_type parameter if it is not set.
-func SetDefaultUserAgent(userAgent *oxylabs.UserAgent) {
-	if *userAgent == "" {
-		*userAgent = oxylabs.UA_DESKTOP
-	}
-}
-
 // SetDefaultHotelOccupancy sets the hotel_occupancy parameter if it is not set.
 func SetDefaultHotelOccupancy(ctx oxylabs.ContextOption) {
 	if ctx["hotel_occupancy"] == nil {
@@ -81,9 +48,54 @@ func SetDefaultHttpMethod(ctx oxylabs.ContextOption) {
 	}
 }
 
-// SetDefaultContentEncoding sets the content_encoding parameter if it is not set.
-func SetDefaultContentEncoding(contentEncoding *string) {
-	if *contentEncoding == "" {
-		*contentEncoding = "base64"
+// Settings returns the settings of the client with unset values replaced
+// by the SDK defaults. Limit stays 0 if unset, as its default depends on the source.
+func (c *Client) Settings() oxylabs.Settings {
+	settings := c.config().Settings
+	if settings.Timeout == 0 {
+		settings.Timeout = DefaultTimeout
+	}
+	if settings.PollInterval == 0 {
+		settings.PollInterval = DefaultPollInterval
+	}
+	if settings.StartPage == 0 {
+		settings.StartPage = DefaultStartPage
+	}
+	if settings.Pages == 0 {
+		settings.Pages = DefaultPages
+	}
+
+	return settings
+}
+
+// ApplyDefaults sets the zero valued fields of an opts struct to the client
+// defaults, then to the settings of the client and the SDK defaults.
+// defaultLimit is the limit of the source if the client sets none.
+func (c *Client) ApplyDefaults(f Fields, defaultLimit int) {
+	defaults := c.config().Defaults
+	setIfZero(f.GeoLocation, defaults.GeoLocation)
+	setIfZero(f.UserAgent, defaults.UserAgent)
+	setIfZero(f.CallbackUrl, defaults.CallbackUrl)
+	setIfZero(f.Locale, defaults.Locale)
+	setIfZero(f.Render, defaults.Render)
+
+	settings := c.Settings()
+	if settings.Limit == 0 {
+		settings.Limit = defaultLimit
+	}
+	setIfZero(f.StartPage, settings.StartPage)
+	setIfZero(f.Pages, settings.Pages)
+	setIfZero(f.Limit, settings.Limit)
+
+	setIfZero(f.Domain, DefaultDomain)
+	setIfZero(f.UserAgent, DefaultUserAgent)
+	setIfZero(f.ContentEncoding, DefaultContentEncoding)
+}
+
+// setIfZero sets the field to value if the opts struct has the field and it is zero.
+func setIfZero[T comparable](field *T, value T) {
+	var zero T
+	if field != nil && *field == zero && value != zero {
+		*field = value
 	}
 }
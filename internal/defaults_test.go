@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ApplyDefaults(t *testing.T) {
+	c := NewClient("", "user", "pass",
+		oxylabs.WithDefaults(oxylabs.Defaults{
+			GeoLocation: "Germany",
+			UserAgent:   oxylabs.UA_MOBILE,
+			Locale:      oxylabs.LOCALE_DE,
+			CallbackUrl: "https://example.com/callback",
+		}),
+		oxylabs.WithSettings(oxylabs.Settings{
+			Timeout: time.Minute,
+			Pages:   3,
+		}),
+	)
+
+	var (
+		geoLocation oxylabs.GeoLocation
+		locale      string
+		domain      oxylabs.Domain
+		userAgent   = oxylabs.UA_DESKTOP
+		startPage   int
+		pages       int
+		limit       int
+	)
+	c.ApplyDefaults(Fields{
+		GeoLocation: &geoLocation,
+		UserAgent:   &userAgent,
+		Locale:      (*oxylabs.Locale)(&locale),
+		Domain:      &domain,
+		StartPage:   &startPage,
+		Pages:       &pages,
+		Limit:       &limit,
+	}, DefaultLimit_SERP)
+
+	assert.Equal(t, oxylabs.GeoLocation("Germany"), geoLocation)
+	assert.Equal(t, oxylabs.UA_DESKTOP, userAgent)
+	assert.Equal(t, "de", locale)
+	assert.Equal(t, DefaultDomain, domain)
+	assert.Equal(t, []int{1, 3, DefaultLimit_SERP}, []int{startPage, pages, limit})
+	assert.Equal(t, time.Minute, c.Settings().Timeout)
+	assert.Equal(t, DefaultPollInterval, c.Settings().PollInterval)
+
+	limit = 0
+	c.Clone(oxylabs.WithSettings(oxylabs.Settings{Limit: 100})).ApplyDefaults(Fields{Limit: &limit}, DefaultLimit_SERP)
+	assert.Equal(t, 100, limit)
+}
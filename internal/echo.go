@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// PayloadEcho returns the payload to include in the resp of a req, or nil
+// unless payload echo is enabled with oxylabs.WithPayloadEcho.
+func (c *Client) PayloadEcho(payload []byte) json.RawMessage {
+	cfg := c.config()
+	if !cfg.EchoPayload || len(payload) == 0 {
+		return nil
+	}
+	if len(cfg.EchoFields) == 0 {
+		return bytes.Clone(payload)
+	}
+
+	// Include only the selected top-level fields.
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil
+	}
+	echo := make(map[string]json.RawMessage, len(cfg.EchoFields))
+	for _, name := range cfg.EchoFields {
+		if v, ok := fields[name]; ok {
+			echo[name] = v
+		}
+	}
+	data, err := json.Marshal(echo)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
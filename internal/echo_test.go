@@ -0,0 +1,21 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_PayloadEcho(t *testing.T) {
+	payload := []byte(`{"source": "google_search", "query": "adidas", "parse": true}`)
+
+	c := NewClient(SyncBaseUrl, "username", "password")
+	assert.Nil(t, c.PayloadEcho(payload))
+
+	c = NewClient(SyncBaseUrl, "username", "password", oxylabs.WithPayloadEcho())
+	assert.JSONEq(t, string(payload), string(c.PayloadEcho(payload)))
+
+	c = c.Clone(oxylabs.WithPayloadEcho("source", "query", "missing"))
+	assert.JSONEq(t, `{"source": "google_search", "query": "adidas"}`, string(c.PayloadEcho(payload)))
+}
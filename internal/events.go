@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// emit sends the event to the event listener of the client, if one is configured.
+func (c *Client) emit(event oxylabs.Event) {
+	if listener := c.config().EventListener; listener != nil {
+		listener.OnEvent(event)
+	}
+}
+
+// listening reports whether an event listener is configured, so
+// that events which are costly to build can be skipped.
+func (c *Client) listening() bool {
+	return c.config().EventListener != nil
+}
+
+// payloadSource returns the source of a JSON payload.
+func payloadSource(jsonPayload []byte) string {
+	var payload struct {
+		Source string `json:"source"`
+	}
+	json.Unmarshal(jsonPayload, &payload)
+
+	return payload.Source
+}
@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_EventListener(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		case r.URL.Path == "/v1/queries/123/results":
+			w.Write([]byte(`{"results": []}`))
+		case polls.Add(1) == 1:
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		default:
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		}
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var events []oxylabs.Event
+	listener := oxylabs.EventListenerFunc(func(event oxylabs.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	})
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL, "user", "pass", oxylabs.WithEventListener(listener))
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	jobID, err := c.GetJobIDCtx(context.Background(), []byte(`{"source": "google_search", "query": "adidas"}`))
+	assert.NoError(t, err)
+	resp, err := c.WaitJob(context.Background(), jobID, time.Millisecond)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	if assert.Len(t, events, 4) {
+		assert.Equal(t, "google_search", events[0].(*oxylabs.JobSubmittedEvent).Source)
+		assert.Equal(t, oxylabs.JobPending, events[1].(*oxylabs.JobPolledEvent).Status)
+		assert.Equal(t, oxylabs.JobDone, events[2].(*oxylabs.JobPolledEvent).Status)
+		assert.Equal(t, "123", events[3].(*oxylabs.JobCompletedEvent).JobID)
+	}
+}
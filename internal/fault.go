@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// jobFault returns the error of a faulted job with the details reported by
+// the job endpoint, jobBody, and the results endpoint, if it has any results.
+func (c *Client) jobFault(ctx context.Context, jobID string, jobBody []byte) *oxylabs.JobFaultError {
+	fault := &oxylabs.JobFaultError{
+		JobID: jobID,
+		Job:   json.RawMessage(jobBody),
+	}
+
+	var job struct {
+		Source  string      `json:"source"`
+		Message string      `json:"message"`
+		Errors  interface{} `json:"_errors"`
+	}
+	if err := json.Unmarshal(jobBody, &job); err == nil {
+		fault.Source = job.Source
+		fault.Reason = job.Message
+		if fault.Reason == "" && job.Errors != nil {
+			fault.Reason = fmt.Sprint(job.Errors)
+		}
+	}
+
+	// The results of a faulted job, if any, carry the status code of the failure.
+	var results struct {
+		Results []struct {
+			StatusCode int             `json:"status_code"`
+			Content    json.RawMessage `json:"content"`
+		} `json:"results"`
+	}
+	if err := c.getJSON(ctx, c.jobUrl(jobID, "/results"), &results); err != nil || len(results.Results) == 0 {
+		return fault
+	}
+	result := results.Results[0]
+	fault.StatusCode = result.StatusCode
+	if fault.Reason == "" {
+		var content string
+		if json.Unmarshal(result.Content, &content) == nil {
+			fault.Reason = strings.TrimSpace(content)
+		}
+	}
+
+	return fault
+}
+
+// ResubmitFaulted resubmits the payload of a job which ended with err, if err is
+// a JobFaultError and fewer than the configured retries of faulted jobs were made.
+// attempt is the number of the failed attempt, starting at 1. It returns the ID of
+// the new job, or err if the job is not resubmitted. If the resubmission fails,
+// the returned error wraps both err and the error of the resubmission.
+func (c *Client) ResubmitFaulted(
+	ctx context.Context,
+	jsonPayload []byte,
+	attempt int,
+	err error,
+) (string, error) {
+	var fault *oxylabs.JobFaultError
+	if !errors.As(err, &fault) || fault.BadParameters() ||
+		jsonPayload == nil || attempt > c.config().RetryFaultedJobs {
+		return "", err
+	}
+
+	c.emit(&oxylabs.RequestRetriedEvent{
+		Time:    time.Now(),
+		Url:     c.asyncUrl(),
+		JobID:   fault.JobID,
+		Attempt: attempt + 1,
+		Reason:  "job faulted",
+	})
+
+	// The idempotency key maps to the faulted job, so each resubmission has a key
+	// of its own, reused if the resubmission was already made, e.g. before a restart.
+	idempotencyKey := oxylabs.IdempotencyKey(ctx)
+	if idempotencyKey != "" {
+		idempotencyKey = fmt.Sprintf("%s-retry-%d", idempotencyKey, attempt)
+		unlock := c.idempotencyLocks().lock(idempotencyKey)
+		defer unlock()
+
+		jobID, keyErr := c.idempotentJobID(idempotencyKey, jsonPayload)
+		if keyErr != nil {
+			return "", errors.Join(err, fmt.Errorf("error resubmitting faulted job %s: %w", fault.JobID, keyErr))
+		} else if jobID != "" {
+			return jobID, nil
+		}
+	}
+
+	// The cached job is the faulted one, so it is replaced by the new job.
+	// The new job waits for a slot of the plan limits, as the faulted one freed its slot.
+	jobID, submitErr := c.submitLimitedJob(ctx, jsonPayload, c.reqKey(ctx, "job", jsonPayload), idempotencyKey)
+	if submitErr != nil {
+		return "", errors.Join(err, fmt.Errorf("error resubmitting faulted job %s: %w", fault.JobID, submitErr))
+	}
+
+	return jobID, nil
+}
@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// hedgedResult is the outcome of an attempt of a hedged req.
+type hedgedResult struct {
+	attempt int
+	resp    *http.Response
+	err     error
+}
+
+// doHedged performs the req like do. With hedging enabled, a second attempt is
+// sent if the first has not responded within the hedge delay, and the resp which
+// arrives first is returned, canceling the other attempt.
+func (c *Client) doHedged(req *http.Request) (*http.Response, error) {
+	delay := c.config().HedgeDelay
+	if delay <= 0 || req.GetBody == nil {
+		return c.do(req)
+	}
+
+	results := make(chan hedgedResult, 2)
+	var cancels []context.CancelFunc
+	attempt := func() error {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(req.Context())
+		r := req.Clone(ctx)
+		r.Body = body
+		i := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			resp, err := c.do(r)
+			results <- hedgedResult{attempt: i, resp: resp, err: err}
+		}()
+		return nil
+	}
+	if err := attempt(); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	inFlight := 1
+	for {
+		select {
+		case <-timer.C:
+			if attempt() == nil {
+				inFlight++
+				c.emit(&oxylabs.RequestRetriedEvent{
+					Time:    time.Now(),
+					Url:     req.URL.String(),
+					Attempt: 2,
+					Reason:  "hedged",
+				})
+			}
+		case res := <-results:
+			inFlight--
+			// Hedging tames latency, so a failed attempt is not retried,
+			// but the other attempt is awaited if it is in flight.
+			if res.err != nil && inFlight > 0 {
+				cancels[res.attempt]()
+				continue
+			}
+
+			// Cancel the other attempt, closing its resp if it still arrives.
+			for i, cancel := range cancels {
+				if i != res.attempt {
+					cancel()
+				}
+			}
+			go discardHedged(results, inFlight)
+
+			if res.err != nil {
+				cancels[res.attempt]()
+				return nil, res.err
+			}
+			res.resp.Body = &cancelBody{ReadCloser: res.resp.Body, cancel: cancels[res.attempt]}
+			return res.resp, nil
+		}
+	}
+}
+
+// discardHedged closes the resps of the canceled attempts of a hedged req.
+func discardHedged(results chan hedgedResult, inFlight int) {
+	for ; inFlight > 0; inFlight-- {
+		if res := <-results; res.resp != nil {
+			res.resp.Body.Close()
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Hedging(t *testing.T) {
+	var attempts atomic.Int32
+	canceled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if attempts.Add(1) == 1 {
+			// The first attempt hangs until the hedged attempt wins.
+			<-r.Context().Done()
+			close(canceled)
+			return
+		}
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user", "pass", oxylabs.WithHedging(10*time.Millisecond))
+	resp, err := c.Req(context.Background(), []byte(`{"query": "adidas"}`), "POST")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"query": "adidas"}`, string(body))
+	assert.Equal(t, int32(2), attempts.Load())
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("first attempt was not canceled")
+	}
+}
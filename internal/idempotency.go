@@ -0,0 +1,51 @@
+package internal
+
+import "sync"
+
+// idempotencyLocks serializes the submissions of jobs with the same idempotency key,
+// so that concurrent retries do not both submit a job. It is shared with clones.
+type idempotencyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*idempotencyLock
+}
+
+type idempotencyLock struct {
+	mu      sync.Mutex
+	waiters int
+}
+
+// idempotencyLocks returns the idempotency locks of the client, initializing them on first use.
+func (c *Client) idempotencyLocks() *idempotencyLocks {
+	c.idempotencyOnce.Do(func() {
+		if c.idempotency == nil {
+			c.idempotency = &idempotencyLocks{locks: map[string]*idempotencyLock{}}
+		}
+	})
+
+	return c.idempotency
+}
+
+// lock locks the idempotency key and returns the func which unlocks it.
+func (l *idempotencyLocks) lock(idempotencyKey string) func() {
+	l.mu.Lock()
+	lock, ok := l.locks[idempotencyKey]
+	if !ok {
+		lock = &idempotencyLock{}
+		l.locks[idempotencyKey] = lock
+	}
+	lock.waiters++
+	l.mu.Unlock()
+
+	lock.mu.Lock()
+
+	return func() {
+		lock.mu.Unlock()
+
+		l.mu.Lock()
+		lock.waiters--
+		if lock.waiters == 0 {
+			delete(l.locks, idempotencyKey)
+		}
+		l.mu.Unlock()
+	}
+}
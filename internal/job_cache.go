@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// jobResultsTTL is how long the API keeps the results of a job, so cached
+// job IDs expire by then even if the cache TTL is longer or zero.
+const jobResultsTTL = 24 * time.Hour
+
+// jobKeys maps the cached jobs which did not finish yet to their cache keys,
+// so that the job ID of a job which faults is evicted from the cache instead
+// of being reused by identical reqs. It is shared with clones.
+type jobKeys struct {
+	mu       sync.Mutex
+	keys     map[string]jobKey
+	prunedAt time.Time
+}
+
+type jobKey struct {
+	key      string
+	cachedAt time.Time
+}
+
+// jobCacheKeys returns the job keys of the client, initializing them on first use.
+func (c *Client) jobCacheKeys() *jobKeys {
+	c.jobKeysOnce.Do(func() {
+		if c.jobKeys == nil {
+			c.jobKeys = &jobKeys{keys: map[string]jobKey{}, prunedAt: time.Now()}
+		}
+	})
+
+	return c.jobKeys
+}
+
+// cacheJob caches the ID of the submitted job under key until its results expire.
+func (c *Client) cacheJob(key string, jobID string) {
+	cache := c.config().Cache
+	if key == "" || cache == nil {
+		return
+	}
+
+	ttl := c.config().CacheTTL
+	if ttl == 0 || ttl > jobResultsTTL {
+		ttl = jobResultsTTL
+	}
+	if cache.Set(context.Background(), key, []byte(jobID), ttl) != nil {
+		return
+	}
+
+	k := c.jobCacheKeys()
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	// Drop the keys of jobs which were never seen finishing.
+	now := time.Now()
+	if now.Sub(k.prunedAt) >= time.Hour {
+		k.prunedAt = now
+		for id, entry := range k.keys {
+			if now.Sub(entry.cachedAt) >= jobResultsTTL {
+				delete(k.keys, id)
+			}
+		}
+	}
+
+	k.keys[jobID] = jobKey{key: key, cachedAt: now}
+}
+
+// uncacheJob stops tracking the cache key of the job, and evicts the job
+// from the cache if evict is set, e.g. because it faulted.
+func (c *Client) uncacheJob(jobID string, evict bool) {
+	k := c.jobCacheKeys()
+	k.mu.Lock()
+	entry, ok := k.keys[jobID]
+	delete(k.keys, jobID)
+	k.mu.Unlock()
+
+	if ok && evict && c.config().Cache != nil {
+		// The cache only saves credits, so a failed eviction is ignored.
+		c.config().Cache.Delete(context.Background(), entry.key)
+	}
+}
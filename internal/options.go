@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Fields points to the fields of an opts struct, e.g. serp.GoogleSearchOpts,
+// which are shared between sources. Fields which the opts struct lacks are nil.
+type Fields struct {
+	// Opts is the name of the opts struct.
+	Opts string
+
+	Domain            *oxylabs.Domain
+	StartPage         *int
+	Pages             *int
+	Limit             *int
+	Locale            *oxylabs.Locale
+	GeoLocation       *oxylabs.GeoLocation
+	UserAgent         *oxylabs.UserAgent
+	CallbackUrl       *string
+	Render            *oxylabs.Render
+	Parse             *bool
+	ParseInstructions **map[string]interface{}
+	PollInterval      *time.Duration
+	Context           *[]func(oxylabs.ContextOption)
+
+	Headers               *map[string]string
+	Cookies               *[]oxylabs.Cookie
+	SessionId             *string
+	ContentEncoding       *string
+	ResultsLanguage       *string
+	CustomUserAgent       *string
+	HttpMethod            *string
+	Content               *[]byte
+	SuccessfulStatusCodes *[]int
+}
+
+// Option sets a field of an opts struct, e.g. serp.GoogleSearchOpts.
+type Option func(f Fields) error
+
+// SetOption returns an Option which sets the field with the given name,
+// selected by field, to value.
+func SetOption[T any](name string, field func(f Fields) *T, value T) Option {
+	return func(f Fields) error {
+		ptr := field(f)
+		if ptr == nil {
+			return fmt.Errorf("option %s is not supported by %s", name, f.Opts)
+		}
+		*ptr = value
+
+		return nil
+	}
+}
+
+// AppendOption returns an Option which appends values to the slice field
+// with the given name, selected by field.
+func AppendOption[T any](name string, field func(f Fields) *[]T, values []T) Option {
+	return func(f Fields) error {
+		ptr := field(f)
+		if ptr == nil {
+			return fmt.Errorf("option %s is not supported by %s", name, f.Opts)
+		}
+		*ptr = append(*ptr, values...)
+
+		return nil
+	}
+}
+
+// ApplyOptions applies the options to the fields of an opts struct.
+func ApplyOptions(f Fields, options []Option) error {
+	for _, option := range options {
+		if err := option(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LastOpts returns the last non-nil opts, or a zero opts struct if there is none.
+func LastOpts[T any](opts []*T) *T {
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		return opts[len(opts)-1]
+	}
+
+	return new(T)
+}
+
+// RequestTimeout returns requestTimeout, the RequestTimeout of the opts,
+// or timeout if it is not set.
+func RequestTimeout(timeout time.Duration, requestTimeout time.Duration) time.Duration {
+	if requestTimeout <= 0 {
+		return timeout
+	}
+
+	return requestTimeout
+}
+
+// CancelOnDone calls cancel once res is done, or right away if err is not nil,
+// so that the timeout ctx of a non-Ctx async method outlives its return.
+func CancelOnDone[R interface{ Done() <-chan struct{} }](
+	cancel context.CancelFunc,
+	res R,
+	err error,
+) (R, error) {
+	if err != nil {
+		cancel()
+		return res, err
+	}
+
+	go func() {
+		<-res.Done()
+		cancel()
+	}()
+
+	return res, nil
+}
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// splitPages returns the single-page payloads of a payload of multiple pages,
+// or nil if page parallelism is disabled or the payload is of a single page.
+func (c *Client) splitPages(jsonPayload []byte) [][]byte {
+	if c.config().PageParallelism <= 1 {
+		return nil
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return nil
+	}
+	var pages, startPage int
+	if json.Unmarshal(payload["pages"], &pages) != nil || pages <= 1 {
+		return nil
+	}
+	json.Unmarshal(payload["start_page"], &startPage)
+	startPage = max(startPage, 1)
+
+	payloads := make([][]byte, 0, pages)
+	for page := startPage; page < startPage+pages; page++ {
+		payload["start_page"] = json.RawMessage(strconv.Itoa(page))
+		payload["pages"] = json.RawMessage("1")
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil
+		}
+		payloads = append(payloads, data)
+	}
+
+	return payloads
+}
+
+// reqPages makes the single-page reqs in parallel and returns a resp with the
+// results of all pages in order and the job of the first page, see mergePages.
+// If a page fails, the reqs of the other pages are canceled and its error, or
+// its resp if the API returned an error status, is returned.
+func (c *Client) reqPages(
+	ctx context.Context,
+	payloads [][]byte,
+	method string,
+) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		failed *http.Response
+		err    error
+		bodies = make([][]byte, len(payloads))
+		sem    = make(chan struct{}, c.config().PageParallelism)
+		wg     sync.WaitGroup
+	)
+	// fail records the first failure of a page and cancels the others.
+	fail := func(resp *http.Response, e error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if failed != nil || err != nil {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			return
+		}
+		failed, err = resp, e
+		cancel()
+	}
+
+	for i, payload := range payloads {
+		wg.Add(1)
+		go func(i int, payload []byte) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				fail(nil, ctx.Err())
+				return
+			}
+
+			resp, e := c.Req(ctx, payload, method)
+			if e != nil {
+				fail(nil, e)
+				return
+			}
+			if resp.StatusCode != http.StatusOK {
+				fail(resp, nil)
+				return
+			}
+
+			body, e := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if e != nil {
+				fail(nil, fmt.Errorf("error reading resp body: %v", e))
+				return
+			}
+			bodies[i] = body
+		}(i, payload)
+	}
+	wg.Wait()
+
+	if failed != nil || err != nil {
+		return failed, err
+	}
+
+	body, err := mergePages(bodies)
+	if err != nil {
+		return nil, err
+	}
+
+	return cachedResp(body), nil
+}
+
+// mergePages merges the resp bodies of single-page reqs in order. The merged
+// job is the one of the first page, with the page count of the whole req and
+// the IDs of the jobs of all pages.
+func mergePages(bodies [][]byte) ([]byte, error) {
+	var merged struct {
+		Results []json.RawMessage          `json:"results"`
+		Job     map[string]json.RawMessage `json:"job,omitempty"`
+	}
+	jobIDs := make([]string, 0, len(bodies))
+	for i, body := range bodies {
+		var page struct {
+			Results []json.RawMessage          `json:"results"`
+			Job     map[string]json.RawMessage `json:"job"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("error unmarshalling page resp body: %v", err)
+		}
+		merged.Results = append(merged.Results, page.Results...)
+		if i == 0 {
+			merged.Job = page.Job
+		}
+
+		var jobID string
+		json.Unmarshal(page.Job["id"], &jobID)
+		jobIDs = append(jobIDs, jobID)
+	}
+
+	if merged.Job != nil {
+		merged.Job["pages"] = json.RawMessage(strconv.Itoa(len(bodies)))
+		ids, err := json.Marshal(jobIDs)
+		if err != nil {
+			return nil, err
+		}
+		merged.Job["page_job_ids"] = ids
+	}
+
+	return json.Marshal(merged)
+}
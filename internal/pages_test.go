@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Req_PageParallelism(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		var payload struct {
+			StartPage int `json:"start_page"`
+			Pages     int `json:"pages"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		assert.Equal(t, 1, payload.Pages)
+
+		// Later pages finish first.
+		time.Sleep(time.Duration(10-payload.StartPage) * 5 * time.Millisecond)
+		fmt.Fprintf(w, `{"results": [{"content": "page %d", "page": %d}], "job": {"id": "job-%d", "start_page": %d, "pages": 1}}`,
+			payload.StartPage, payload.StartPage, payload.StartPage, payload.StartPage)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user", "pass", oxylabs.WithPageParallelism(2))
+	resp, err := c.Req(context.Background(), []byte(`{"source": "google_search", "query": "adidas", "start_page": 2, "pages": 4}`), http.MethodPost)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.JSONEq(t, `{
+		"results": [
+			{"content": "page 2", "page": 2},
+			{"content": "page 3", "page": 3},
+			{"content": "page 4", "page": 4},
+			{"content": "page 5", "page": 5}
+		],
+		"job": {"id": "job-2", "start_page": 2, "pages": 4, "page_job_ids": ["job-2", "job-3", "job-4", "job-5"]}
+	}`, string(body))
+	assert.Equal(t, 2, maxInFlight)
+}
+
+func TestClient_Req_PageParallelism_FailedPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			StartPage int `json:"start_page"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		if payload.StartPage == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message": "bad page"}`))
+			return
+		}
+		w.Write([]byte(`{"results": [], "job": {}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user", "pass", oxylabs.WithPageParallelism(3))
+	resp, err := c.Req(context.Background(), []byte(`{"source": "google_search", "query": "adidas", "pages": 3}`), http.MethodPost)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
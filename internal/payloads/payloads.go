@@ -0,0 +1,58 @@
+// Package payloads builds the req payloads of sources for oxylabs.PayloadHash
+// and derives the keys of payloads, shared by PayloadHash and the client cache.
+package payloads
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Builder returns the JSON payload of a scrape of its source with the query,
+// url or product id and opts, e.g. *serp.GoogleSearchOpts or nil.
+type Builder func(query string, opts interface{}) ([]byte, error)
+
+var (
+	mu       sync.RWMutex
+	builders = make(map[string]Builder)
+)
+
+// Register registers the builder of the payloads of source.
+func Register(source string, builder Builder) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	builders[source] = builder
+}
+
+// Build returns the JSON payload of a scrape of source.
+func Build(source string, query string, opts interface{}) ([]byte, error) {
+	mu.RLock()
+	builder, ok := builders[source]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported source: %s, or its package is not imported", source)
+	}
+
+	return builder(query, opts)
+}
+
+// Key returns a stable hash of the JSON payload. The payload is normalized first,
+// so that payloads differing only in key order or whitespace share a key.
+func Key(jsonPayload []byte) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal(jsonPayload, &v); err != nil {
+		return "", fmt.Errorf("error unmarshalling payload: %v", err)
+	}
+
+	// Map keys are marshalled in sorted order.
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling payload: %v", err)
+	}
+	sum := sha256.Sum256(normalized)
+
+	return hex.EncodeToString(sum[:]), nil
+}
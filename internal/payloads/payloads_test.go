@@ -0,0 +1,36 @@
+package payloads
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKey_Normalizes(t *testing.T) {
+	a, err := Key([]byte(`{"source": "google_search", "query": "adidas"}`))
+	assert.NoError(t, err)
+
+	b, err := Key([]byte(`{"query":"adidas","source":"google_search"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, a, b)
+
+	c, err := Key([]byte(`{"query":"nike","source":"google_search"}`))
+	assert.NoError(t, err)
+	assert.NotEqual(t, a, c)
+
+	_, err = Key([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestBuild(t *testing.T) {
+	Register("test_source", func(query string, opts interface{}) ([]byte, error) {
+		return []byte(`{"query": "` + query + `"}`), nil
+	})
+
+	payload, err := Build("test_source", "adidas", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"query": "adidas"}`, string(payload))
+
+	_, err = Build("unknown_source", "adidas", nil)
+	assert.EqualError(t, err, "unsupported source: unknown_source, or its package is not imported")
+}
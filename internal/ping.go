@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Ping validates the credentials and the reachability of the API with an
+// authenticated req to the usage statistics endpoint, which costs no credits.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", StatsUrl, nil)
+	if err != nil {
+		return err
+	}
+	if err = c.setAuth(req); err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("error performing req: %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("%w: %s", oxylabs.ErrInvalidCredentials, respBody)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("error with status code %s: %s", resp.Status, respBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Ping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, _, _ := r.BasicAuth(); username != "user" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL, "user", "pass")
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+	assert.NoError(t, c.Ping(context.Background()))
+
+	c.SetCredentials("invalid", "pass")
+	assert.ErrorIs(t, c.Ping(context.Background()), oxylabs.ErrInvalidCredentials)
+}
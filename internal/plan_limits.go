@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// jobLimiter bounds the jobs in flight to the concurrent job limit of
+// the account plan, queueing the submissions which exceed it.
+// It is shared with clones, as the limit applies to the whole account.
+// A job holds its slot until a status req observes that it finished.
+type jobLimiter struct {
+	limit int
+	slots chan struct{}
+
+	mu sync.Mutex
+	// held maps the jobs holding a slot to whether they are being polled.
+	held map[string]bool
+}
+
+// jobLimiter returns the job limiter of the client, initializing it on first use,
+// or nil if the client has no plan limits.
+func (c *Client) jobLimiter() *jobLimiter {
+	c.limiterOnce.Do(func() {
+		if n := c.config().ConcurrentJobs; c.limiter == nil && n > 0 {
+			c.limiter = &jobLimiter{limit: n, slots: make(chan struct{}, n), held: map[string]bool{}}
+		}
+	})
+
+	return c.limiter
+}
+
+// acquireJobSlot waits for a free slot of the plan limits, or returns ctx's error
+// if it is done first. While it waits, the status of the held jobs which are not
+// polled, e.g. submitted with SubmitOnly or whose polling was canceled, is checked
+// every poll interval, so that the slots of the finished ones are freed.
+func (c *Client) acquireJobSlot(ctx context.Context) error {
+	l := c.jobLimiter()
+	if l == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	ticker := time.NewTicker(c.Settings().PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case l.slots <- struct{}{}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			for _, jobID := range l.unpolled() {
+				// The slot of a finished job is freed by the status check.
+				c.CheckJobStatus(ctx, jobID)
+			}
+		}
+	}
+}
+
+// hold records that the acquired slot is held by the job.
+func (l *jobLimiter) hold(jobID string) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.held[jobID] = false
+}
+
+// release frees the slot of an acquire which did not submit a job.
+func (l *jobLimiter) release() {
+	if l != nil {
+		<-l.slots
+	}
+}
+
+// poll marks the held job as polled until the returned func is called.
+func (l *jobLimiter) poll(jobID string) func() {
+	if l == nil {
+		return func() {}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.held[jobID]; ok {
+		l.held[jobID] = true
+	}
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if _, ok := l.held[jobID]; ok {
+			l.held[jobID] = false
+		}
+	}
+}
+
+// unpolled returns the held jobs which are not being polled.
+func (l *jobLimiter) unpolled() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var jobIDs []string
+	for jobID, polled := range l.held {
+		if !polled {
+			jobIDs = append(jobIDs, jobID)
+		}
+	}
+
+	return jobIDs
+}
+
+// ReleaseJob frees the slot held by the job, if any. The client releases jobs
+// once a status req observes that they finished, so it is only needed for jobs
+// which finish outside of the client's view, e.g. deleted ones.
+func (c *Client) ReleaseJob(jobID string) {
+	l := c.jobLimiter()
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	_, held := l.held[jobID]
+	delete(l.held, jobID)
+	l.mu.Unlock()
+
+	if held {
+		<-l.slots
+	}
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_PlanLimits(t *testing.T) {
+	var submitted atomic.Int32
+	var finished atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			status := "pending"
+			if finished.Load() {
+				status = "done"
+			}
+			fmt.Fprintf(w, `{"id": "1", "status": "%s"}`, status)
+			return
+		}
+		fmt.Fprintf(w, `{"id": "%d", "status": "pending"}`, submitted.Add(1))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "user", "pass",
+		oxylabs.WithPlanLimits(1),
+		oxylabs.WithSettings(oxylabs.Settings{PollInterval: time.Millisecond}),
+	)
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+	_, err := c.GetJobIDCtx(context.Background(), []byte(`{"query": "a"}`))
+	assert.NoError(t, err)
+
+	// The second job is queued while the first one is pending.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.Clone().GetJobIDCtx(ctx, []byte(`{"query": "b"}`))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), submitted.Load())
+
+	// Canceled polling keeps the slot of the pending job.
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.WaitJob(ctx, "1", time.Millisecond)
+	assert.Error(t, err)
+	assert.Len(t, c.jobLimiter().slots, 1)
+
+	// The queued submission frees the slot once the first job finishes.
+	finished.Store(true)
+	_, err = c.GetJobIDCtx(context.Background(), []byte(`{"query": "b"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), submitted.Load())
+	assert.Len(t, c.jobLimiter().slots, 1)
+
+	c.ReleaseJob("2")
+	c.ReleaseJob("2")
+	assert.Len(t, c.jobLimiter().slots, 0)
+}
+
+func TestClient_PlanLimits_ResubmitFaulted(t *testing.T) {
+	var submitted atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			fmt.Fprintf(w, `{"id": "%d", "status": "pending"}`, submitted.Add(1))
+		case r.URL.Path == "/v1/queries/1":
+			w.Write([]byte(`{"id": "1", "status": "faulted"}`))
+		case r.URL.Path == "/v1/queries/1/results":
+			w.Write([]byte(`{"results": [{"content": "", "status_code": 613}]}`))
+		default:
+			w.Write([]byte(`{"id": "2", "status": "pending"}`))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL+"/v1/queries", "user", "pass",
+		oxylabs.WithPlanLimits(1),
+		oxylabs.WithRetryFaultedJobs(1),
+		oxylabs.WithSettings(oxylabs.Settings{PollInterval: time.Millisecond}),
+	)
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+	_, err := c.GetJobIDCtx(context.Background(), []byte(`{"query": "a"}`))
+	assert.NoError(t, err)
+	_, faultErr := c.WaitJob(context.Background(), "1", time.Millisecond)
+	var fault *oxylabs.JobFaultError
+	assert.ErrorAs(t, faultErr, &fault)
+
+	// The faulted job freed its slot, which the second job holds.
+	_, err = c.GetJobIDCtx(context.Background(), []byte(`{"query": "b"}`))
+	assert.NoError(t, err)
+
+	// The resubmission is queued while the second job is pending.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.ResubmitFaulted(ctx, []byte(`{"query": "a"}`), 1, faultErr)
+	assert.ErrorContains(t, err, "job slot")
+	assert.Equal(t, int32(2), submitted.Load())
+
+	c.ReleaseJob("2")
+	jobID, err := c.ResubmitFaulted(context.Background(), []byte(`{"query": "a"}`), 1, faultErr)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", jobID)
+	assert.Len(t, c.jobLimiter().slots, 1)
+}
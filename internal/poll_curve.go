@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// PollCurve returns the poll curve of a job submitted with the payload.
+// A poll interval set for the req or the client polls at a constant interval,
+// otherwise the curve configured for the source or its default curve is used.
+// Jobs without a payload, e.g. resumed ones, are polled at the default interval.
+func (c *Client) PollCurve(payload []byte, pollInterval time.Duration) oxylabs.PollCurve {
+	if pollInterval != 0 {
+		return oxylabs.ConstantPollCurve(pollInterval)
+	}
+	if len(payload) == 0 || c.config().Settings.PollInterval != 0 {
+		return oxylabs.ConstantPollCurve(c.Settings().PollInterval)
+	}
+
+	var job struct {
+		Source oxylabs.Source `json:"source"`
+		Render oxylabs.Render `json:"render"`
+	}
+	if err := json.Unmarshal(payload, &job); err != nil {
+		return oxylabs.ConstantPollCurve(c.Settings().PollInterval)
+	}
+	if curve, ok := c.config().PollCurves[job.Source]; ok {
+		return curve
+	}
+
+	return oxylabs.PollCurveFor(job.Source, job.Render != "")
+}
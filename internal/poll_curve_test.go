@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_PollCurve(t *testing.T) {
+	c := NewClient("", "user", "pass")
+
+	serp := c.PollCurve([]byte(`{"source": "google_search"}`), 0)
+	assert.Equal(t, oxylabs.PollCurveFor(oxylabs.GoogleSearch, false), serp)
+	rendered := c.PollCurve([]byte(`{"source": "google_search", "render": "html"}`), 0)
+	assert.Greater(t, rendered.InitialDelay, serp.InitialDelay)
+
+	// Intervals grow up to the max interval.
+	interval := serp.Interval
+	for i := 0; i < 10; i++ {
+		interval = serp.Next(interval)
+	}
+	assert.Equal(t, serp.MaxInterval, interval)
+
+	// Poll intervals set for the req or the client keep polling constant.
+	assert.Equal(t, oxylabs.ConstantPollCurve(time.Second), c.PollCurve([]byte(`{"source": "google_search"}`), time.Second))
+	assert.Equal(t, oxylabs.ConstantPollCurve(DefaultPollInterval), c.PollCurve(nil, 0))
+
+	curve := oxylabs.PollCurve{Interval: time.Second}
+	c = c.Clone(oxylabs.WithPollCurve(oxylabs.Universal, curve))
+	assert.Equal(t, curve, c.PollCurve([]byte(`{"source": "universal_ecommerce", "render": "html"}`), 0))
+
+	c = c.Clone(oxylabs.WithSettings(oxylabs.Settings{PollInterval: 3 * time.Second}))
+	assert.Equal(t, oxylabs.ConstantPollCurve(3*time.Second), c.PollCurve([]byte(`{"source": "universal_ecommerce"}`), 0))
+}
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// pollScheduler wakes the pollers of a client from a single goroutine and
+// timer, instead of a goroutine and timer per poll, so that thousands of jobs
+// can be polled concurrently. The goroutine only runs while pollers wait.
+// It is safe for concurrent use.
+type pollScheduler struct {
+	mu      sync.Mutex
+	waiters waiterHeap
+	running bool
+	reset   chan struct{}
+}
+
+// pollWaiter is a poller waiting for its next poll.
+// It is reused between the polls of a job.
+type pollWaiter struct {
+	at    time.Time
+	wake  chan struct{}
+	index int
+}
+
+func newPollWaiter() *pollWaiter {
+	return &pollWaiter{wake: make(chan struct{}, 1), index: -1}
+}
+
+// pollScheduler returns the poll scheduler of the client, initializing it on first use.
+func (c *Client) pollScheduler() *pollScheduler {
+	c.schedulerOnce.Do(func() {
+		if c.scheduler == nil {
+			c.scheduler = &pollScheduler{reset: make(chan struct{}, 1)}
+		}
+	})
+
+	return c.scheduler
+}
+
+// sleep blocks until d has elapsed or ctx is done, in which case ctx's error is returned.
+func (s *pollScheduler) sleep(ctx context.Context, w *pollWaiter, d time.Duration) error {
+	s.add(w, time.Now().Add(d))
+
+	select {
+	case <-w.wake:
+		return nil
+	case <-ctx.Done():
+		s.remove(w)
+		return ctx.Err()
+	}
+}
+
+// add schedules the waiter to be woken at.
+func (s *pollScheduler) add(w *pollWaiter, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.at = at
+	heap.Push(&s.waiters, w)
+	if !s.running {
+		s.running = true
+		go s.run()
+		return
+	}
+
+	// Reset the timer if the waiter is the next to be woken.
+	if s.waiters[0] == w {
+		select {
+		case s.reset <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// remove unschedules the waiter, discarding a wake up which raced with it.
+func (s *pollScheduler) remove(w *pollWaiter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if w.index >= 0 {
+		heap.Remove(&s.waiters, w.index)
+		// Wake the goroutine so that it stops if no waiter is left.
+		select {
+		case s.reset <- struct{}{}:
+		default:
+		}
+	}
+	select {
+	case <-w.wake:
+	default:
+	}
+}
+
+// run wakes the waiters when they are due, until none is left.
+func (s *pollScheduler) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		s.mu.Lock()
+		now := time.Now()
+		for len(s.waiters) > 0 && !s.waiters[0].at.After(now) {
+			w := heap.Pop(&s.waiters).(*pollWaiter)
+			w.wake <- struct{}{}
+		}
+		if len(s.waiters) == 0 {
+			s.running = false
+			s.mu.Unlock()
+			return
+		}
+		timer.Reset(s.waiters[0].at.Sub(now))
+		s.mu.Unlock()
+
+		select {
+		case <-timer.C:
+		case <-s.reset:
+		}
+	}
+}
+
+// waiterHeap orders waiters by the time they are due.
+type waiterHeap []*pollWaiter
+
+func (h waiterHeap) Len() int           { return len(h) }
+func (h waiterHeap) Less(i, j int) bool { return h[i].at.Before(h[j].at) }
+
+func (h waiterHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *waiterHeap) Push(x any) {
+	w := x.(*pollWaiter)
+	w.index = len(*h)
+	*h = append(*h, w)
+}
+
+func (h *waiterHeap) Pop() any {
+	old := *h
+	w := old[len(old)-1]
+	old[len(old)-1] = nil
+	w.index = -1
+	*h = old[:len(old)-1]
+
+	return w
+}
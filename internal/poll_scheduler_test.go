@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollScheduler_Sleep(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+	s := c.pollScheduler()
+	assert.Same(t, s, c.Clone().pollScheduler())
+
+	// Waiters scheduled out of order are all woken after their duration.
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(d time.Duration) {
+			defer wg.Done()
+			start := time.Now()
+			assert.NoError(t, s.sleep(context.Background(), newPollWaiter(), d))
+			assert.GreaterOrEqual(t, time.Since(start), d)
+		}(time.Duration(100-i) * 100 * time.Microsecond)
+	}
+	wg.Wait()
+
+	// A canceled waiter is removed, and the scheduler goroutine stops once idle.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, s.sleep(ctx, newPollWaiter(), time.Hour), context.DeadlineExceeded)
+	assert.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return !s.running && len(s.waiters) == 0
+	}, time.Second, time.Millisecond)
+}
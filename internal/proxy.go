@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// ProxyEndpointHost is the host of the Oxylabs proxy endpoint.
+const ProxyEndpointHost = "realtime.oxylabs.io:60000"
+
+// ProxyReq performs a GET req to url via the proxy endpoint. The parameters
+// of the opts fields f supported by the proxy endpoint are sent as headers.
+func (c *Client) ProxyReq(
+	ctx context.Context,
+	url string,
+	f Fields,
+) (*http.Response, error) {
+	done, err := c.startReq()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	// Reqs cannot be chained through a second proxy, so it is not dropped silently.
+	if c.config().ProxyURL != nil {
+		return nil, fmt.Errorf("the proxy endpoint integration cannot be used with a proxy url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	setProxyHeaders(req, f)
+
+	resp, err := c.proxyHttpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing proxy req: %v", err)
+	}
+
+	return resp, nil
+}
+
+// proxyHttpClient returns the http client for the proxy endpoint,
+// creating it on first use.
+func (c *Client) proxyHttpClient() *http.Client {
+	c.proxyOnce.Do(func() {
+		transport := newHttpClient(c.config()).Transport.(*http.Transport)
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			// Read the credentials per req, so that they can be rotated or overridden.
+			credentials := c.credentials()
+			if username, password, ok := oxylabs.ReqCredentials(req.Context()); ok {
+				credentials = ApiCredentials{Username: username, Password: password}
+			}
+			host := c.ProxyHost
+			if host == "" {
+				host = ProxyEndpointHost
+			}
+			return &url.URL{
+				Scheme: "http",
+				User:   url.UserPassword(credentials.Username, credentials.Password),
+				Host:   host,
+			}, nil
+		}
+
+		// The proxy endpoint re-signs the TLS connection to the target,
+		// so verification is only skipped if opted in.
+		if c.config().InsecureProxyTLS {
+			if transport.TLSClientConfig == nil {
+				transport.TLSClientConfig = &tls.Config{}
+			}
+			transport.TLSClientConfig.InsecureSkipVerify = true
+		}
+
+		c.proxyClient.Store(&http.Client{Transport: transport})
+	})
+
+	return c.proxyClient.Load()
+}
+
+// setProxyHeaders sets the proxy endpoint headers for the fields f.
+func setProxyHeaders(req *http.Request, f Fields) {
+	if f.UserAgent != nil && *f.UserAgent != "" {
+		req.Header.Set("x-oxylabs-user-agent-type", string(*f.UserAgent))
+	}
+	if f.Render != nil && *f.Render != "" {
+		req.Header.Set("x-oxylabs-render", string(*f.Render))
+	}
+	if f.GeoLocation != nil && *f.GeoLocation != "" {
+		req.Header.Set("x-oxylabs-geo-location", string(*f.GeoLocation))
+	}
+	if f.Parse != nil && *f.Parse {
+		req.Header.Set("x-oxylabs-parse", "1")
+	}
+}
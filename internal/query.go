@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/oxylabs/oxylabs-sdk-go/query"
+)
+
+// NormalizeQuery returns the query of a search source normalized as
+// configured with oxylabs.WithQueryNormalization. Queries which are empty
+// once normalized are rejected.
+//
+// NormalizeQuery, CheckUrl and CheckProductId may be called on a nil client,
+// which prepares payloads to validate opts alone: the query is returned unchecked.
+func (c *Client) NormalizeQuery(q string) (string, error) {
+	if c == nil {
+		return q, nil
+	}
+
+	normalization := c.config().QueryNormalization
+	if !normalization.Disabled {
+		var err error
+		if q, err = query.Normalize(q, normalization.Lowercase); err != nil {
+			return "", fmt.Errorf("invalid query parameter: %w", err)
+		}
+	}
+	if q == "" {
+		return "", fmt.Errorf("query parameter is empty")
+	}
+
+	return q, nil
+}
+
+// CheckUrl checks that the url of a url source belongs to host.
+func (c *Client) CheckUrl(url string, host string) error {
+	if c == nil {
+		return nil
+	}
+
+	return ValidateUrl(url, host)
+}
+
+// CheckProductId checks that the product id of a product source is set.
+func (c *Client) CheckProductId(productId string) error {
+	if c == nil {
+		return nil
+	}
+	if productId == "" {
+		return fmt.Errorf("product id parameter is empty")
+	}
+
+	return nil
+}
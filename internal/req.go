@@ -3,9 +3,14 @@ package internal
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // Req to the API.
@@ -16,6 +21,52 @@ func (c *Client) Req(
 	ctx context.Context,
 	jsonPayload []byte,
 	method string,
+) (*http.Response, error) {
+	done, err := c.startReq()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	if err := CheckPayload(jsonPayload); err != nil {
+		return nil, err
+	}
+
+	// Make the reqs of multiple pages in parallel, if enabled.
+	if payloads := c.splitPages(jsonPayload); payloads != nil {
+		return c.reqPages(ctx, payloads, method)
+	}
+
+	// Return the cached resp of an identical req, if any.
+	key := c.reqKey(ctx, "realtime", jsonPayload)
+	if body, ok := c.cacheGet(ctx, key); ok {
+		return cachedResp(body), nil
+	}
+
+	if key == "" || !c.config().Deduplicate {
+		return c.req(ctx, jsonPayload, method, key)
+	}
+
+	// Share the resp of an identical req in flight.
+	v, err := c.flights.do(ctx, key, func(ctx context.Context) (interface{}, error) {
+		resp, err := c.req(ctx, jsonPayload, method, key)
+		if err != nil {
+			return nil, err
+		}
+		return newSharedResp(resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*sharedResp).resp(), nil
+}
+
+// req performs the req and caches successful resps under key.
+func (c *Client) req(
+	ctx context.Context,
+	jsonPayload []byte,
+	method string,
+	key string,
 ) (*http.Response, error) {
 	// Prepare req.
 	req, err := http.NewRequestWithContext(
@@ -28,15 +79,175 @@ func (c *Client) Req(
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(c.ApiCredentials.Username, c.ApiCredentials.Password)
+	if err = c.setAuth(req); err != nil {
+		return nil, err
+	}
 
 	// Get resp.
-	resp, err := c.HttpClient.Do(req)
+	resp, err := c.doHedged(req)
 	if e, ok := err.(net.Error); ok && e.Timeout() {
+		c.audit(req, jsonPayload, "", 0, err)
 		return nil, fmt.Errorf("timeout error: %v", err)
 	} else if err != nil {
+		c.audit(req, jsonPayload, "", 0, err)
 		return nil, err
 	}
 
+	// Read the body if it is needed to cache or audit the resp.
+	cache := key != "" && c.config().Cache != nil && resp.StatusCode == http.StatusOK
+	if cache || c.config().AuditHook != nil {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading resp body: %v", err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if cache {
+			c.cacheSet(ctx, key, body)
+		}
+		c.audit(req, jsonPayload, respJobID(body), resp.StatusCode, nil)
+	}
+
 	return resp, nil
 }
+
+// maxPayloadSize is the maximum size in bytes of a req payload.
+const maxPayloadSize = 10 << 20
+
+// CheckPayload rejects oversized payloads and parameters which the source of
+// the payload does not support.
+// Payloads which are not JSON objects are left to the API to reject.
+func CheckPayload(jsonPayload []byte) error {
+	if len(jsonPayload) > maxPayloadSize {
+		return fmt.Errorf("payload of %d bytes exceeds the maximum of %d bytes", len(jsonPayload), maxPayloadSize)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return nil
+	}
+
+	return oxylabs.CheckSourceParams(payload)
+}
+
+// sharedResp is a resp shared by deduplicated reqs.
+type sharedResp struct {
+	httpResp *http.Response
+	body     []byte
+}
+
+// newSharedResp reads and closes the body of resp.
+func newSharedResp(resp *http.Response) (*sharedResp, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	return &sharedResp{httpResp: resp, body: body}, nil
+}
+
+// resp returns a copy of the shared resp with its own body reader.
+func (s *sharedResp) resp() *http.Response {
+	resp := *s.httpResp
+	resp.Body = io.NopCloser(bytes.NewReader(s.body))
+
+	return &resp
+}
+
+// GetJSON performs an authenticated GET req to url and unmarshals the resp body into v.
+func (c *Client) GetJSON(
+	ctx context.Context,
+	url string,
+	v interface{},
+) error {
+	if err := c.checkOpen(); err != nil {
+		return err
+	}
+
+	return c.getJSON(ctx, url, v)
+}
+
+// getJSON is GetJSON for the reqs of pollers, which may still run
+// while the client shuts down.
+func (c *Client) getJSON(
+	ctx context.Context,
+	url string,
+	v interface{},
+) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if err = c.setAuth(req); err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error with status code %s: %s", resp.Status, respBody)
+	}
+
+	if err = json.Unmarshal(respBody, v); err != nil {
+		return fmt.Errorf("error unmarshalling resp body: %v", err)
+	}
+
+	return nil
+}
+
+// do performs the req with compression negotiation and
+// transparently decompresses the resp body.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Accept-Encoding", AcceptEncoding)
+
+	resp, err := c.HttpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decompressResp(resp); err != nil {
+		return nil, err
+	}
+	if err = c.limitResp(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// asyncUrl returns the url which async jobs are submitted to.
+func (c *Client) asyncUrl() string {
+	if c.AsyncUrl != "" {
+		return c.AsyncUrl
+	}
+
+	return c.BaseUrl
+}
+
+// jobUrl returns the url of the job at the async url, followed by path.
+func (c *Client) jobUrl(jobID string, path string) string {
+	return fmt.Sprintf("%s/%s%s", c.asyncUrl(), jobID, path)
+}
+
+// NotSent reports whether err is the error of a req which failed before it
+// was sent, e.g. as its host could not be resolved or dialed, so that the
+// API has not run it.
+func NotSent(err error) bool {
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+
+	return errors.As(err, &dnsErr) || (errors.As(err, &opErr) && opErr.Op == "dial")
+}
@@ -0,0 +1,149 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Close rejects new reqs and stops issuing new polls, cancels the in-flight
+// pollers, waits for them and the in-flight realtime reqs to return and closes
+// idle connections. It returns ErrClientClosed if the client was already closed
+// or shut down.
+func (c *Client) Close() error {
+	if err := c.checkOpen(); err != nil {
+		return err
+	}
+
+	c.markClosed()
+	c.stop()
+	c.pollers.Wait()
+	c.reqs.Wait()
+	c.closeIdleConnections()
+
+	return nil
+}
+
+// Shutdown rejects new reqs and stops issuing new polls, and waits for the
+// in-flight pollers and realtime reqs to finish. If ctx is done first, the
+// remaining pollers are canceled and ctx's error is returned; in-flight reqs
+// end with their own ctx. Idle connections are closed once all pollers have
+// returned.
+func (c *Client) Shutdown(ctx context.Context) error {
+	c.markClosed()
+
+	done := make(chan struct{})
+	go func() {
+		c.pollers.Wait()
+		c.reqs.Wait()
+		close(done)
+	}()
+
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		c.stop()
+		c.pollers.Wait()
+		err = ctx.Err()
+	}
+
+	c.closeIdleConnections()
+
+	return err
+}
+
+// markClosed marks the client as closed, so that new reqs and polls are rejected.
+func (c *Client) markClosed() {
+	c.shutdownMu.Lock()
+	c.closed = true
+	c.shutdownMu.Unlock()
+}
+
+// closeIdleConnections closes the idle connections of the transports of the
+// client, leaving the pool of an http client shared with other clients open.
+func (c *Client) closeIdleConnections() {
+	if !c.sharedHttpClient {
+		c.HttpClient.CloseIdleConnections()
+	}
+	if proxyClient := c.proxyClient.Load(); proxyClient != nil {
+		proxyClient.CloseIdleConnections()
+	}
+}
+
+// startPoller registers a poller and returns its context, which is canceled
+// when the client is closed, and the func to call once the poller returns.
+func (c *Client) startPoller(ctx context.Context) (context.Context, func(), error) {
+	c.shutdownMu.Lock()
+	defer c.shutdownMu.Unlock()
+
+	if c.closed {
+		return nil, nil, oxylabs.ErrClientClosed
+	}
+	c.pollers.Add(1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	stopAfter := context.AfterFunc(c.stopContext(), cancel)
+
+	return ctx, func() {
+		stopAfter()
+		cancel()
+		c.pollers.Done()
+	}, nil
+}
+
+// startReq registers an in-flight req and returns the func to call once it
+// returns, or ErrClientClosed if the client was closed.
+func (c *Client) startReq() (func(), error) {
+	c.shutdownMu.Lock()
+	defer c.shutdownMu.Unlock()
+
+	if c.closed {
+		return nil, oxylabs.ErrClientClosed
+	}
+	c.reqs.Add(1)
+
+	return c.reqs.Done, nil
+}
+
+// checkOpen returns ErrClientClosed if the client was closed.
+func (c *Client) checkOpen() error {
+	c.shutdownMu.Lock()
+	defer c.shutdownMu.Unlock()
+
+	if c.closed {
+		return oxylabs.ErrClientClosed
+	}
+
+	return nil
+}
+
+// pollErr returns the error of a poller whose ctx is done.
+func (c *Client) pollErr(ctx context.Context) error {
+	if c.stopContext().Err() != nil {
+		return oxylabs.ErrClientClosed
+	}
+	var timeoutErr *oxylabs.JobTimeoutError
+	if errors.As(context.Cause(ctx), &timeoutErr) {
+		return timeoutErr
+	}
+
+	return fmt.Errorf("timeout exceeded")
+}
+
+// stopContext returns the context which is canceled to cancel in-flight pollers.
+func (c *Client) stopContext() context.Context {
+	c.stopOnce.Do(func() {
+		c.stopCtx, c.stopPolls = context.WithCancel(context.Background())
+	})
+
+	return c.stopCtx
+}
+
+// stop cancels the in-flight pollers.
+func (c *Client) stop() {
+	c.stopContext()
+	c.stopPolls()
+}
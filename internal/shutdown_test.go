@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Shutdown_CancelsPollers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "123", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := NewClient(server.URL, "user", "pass")
+	c.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	httpRespChan := make(chan *http.Response, 1)
+	errChan := make(chan error, 1)
+	go c.PollJobStatus(context.Background(), "123", 5*time.Millisecond, httpRespChan, errChan)
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, c.Shutdown(ctx), context.DeadlineExceeded)
+	assert.ErrorIs(t, <-errChan, oxylabs.ErrClientClosed)
+
+	// New polls and submissions are rejected.
+	httpRespChan = make(chan *http.Response, 1)
+	errChan = make(chan error, 1)
+	c.PollJobStatus(context.Background(), "123", time.Millisecond, httpRespChan, errChan)
+	assert.ErrorIs(t, <-errChan, oxylabs.ErrClientClosed)
+	_, err := c.GetJobID([]byte(`{}`))
+	assert.ErrorIs(t, err, oxylabs.ErrClientClosed)
+	assert.ErrorIs(t, c.Close(), oxylabs.ErrClientClosed)
+}
+
+func TestClient_Close_RejectsRealtimeReqs(t *testing.T) {
+	reqs := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs++
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user", "pass")
+	resp, err := c.Req(context.Background(), []byte(`{"query": "adidas"}`), "POST")
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	assert.NoError(t, c.Close())
+	_, err = c.Req(context.Background(), []byte(`{"query": "adidas"}`), "POST")
+	assert.ErrorIs(t, err, oxylabs.ErrClientClosed)
+	var v interface{}
+	assert.ErrorIs(t, c.GetJSON(context.Background(), server.URL, &v), oxylabs.ErrClientClosed)
+	_, err = c.ProxyReq(context.Background(), "https://example.com", Fields{})
+	assert.ErrorIs(t, err, oxylabs.ErrClientClosed)
+	assert.Equal(t, 1, reqs)
+}
+
+func TestClient_Close_WaitsForRealtimeReqs(t *testing.T) {
+	received := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-release
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user", "pass")
+	reqErr := make(chan error, 1)
+	go func() {
+		resp, err := c.Req(context.Background(), []byte(`{"query": "adidas"}`), "POST")
+		if err == nil {
+			resp.Body.Close()
+		}
+		reqErr <- err
+	}()
+	<-received
+
+	closed := make(chan error, 1)
+	go func() { closed <- c.Close() }()
+	select {
+	case <-closed:
+		t.Fatal("Close returned before the in-flight req")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	assert.NoError(t, <-reqErr)
+	assert.NoError(t, <-closed)
+}
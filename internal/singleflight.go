@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup collapses concurrent calls with the same key into a single call.
+// The zero value is ready to use.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+
+	// waiters is the number of callers waiting for the call,
+	// which is canceled once none is left.
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// do calls fn unless a call with the same key is in flight, in which case it
+// shares the result of that call. As the call is shared, it is not canceled
+// with the ctx of the first caller: it keeps the values of that ctx and is
+// canceled once the ctx of every caller is done, so it is bounded by the
+// longest deadline of its callers. Each caller stops waiting for the call
+// once its own ctx is done.
+func (g *flightGroup) do(
+	ctx context.Context,
+	key string,
+	fn func(ctx context.Context) (interface{}, error),
+) (interface{}, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flight)
+	}
+	f, ok := g.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = f
+		go g.call(callCtx, key, f, fn)
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.val, f.err
+	case <-ctx.Done():
+		g.leave(key, f)
+		return nil, ctx.Err()
+	}
+}
+
+// leave records that a caller stopped waiting for the flight, canceling
+// the call if it was the last one. Later callers start a new call.
+func (g *flightGroup) leave(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	f.waiters--
+	if f.waiters == 0 {
+		f.cancel()
+		if g.calls[key] == f {
+			delete(g.calls, key)
+		}
+	}
+}
+
+// call makes the shared call of the flight and wakes its callers.
+func (g *flightGroup) call(
+	ctx context.Context,
+	key string,
+	f *flight,
+	fn func(ctx context.Context) (interface{}, error),
+) {
+	defer f.cancel()
+	f.val, f.err = fn(ctx)
+
+	g.mu.Lock()
+	if g.calls[key] == f {
+		delete(g.calls, key)
+	}
+	g.mu.Unlock()
+	close(f.done)
+}
@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// GetUsageStats retrieves the usage statistics of the account from the stats endpoint.
+func (c *Client) GetUsageStats(
+	ctx context.Context,
+	opt *oxylabs.UsageStatsOpts,
+) (*oxylabs.UsageStats, error) {
+	query := url.Values{}
+	if opt.DateFrom != "" {
+		query.Set("date_from", opt.DateFrom)
+	}
+	if opt.DateTo != "" {
+		query.Set("date_to", opt.DateTo)
+	}
+	if opt.GroupBy != "" {
+		query.Set("group_by", opt.GroupBy)
+	}
+	if opt.Source != "" {
+		query.Set("source", string(opt.Source))
+	}
+
+	statsUrl := StatsUrl
+	if len(query) > 0 {
+		statsUrl += "?" + query.Encode()
+	}
+
+	stats := &oxylabs.UsageStats{}
+	if err := c.GetJSON(ctx, statsUrl, stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
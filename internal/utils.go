@@ -50,3 +50,33 @@ func ValidateUrl(
 
 	return nil
 }
+
+// SameUrl reports whether the urls point to the same page, ignoring the case
+// of the scheme and host, http vs https, default ports, a trailing slash,
+// the order of the query parameters and the fragment.
+// Unparsable urls are compared as strings.
+func SameUrl(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	ua, errA := url.Parse(a)
+	ub, errB := url.Parse(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	return normalizeHost(ua) == normalizeHost(ub) &&
+		strings.TrimSuffix(ua.EscapedPath(), "/") == strings.TrimSuffix(ub.EscapedPath(), "/") &&
+		ua.Query().Encode() == ub.Query().Encode()
+}
+
+// normalizeHost returns the lower case host of u without its default port.
+func normalizeHost(u *url.URL) string {
+	host := strings.ToLower(u.Hostname())
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+
+	return host
+}
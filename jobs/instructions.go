@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"reflect"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// normalizeInstructions converts the parse instructions of the opts struct v, decoded
+// as generic JSON values, into the types expected by oxylabs.ValidateParseInstructions.
+func normalizeInstructions(v interface{}) {
+	field := reflect.ValueOf(v).Elem().FieldByName("ParseInstructions")
+	if !field.IsValid() || field.IsNil() {
+		return
+	}
+
+	instructions, ok := field.Interface().(*map[string]interface{})
+	if !ok {
+		return
+	}
+	for k, value := range *instructions {
+		(*instructions)[k] = normalizeInstruction(k, value)
+	}
+}
+
+func normalizeInstruction(key string, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			v[k] = normalizeInstruction(k, value)
+		}
+		return v
+	case []interface{}:
+		if key != "_fns" {
+			return v
+		}
+		fns := make([]map[string]interface{}, 0, len(v))
+		for _, fn := range v {
+			f, ok := fn.(map[string]interface{})
+			if !ok {
+				return v
+			}
+			name, _ := f["_fn"].(string)
+			if args, ok := f["_args"]; ok {
+				f["_args"] = normalizeArgs(oxylabs.FnName(name), args)
+			}
+			fns = append(fns, f)
+		}
+		return fns
+	default:
+		return v
+	}
+}
+
+// normalizeArgs converts JSON numbers to ints, and string lists to []string
+// for the functions taking selectors.
+func normalizeArgs(name oxylabs.FnName, args interface{}) interface{} {
+	switch a := args.(type) {
+	case float64:
+		if a == float64(int(a)) {
+			return int(a)
+		}
+	case []interface{}:
+		switch name {
+		case oxylabs.Xpath, oxylabs.XpathOne, oxylabs.Css, oxylabs.CssOne:
+			selectors := make([]string, 0, len(a))
+			for _, e := range a {
+				s, ok := e.(string)
+				if !ok {
+					return a
+				}
+				selectors = append(selectors, s)
+			}
+			return selectors
+		}
+		for i, e := range a {
+			a[i] = normalizeArgs(name, e)
+		}
+	}
+
+	return args
+}
@@ -0,0 +1,150 @@
+// Package jobs loads scrape job definitions from JSON config files
+// into typed opts and submits them, e.g. for declarative scraping pipelines.
+// YAML job files are loaded by the jobsyaml module.
+//
+// A job file lists the jobs to run:
+//
+//	{"jobs": [{
+//	  "name": "adidas",
+//	  "source": "google_search",
+//	  "query": "adidas",
+//	  "options": {"pages": 3, "parse": true, "poll_interval": "5s"}
+//	}]}
+package jobs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Definition is a scrape job definition. Query is the query, or the url for url sources.
+// Options are decoded into the opts struct of the source, with keys in snake case,
+// e.g. start_page for StartPage.
+type Definition struct {
+	Name    string                 `json:"name"`
+	Source  oxylabs.Source         `json:"source"`
+	Query   string                 `json:"query"`
+	Options map[string]interface{} `json:"options"`
+}
+
+// File is the format of a job file.
+type File struct {
+	Jobs []Definition `json:"jobs"`
+}
+
+// Load loads the job definitions from the JSON file at path.
+func Load(path string) ([]Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading job file: %v", err)
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		return ParseJSON(data)
+	case ".yaml", ".yml":
+		return nil, fmt.Errorf("unsupported job file extension: %q, load YAML job files with jobsyaml.Load", ext)
+	default:
+		return nil, fmt.Errorf("unsupported job file extension: %q", ext)
+	}
+}
+
+// ParseJSON parses job definitions in JSON format.
+func ParseJSON(data []byte) ([]Definition, error) {
+	file := &File{}
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("error unmarshalling job file: %v", err)
+	}
+
+	return file.Jobs, validate(file.Jobs)
+}
+
+// validate decodes and validates the opts of every definition,
+// so that invalid files are rejected before any job is submitted.
+func validate(defs []Definition) error {
+	for i, def := range defs {
+		if _, err := def.Opts(); err != nil {
+			return fmt.Errorf("invalid job %s: %w", def.label(i), err)
+		}
+	}
+
+	return nil
+}
+
+// label returns the name of the definition, or its index if it has none.
+func (d Definition) label(i int) string {
+	if d.Name != "" {
+		return fmt.Sprintf("%q", d.Name)
+	}
+
+	return fmt.Sprintf("#%d", i+1)
+}
+
+// Opts returns the options of the definition decoded into the opts struct of its
+// source, e.g. *serp.GoogleSearchOpts for google_search, and validated.
+func (d Definition) Opts() (interface{}, error) {
+	src, ok := sources[d.Source]
+	if !ok {
+		return nil, fmt.Errorf("unsupported source: %q", d.Source)
+	}
+	if d.Query == "" {
+		return nil, fmt.Errorf("query is empty")
+	}
+
+	opts := src.newOpts()
+	if err := decodeOpts(d.Options, opts); err != nil {
+		return nil, err
+	}
+
+	if v, ok := opts.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return opts, nil
+}
+
+// decodeOpts decodes the options into the opts struct v.
+func decodeOpts(options map[string]interface{}, v interface{}) error {
+	normalized := make(map[string]interface{}, len(options))
+	for key, value := range options {
+		// Struct fields are matched case-insensitively, so snake case keys
+		// only need their underscores removed.
+		key = strings.ReplaceAll(key, "_", "")
+		switch key {
+		case "context":
+			return fmt.Errorf("context option is not supported in job definitions")
+		case "pollinterval":
+			if s, ok := value.(string); ok {
+				d, err := time.ParseDuration(s)
+				if err != nil {
+					return fmt.Errorf("invalid poll_interval option: %v", err)
+				}
+				value = d
+			}
+		}
+		normalized[key] = value
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return fmt.Errorf("error marshalling options: %v", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(v); err != nil {
+		return fmt.Errorf("error decoding options: %v", err)
+	}
+	normalizeInstructions(v)
+
+	return nil
+}
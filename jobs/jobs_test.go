@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSON(t *testing.T) {
+	defs, err := ParseJSON([]byte(`{"jobs": [{
+		"name": "adidas",
+		"source": "google_search",
+		"query": "adidas",
+		"options": {
+			"domain": "de",
+			"start_page": 2,
+			"pages": 3,
+			"parse": true,
+			"poll_interval": "5s",
+			"parse_instructions": {
+				"title": {"_fns": [{"_fn": "xpath_one", "_args": ["//title/text()"]}]}
+			}
+		}
+	}]}`))
+	assert.NoError(t, err)
+	if assert.Len(t, defs, 1) {
+		opts, err := defs[0].Opts()
+		assert.NoError(t, err)
+
+		opt := opts.(*serp.GoogleSearchOpts)
+		assert.Equal(t, oxylabs.Domain("de"), opt.Domain)
+		assert.Equal(t, 2, opt.StartPage)
+		assert.Equal(t, 3, opt.Pages)
+		assert.True(t, opt.Parse)
+		assert.Equal(t, 5*time.Second, opt.PollInterval)
+		assert.Contains(t, *opt.ParseInstructions, "title")
+	}
+}
+
+func TestParseJSON_Invalid(t *testing.T) {
+	_, err := ParseJSON([]byte(`{"jobs": [{"source": "google_search", "query": "adidas", "options": {"pagez": 1}}]}`))
+	assert.ErrorContains(t, err, `invalid job #1: error decoding options: json: unknown field "pagez"`)
+
+	_, err = ParseJSON([]byte(`{"jobs": [{"name": "x", "source": "unknown", "query": "adidas"}]}`))
+	assert.ErrorContains(t, err, `invalid job "x": unsupported source: "unknown"`)
+
+	_, err = ParseJSON([]byte(`{"jobs": [{"source": "bing_search", "query": "adidas", "options": {"user_agent": "invalid"}}]}`))
+	assert.ErrorContains(t, err, "invalid user agent parameter: invalid")
+}
@@ -0,0 +1,59 @@
+// Package jobsyaml loads job definitions of the jobs package from YAML
+// files. It is a separate package, so that only the programs which load
+// YAML files import the YAML decoder.
+//
+// A job file lists the jobs to run:
+//
+//	jobs:
+//	  - name: adidas
+//	    source: google_search
+//	    query: adidas
+//	    options:
+//	      pages: 3
+//	      parse: true
+//	      poll_interval: 5s
+package jobsyaml
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/oxylabs/oxylabs-sdk-go/jobs"
+	"gopkg.in/yaml.v3"
+)
+
+// Load loads the job definitions from the YAML or JSON file at path,
+// depending on its extension.
+func Load(path string) ([]jobs.Definition, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+	default:
+		return jobs.Load(path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading job file: %v", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse parses job definitions in YAML format. The YAML is converted to
+// JSON, so that options are decoded the same way as by jobs.ParseJSON.
+func Parse(data []byte) ([]jobs.Definition, error) {
+	var file interface{}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("error unmarshalling job file: %v", err)
+	}
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		return nil, fmt.Errorf("error converting job file to json: %v", err)
+	}
+
+	return jobs.ParseJSON(data)
+}
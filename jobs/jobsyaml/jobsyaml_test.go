@@ -0,0 +1,46 @@
+package jobsyaml
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	defs, err := Parse([]byte(`
+jobs:
+  - name: adidas
+    source: google_search
+    query: adidas
+    options:
+      domain: de
+      start_page: 2
+      pages: 3
+      parse: true
+      poll_interval: 5s
+      parse_instructions:
+        title:
+          _fns:
+            - _fn: xpath_one
+              _args: ["//title/text()"]
+`))
+	assert.NoError(t, err)
+	if assert.Len(t, defs, 1) {
+		opts, err := defs[0].Opts()
+		assert.NoError(t, err)
+
+		opt := opts.(*serp.GoogleSearchOpts)
+		assert.Equal(t, oxylabs.Domain("de"), opt.Domain)
+		assert.Equal(t, 2, opt.StartPage)
+		assert.Equal(t, 3, opt.Pages)
+		assert.True(t, opt.Parse)
+		assert.Equal(t, 5*time.Second, opt.PollInterval)
+		assert.Contains(t, *opt.ParseInstructions, "title")
+	}
+
+	_, err = Parse([]byte("jobs:\n  - source: google_search\n    query: adidas\n    options:\n      pagez: 1\n"))
+	assert.ErrorContains(t, err, `unknown field "pagez"`)
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"sync"
+	"time"
+)
+
+// Progress is a snapshot of the progress of a run of jobs.
+type Progress struct {
+	Total int
+	// Submitted is the number of jobs submitted so far, including finished ones.
+	Submitted int
+	Completed int
+	Failed    int
+	Elapsed   time.Duration
+	// ETA is the estimated time until all jobs are finished, based on the
+	// average time per finished job. It is 0 until the first job finishes.
+	ETA time.Duration
+}
+
+// Done returns the number of finished jobs, completed or failed.
+func (p Progress) Done() int {
+	return p.Completed + p.Failed
+}
+
+// progress tracks the progress of a run and reports it to the OnProgress
+// callback of the runner, if any. Reports are serialized.
+type progress struct {
+	mu       sync.Mutex
+	report   func(Progress)
+	started  time.Time
+	snapshot Progress
+}
+
+func newProgress(report func(Progress), total int) *progress {
+	return &progress{
+		report:   report,
+		started:  time.Now(),
+		snapshot: Progress{Total: total},
+	}
+}
+
+// submitted records that a job was started.
+func (p *progress) submitted() {
+	p.update(func(s *Progress) { s.Submitted++ })
+}
+
+// finished records that a job finished with err.
+func (p *progress) finished(err error) {
+	p.update(func(s *Progress) {
+		if err != nil {
+			s.Failed++
+		} else {
+			s.Completed++
+		}
+	})
+}
+
+func (p *progress) update(f func(s *Progress)) {
+	if p.report == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f(&p.snapshot)
+	p.snapshot.Elapsed = time.Since(p.started)
+	if done := p.snapshot.Done(); done > 0 {
+		perJob := p.snapshot.Elapsed / time.Duration(done)
+		p.snapshot.ETA = perJob * time.Duration(p.snapshot.Total-done)
+	}
+
+	p.report(p.snapshot)
+}
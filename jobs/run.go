@@ -0,0 +1,258 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/oxylabs/oxylabs-sdk-go/ecommerce"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+)
+
+// Result is the result of a job. Depending on the source either Serp or Ecommerce is set.
+// Index is the position of the definition of the job in the input slice, so that
+// results received out of order from Stream can be matched back to their inputs.
+type Result struct {
+	Index      int             `json:"index"`
+	Definition Definition      `json:"definition"`
+	Serp       *serp.Resp      `json:"serp,omitempty"`
+	Ecommerce  *ecommerce.Resp `json:"ecommerce,omitempty"`
+	// Err is the error of the job, set only on results received from Stream.
+	Err error `json:"-"`
+}
+
+// Runner submits jobs with the push-pull clients of the SERP and E-Commerce APIs.
+// A client only needs to be set if jobs of its sources are run.
+type Runner struct {
+	Serp      *serp.SerpClientAsync
+	Ecommerce *ecommerce.EcommerceClientAsync
+	// OnProgress, if set, is called with the progress of RunAll and Stream whenever
+	// a job is submitted or finishes. Calls are serialized, so it should return quickly.
+	OnProgress func(Progress)
+}
+
+// Run submits the job and waits for its result.
+func (r *Runner) Run(ctx context.Context, def Definition) (*Result, error) {
+	opts, err := def.Opts()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := sources[def.Source].run(r, ctx, def.Query, opts)
+	if err != nil {
+		return nil, err
+	}
+	res.Definition = def
+
+	return res, nil
+}
+
+// RunAll runs the jobs one after another and returns their results,
+// stopping at the first error.
+func (r *Runner) RunAll(ctx context.Context, defs []Definition) ([]*Result, error) {
+	results := make([]*Result, 0, len(defs))
+	p := newProgress(r.OnProgress, len(defs))
+	for i, def := range defs {
+		p.submitted()
+		res, err := r.Run(ctx, def)
+		p.finished(err)
+		if err != nil {
+			return results, fmt.Errorf("error running job %s: %w", def.label(i), err)
+		}
+		res.Index = i
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// Stream runs the jobs with at most concurrency of them in flight and sends
+// their results on the returned channel as they complete, which is closed once
+// all jobs are done. Results arrive in completion order; each carries the Index
+// and the Definition of its job. The error of a failed job is set on its result,
+// so every input gets exactly one result.
+func (r *Runner) Stream(ctx context.Context, defs []Definition, concurrency int) <-chan *Result {
+	results := make(chan *Result, len(defs))
+	sem := make(chan struct{}, max(concurrency, 1))
+	p := newProgress(r.OnProgress, len(defs))
+
+	var wg sync.WaitGroup
+	for i, def := range defs {
+		wg.Add(1)
+		go func(i int, def Definition) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				p.finished(ctx.Err())
+				results <- &Result{Index: i, Definition: def, Err: ctx.Err()}
+				return
+			}
+
+			p.submitted()
+			res, err := r.Run(ctx, def)
+			p.finished(err)
+			if err != nil {
+				res = &Result{Definition: def, Err: fmt.Errorf("error running job %s: %w", def.label(i), err)}
+			}
+			res.Index = i
+			results <- res
+		}(i, def)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// Ordered returns the results received from ch ordered by their Index.
+func Ordered(ch <-chan *Result) []*Result {
+	var results []*Result
+	for res := range ch {
+		results = append(results, res)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+
+	return results
+}
+
+// source is the opts struct and the scrape method of a source, and the
+// client methods used by the stateless Submit, Status and Fetch calls.
+type source struct {
+	newOpts func() interface{}
+	run     func(r *Runner, ctx context.Context, query string, opts interface{}) (*Result, error)
+	client  func(r *Runner) (jobClient, error)
+	fetch   func(r *Runner, ctx context.Context, jobID string, opts fetchOpts) (*Result, error)
+}
+
+func serpSource[O any](
+	scrape func(*serp.SerpClientAsync, context.Context, string, ...*O) (*serp.AsyncResult, error),
+) source {
+	return source{
+		newOpts: func() interface{} { return new(O) },
+		run: func(r *Runner, ctx context.Context, query string, opts interface{}) (*Result, error) {
+			if r.Serp == nil {
+				return nil, fmt.Errorf("runner has no serp client")
+			}
+			res, err := scrape(r.Serp, ctx, query, opts.(*O))
+			if err != nil {
+				return nil, err
+			}
+			resp, ok := <-res.Results()
+			if !ok {
+				return nil, res.Err()
+			}
+
+			return &Result{Serp: resp}, nil
+		},
+		client: func(r *Runner) (jobClient, error) {
+			if r.Serp == nil {
+				return nil, fmt.Errorf("runner has no serp client")
+			}
+
+			return r.Serp, nil
+		},
+		fetch: func(r *Runner, ctx context.Context, jobID string, opts fetchOpts) (*Result, error) {
+			if r.Serp == nil {
+				return nil, fmt.Errorf("runner has no serp client")
+			}
+			resp, err := r.Serp.GetJobResultsCtx(ctx, jobID, &serp.JobResultsOpts{
+				Meta:     opts.Meta,
+				Username: opts.Username,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return &Result{Serp: resp}, nil
+		},
+	}
+}
+
+func ecommerceSource[O any](
+	scrape func(*ecommerce.EcommerceClientAsync, context.Context, string, ...*O) (*ecommerce.AsyncResult, error),
+) source {
+	return source{
+		newOpts: func() interface{} { return new(O) },
+		run: func(r *Runner, ctx context.Context, query string, opts interface{}) (*Result, error) {
+			if r.Ecommerce == nil {
+				return nil, fmt.Errorf("runner has no ecommerce client")
+			}
+			res, err := scrape(r.Ecommerce, ctx, query, opts.(*O))
+			if err != nil {
+				return nil, err
+			}
+			resp, ok := <-res.Results()
+			if !ok {
+				return nil, res.Err()
+			}
+
+			return &Result{Ecommerce: resp}, nil
+		},
+		client: func(r *Runner) (jobClient, error) {
+			if r.Ecommerce == nil {
+				return nil, fmt.Errorf("runner has no ecommerce client")
+			}
+
+			return r.Ecommerce, nil
+		},
+		fetch: func(r *Runner, ctx context.Context, jobID string, opts fetchOpts) (*Result, error) {
+			if r.Ecommerce == nil {
+				return nil, fmt.Errorf("runner has no ecommerce client")
+			}
+			resp, err := r.Ecommerce.GetJobResultsCtx(ctx, jobID, &ecommerce.JobResultsOpts{
+				Meta:     opts.Meta,
+				Username: opts.Username,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return &Result{Ecommerce: resp}, nil
+		},
+	}
+}
+
+var sources = map[oxylabs.Source]source{
+	oxylabs.GoogleSearch:        serpSource((*serp.SerpClientAsync).ScrapeGoogleSearchCtx),
+	oxylabs.GoogleUrl:           serpSource((*serp.SerpClientAsync).ScrapeGoogleUrlCtx),
+	oxylabs.GoogleAds:           serpSource((*serp.SerpClientAsync).ScrapeGoogleAdsCtx),
+	oxylabs.GoogleSuggestions:   serpSource((*serp.SerpClientAsync).ScrapeGoogleSuggestionsCtx),
+	oxylabs.GoogleHotels:        serpSource((*serp.SerpClientAsync).ScrapeGoogleHotelsCtx),
+	oxylabs.GoogleTravelHotels:  serpSource((*serp.SerpClientAsync).ScrapeGoogleTravelHotelsCtx),
+	oxylabs.GoogleImages:        serpSource((*serp.SerpClientAsync).ScrapeGoogleImagesCtx),
+	oxylabs.GoogleTrendsExplore: serpSource((*serp.SerpClientAsync).ScrapeGoogleTrendsExploreCtx),
+	oxylabs.BingSearch:          serpSource((*serp.SerpClientAsync).ScrapeBingSearchCtx),
+	oxylabs.BingUrl:             serpSource((*serp.SerpClientAsync).ScrapeBingUrlCtx),
+
+	oxylabs.AmazonUrl:             ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeAmazonUrlCtx),
+	oxylabs.AmazonSearch:          ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeAmazonSearchCtx),
+	oxylabs.AmazonProduct:         ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeAmazonProductCtx),
+	oxylabs.AmazonPricing:         ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeAmazonPricingCtx),
+	oxylabs.AmazonReviews:         ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeAmazonReviewsCtx),
+	oxylabs.AmazonQuestions:       ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeAmazonQuestionsCtx),
+	oxylabs.AmazonBestsellers:     ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeAmazonBestsellersCtx),
+	oxylabs.AmazonSellers:         ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeAmazonSellersCtx),
+	oxylabs.GoogleShoppingUrl:     ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeGoogleShoppingUrlCtx),
+	oxylabs.GoogleShoppingSearch:  ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeGoogleShoppingSearchCtx),
+	oxylabs.GoogleShoppingProduct: ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeGoogleShoppingProductCtx),
+	oxylabs.GoogleShoppingPricing: ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeGoogleShoppingPricingCtx),
+	oxylabs.WayfairSearch:         ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeWayfairSearchCtx),
+	oxylabs.Wayfair:               ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeWayfairUrlCtx),
+	oxylabs.Universal:             ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeUniversalUrlCtx),
+	oxylabs.Target:                ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeTargetUrlCtx),
+	oxylabs.TargetSearch:          ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeTargetSearchCtx),
+	oxylabs.TargetProduct:         ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeTargetProductCtx),
+	oxylabs.EtsySearch:            ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeEtsySearchCtx),
+	oxylabs.EtsyProduct:           ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeEtsyProductCtx),
+	oxylabs.Kroger:                ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeKrogerUrlCtx),
+	oxylabs.KrogerSearch:          ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeKrogerSearchCtx),
+	oxylabs.KrogerProduct:         ecommerceSource((*ecommerce.EcommerceClientAsync).ScrapeKrogerProductCtx),
+}
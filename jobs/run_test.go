@@ -0,0 +1,129 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/stretchr/testify/assert"
+)
+
+// rewriteTransport sends all reqs to a test server.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRunner_Stream(t *testing.T) {
+	var submitted atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			id := fmt.Sprint(submitted.Add(1))
+			fmt.Fprintf(w, `{"id": "%s", "status": "pending"}`, id)
+		case strings.HasSuffix(r.URL.Path, "/results"):
+			fmt.Fprint(w, `{"results": [{"content": "<html></html>", "status_code": 200}]}`)
+		default:
+			fmt.Fprintf(w, `{"id": "%s", "status": "done"}`, strings.TrimPrefix(r.URL.Path, "/v1/queries/"))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := serp.InitAsync("username", "password")
+	c.C.BaseUrl = server.URL
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	defs := []Definition{
+		{Source: oxylabs.GoogleSearch, Query: "a", Options: map[string]interface{}{"poll_interval": "1ms"}},
+		{Source: oxylabs.GoogleSearch, Query: "b", Options: map[string]interface{}{"poll_interval": "1ms"}},
+		{Source: "unknown", Query: "c"},
+		{Source: oxylabs.BingSearch, Query: "d", Options: map[string]interface{}{"poll_interval": "1ms"}},
+	}
+	var last atomic.Value
+	r := &Runner{Serp: c, OnProgress: func(p Progress) { last.Store(p) }}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// Results arrive in completion order, and the failed job gets a result too.
+	results := Ordered(r.Stream(ctx, defs, 2))
+	if assert.Len(t, results, len(defs)) {
+		for i, res := range results {
+			assert.Equal(t, i, res.Index)
+			assert.Equal(t, defs[i].Query, res.Definition.Query)
+		}
+		assert.NoError(t, results[0].Err)
+		assert.NotNil(t, results[0].Serp)
+		assert.ErrorContains(t, results[2].Err, "error running job #3")
+	}
+
+	progress := last.Load().(Progress)
+	assert.Equal(t, 4, progress.Total)
+	assert.Equal(t, 4, progress.Submitted)
+	assert.Equal(t, 3, progress.Completed)
+	assert.Equal(t, 1, progress.Failed)
+	assert.Equal(t, time.Duration(0), progress.ETA)
+}
+
+func TestRunner_SubmitStatusFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			fmt.Fprint(w, `{"id": "123", "status": "pending"}`)
+		case strings.HasSuffix(r.URL.Path, "/results"):
+			fmt.Fprint(w, `{"results": [{"content": "<html></html>", "status_code": 200}]}`)
+		default:
+			fmt.Fprint(w, `{"id": "123", "status": "done"}`)
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := serp.InitAsync("username", "password")
+	c.C.BaseUrl = server.URL
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+	r := &Runner{Serp: c}
+
+	def := Definition{Source: oxylabs.GoogleSearch, Query: "adidas", Options: map[string]interface{}{
+		"meta": map[string]interface{}{"work_item": "42"},
+	}}
+	ref, err := r.Submit(context.Background(), def)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", ref.ID)
+	assert.Equal(t, "username", ref.Username)
+
+	// The ref survives a round trip through the workflow engine.
+	data, err := json.Marshal(ref)
+	assert.NoError(t, err)
+	var decoded JobRef
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, ref, decoded)
+
+	status, err := r.Status(context.Background(), decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, oxylabs.JobDone, status)
+
+	res, err := r.Fetch(context.Background(), decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, "<html></html>", res.Serp.Results[0].Content)
+	assert.Equal(t, "42", res.Serp.Meta["work_item"])
+	assert.Equal(t, "adidas", res.Definition.Query)
+
+	_, err = (&Runner{}).Submit(context.Background(), def)
+	assert.ErrorContains(t, err, "runner has no serp client")
+}
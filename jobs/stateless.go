@@ -0,0 +1,128 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oxylabs/oxylabs-sdk-go/ecommerce"
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+)
+
+// JobRef refers to a job submitted with Submit. It is serializable, so that it
+// can be passed between the activities of a workflow engine, e.g. Temporal,
+// or the messages of a queue.
+type JobRef struct {
+	ID         string     `json:"id"`
+	Definition Definition `json:"definition"`
+	// Username is the account the job was submitted with, so that Status and
+	// Fetch use it, e.g. with a client spreading jobs across a credentials pool.
+	Username string `json:"username,omitempty"`
+}
+
+// jobClient is implemented by the async clients of both APIs.
+type jobClient interface {
+	SubmitOnly(ctx context.Context, query string, opts interface{}) (string, error)
+	CheckJobStatus(ctx context.Context, jobID string) (oxylabs.JobStatus, error)
+}
+
+// fetchOpts are the options of a definition carried to the results of its job.
+// The results are decoded as the job was submitted.
+type fetchOpts struct {
+	Meta     map[string]string
+	Username string
+}
+
+// apiClient returns the low level client of an async client.
+func apiClient(client jobClient) *internal.Client {
+	switch c := client.(type) {
+	case *serp.SerpClientAsync:
+		return c.C
+	case *ecommerce.EcommerceClientAsync:
+		return c.C
+	}
+
+	return nil
+}
+
+// Submit submits the job of def without waiting for it. Unlike Run, Submit,
+// Status and Fetch are independent calls which keep no state in the runner,
+// so that each step can be retried on its own, e.g. as a workflow activity.
+// A retried Submit submits the job again, unless ctx carries an idempotency key,
+// see oxylabs.WithIdempotencyKey.
+func (r *Runner) Submit(ctx context.Context, def Definition) (JobRef, error) {
+	opts, err := def.Opts()
+	if err != nil {
+		return JobRef{}, err
+	}
+	client, err := sources[def.Source].client(r)
+	if err != nil {
+		return JobRef{}, err
+	}
+
+	jobID, err := client.SubmitOnly(ctx, def.Query, opts)
+	if err != nil {
+		return JobRef{}, err
+	}
+
+	return JobRef{ID: jobID, Definition: def, Username: apiClient(client).JobAccount(ctx, jobID)}, nil
+}
+
+// Status gets the status of the job of ref with a single req, without polling.
+func (r *Runner) Status(ctx context.Context, ref JobRef) (oxylabs.JobStatus, error) {
+	src, ok := sources[ref.Definition.Source]
+	if !ok {
+		return "", fmt.Errorf("unsupported source: %q", ref.Definition.Source)
+	}
+	client, err := src.client(r)
+	if err != nil {
+		return "", err
+	}
+	if err = apiClient(client).PinAccount(ref.ID, ref.Username); err != nil {
+		return "", err
+	}
+
+	return client.CheckJobStatus(ctx, ref.ID)
+}
+
+// Fetch fetches the results of the finished job of ref, decoded as
+// requested by the options of its definition.
+func (r *Runner) Fetch(ctx context.Context, ref JobRef) (*Result, error) {
+	if _, err := ref.Definition.Opts(); err != nil {
+		return nil, err
+	}
+
+	opts := newFetchOpts(ref.Definition)
+	opts.Username = ref.Username
+
+	res, err := sources[ref.Definition.Source].fetch(r, ctx, ref.ID, opts)
+	if err != nil {
+		return nil, err
+	}
+	res.Definition = ref.Definition
+
+	return res, nil
+}
+
+// newFetchOpts returns the fetch options of def, read from its checked options.
+func newFetchOpts(def Definition) fetchOpts {
+	var f fetchOpts
+	for key, value := range def.Options {
+		if strings.ToLower(strings.ReplaceAll(key, "_", "")) != "meta" {
+			continue
+		}
+		switch meta := value.(type) {
+		case map[string]string:
+			f.Meta = meta
+		case map[string]interface{}:
+			f.Meta = make(map[string]string, len(meta))
+			for k, v := range meta {
+				f.Meta[k] = fmt.Sprint(v)
+			}
+		}
+	}
+
+	return f
+}
@@ -0,0 +1,226 @@
+package jobstore
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// minCompactEntries is the number of log entries below which the store
+// file is not compacted, so that small stores are not rewritten often.
+const minCompactEntries = 1000
+
+// FileStore is a Store which persists jobs to a file.
+// Every change is appended to the file as a line of JSON, and the file is
+// compacted to the jobs it still holds when it is opened and once most of
+// its lines are stale. Done jobs are pruned, except for the latest job of
+// each idempotency key.
+type FileStore struct {
+	mu      sync.Mutex
+	path    string
+	records *records
+	// entries is the number of lines of the store file.
+	entries int
+}
+
+// logEntry is a line of the store file: a saved job, the ID of a job
+// marked as done or a released idempotency key.
+type logEntry struct {
+	Save    *Job   `json:"save,omitempty"`
+	Done    string `json:"done,omitempty"`
+	Release string `json:"release,omitempty"`
+}
+
+// NewFileStore returns a FileStore backed by the file at path,
+// loading any jobs which were previously persisted to it.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{
+		path:    path,
+		records: newRecords(),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading job store file: %v", err)
+	}
+
+	if err = s.replay(data); err != nil {
+		return nil, err
+	}
+
+	if err = s.compact(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// replay applies the entries of the store file to the records.
+// A malformed last line, e.g. of an append cut short by a crash, is skipped.
+func (s *FileStore) replay(data []byte) error {
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	for i, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry logEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			if i == len(lines)-1 {
+				break
+			}
+			return fmt.Errorf("error unmarshalling job store file line %d: %v", i+1, err)
+		}
+
+		switch {
+		case entry.Save != nil:
+			s.records.save(*entry.Save)
+		case entry.Done != "":
+			s.records.markDone(entry.Done)
+		case entry.Release != "":
+			s.records.releaseKey(entry.Release)
+		}
+	}
+	for id := range s.records.jobs {
+		s.records.prune(id)
+	}
+
+	return nil
+}
+
+// Save persists a submitted job.
+func (s *FileStore) Save(job Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records.save(job)
+
+	return s.append(logEntry{Save: &job})
+}
+
+// Load returns all jobs which are not marked as done, oldest first.
+func (s *FileStore) Load() ([]Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return pendingJobs(s.records.jobs), nil
+}
+
+// FindByKey returns the job submitted with the idempotency key.
+func (s *FileStore) FindByKey(idempotencyKey string) (Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.records.findByKey(idempotencyKey)
+}
+
+// ReleaseKey removes the pending record of the idempotency key.
+func (s *FileStore) ReleaseKey(idempotencyKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records.releaseKey(idempotencyKey)
+
+	return s.append(logEntry{Release: idempotencyKey})
+}
+
+// MarkDone marks the job as done.
+func (s *FileStore) MarkDone(jobID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.records.markDone(jobID); err != nil {
+		return err
+	}
+
+	return s.append(logEntry{Done: jobID})
+}
+
+// append appends the entry to the store file and syncs it to disk, and
+// compacts the file once most of its lines are stale.
+func (s *FileStore) append(entry logEntry) error {
+	if s.entries >= minCompactEntries && s.entries > 2*len(s.records.jobs) {
+		return s.compact()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error marshalling job store entry: %v", err)
+	}
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return fmt.Errorf("error opening job store file: %v", err)
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing job store file: %v", err)
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("error syncing job store file: %v", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("error writing job store file: %v", err)
+	}
+	s.entries++
+
+	return nil
+}
+
+// compact writes the jobs to a temporary file, syncs it and renames it over
+// the store file so that a crash never leaves a partially written file.
+func (s *FileStore) compact() error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("error creating temporary job store file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, job := range s.records.jobs {
+		job := job
+		if err = enc.Encode(logEntry{Save: &job}); err != nil {
+			tmp.Close()
+			return fmt.Errorf("error writing job store file: %v", err)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing job store file: %v", err)
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error syncing job store file: %v", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("error writing job store file: %v", err)
+	}
+
+	if err = os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("error replacing job store file: %v", err)
+	}
+	syncDir(filepath.Dir(s.path))
+	s.entries = len(s.records.jobs)
+
+	return nil
+}
+
+// syncDir syncs the directory so that a rename in it is persisted.
+// Errors are ignored, as not all platforms support syncing directories.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	d.Sync()
+	d.Close()
+}
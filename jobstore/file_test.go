@@ -0,0 +1,85 @@
+package jobstore
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore_SurvivesReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.json")
+
+	s, err := NewFileStore(path)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	assert.NoError(t, s.Save(Job{ID: "1", Payload: []byte(`{"source":"google_search"}`), SubmittedAt: now}))
+	assert.NoError(t, s.Save(Job{ID: "2", Payload: []byte(`{"source":"bing_search"}`), SubmittedAt: now.Add(time.Second)}))
+	assert.NoError(t, s.Save(Job{ID: "3", Payload: []byte(`{}`), SubmittedAt: now.Add(2 * time.Second)}))
+	assert.NoError(t, s.MarkDone("2"))
+	assert.ErrorIs(t, s.MarkDone("missing"), ErrNotFound)
+
+	// Reopen the store as a restarted process would.
+	s, err = NewFileStore(path)
+	assert.NoError(t, err)
+
+	jobs, err := s.Load()
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 2) {
+		assert.Equal(t, "1", jobs[0].ID)
+		assert.JSONEq(t, `{"source":"google_search"}`, string(jobs[0].Payload))
+		assert.Equal(t, "3", jobs[1].ID)
+	}
+}
+
+func TestFileStore_PrunesDoneJobs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.json")
+
+	s, err := NewFileStore(path)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	for i := 0; i < 3*minCompactEntries; i++ {
+		jobID := fmt.Sprint(i)
+		assert.NoError(t, s.Save(Job{ID: jobID, Payload: []byte(`{}`), SubmittedAt: now}))
+		assert.NoError(t, s.MarkDone(jobID))
+	}
+	assert.NoError(t, s.Save(Job{ID: "keyed", IdempotencyKey: "order-1", SubmittedAt: now}))
+	assert.NoError(t, s.MarkDone("keyed"))
+	assert.NoError(t, s.Save(Job{ID: "pending", SubmittedAt: now}))
+
+	// Done jobs are pruned, except for the latest job of an idempotency key,
+	// and the file is compacted once most of its lines are stale.
+	assert.Len(t, s.records.jobs, 2)
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Less(t, bytes.Count(data, []byte("\n")), 2*minCompactEntries)
+
+	// An append cut short by a crash is skipped.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	assert.NoError(t, err)
+	f.WriteString(`{"save": {"id": "cut`)
+	f.Close()
+
+	s, err = NewFileStore(path)
+	assert.NoError(t, err)
+	jobs, err := s.Load()
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, "pending", jobs[0].ID)
+	}
+	job, err := s.FindByKey("order-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "keyed", job.ID)
+	assert.True(t, job.Done)
+
+	// The file is compacted when it is opened.
+	data, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, bytes.Count(data, []byte("\n")))
+}
@@ -0,0 +1,130 @@
+package jobstore
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrNotFound = errors.New("job not found")
+
+// Job is a submitted async job persisted by a Store.
+type Job struct {
+	ID             string          `json:"id"`
+	Payload        json.RawMessage `json:"payload"`
+	PayloadHash    string          `json:"payload_hash,omitempty"`
+	IdempotencyKey string          `json:"idempotency_key,omitempty"`
+	SubmittedAt    time.Time       `json:"submitted_at"`
+	Done           bool            `json:"done"`
+	// Username is the account the job was submitted with, so that a resumed
+	// job is polled with the same account, e.g. of a credentials pool.
+	Username string `json:"username,omitempty"`
+	// Pending is set on the record of an idempotency key saved before its job is
+	// submitted, so that a submission whose resp was lost is not repeated.
+	// Its ID is empty, and Load does not return it.
+	Pending bool `json:"pending,omitempty"`
+}
+
+// Store persists the IDs of submitted async jobs so that a crashed
+// process can resume polling them after a restart.
+type Store interface {
+	// Save persists a submitted job.
+	Save(job Job) error
+	// Load returns all jobs which are not marked as done.
+	Load() ([]Job, error)
+	// MarkDone marks the job as done.
+	MarkDone(jobID string) error
+}
+
+// KeyFinder is implemented by stores which can look up jobs by idempotency key.
+type KeyFinder interface {
+	// FindByKey returns the latest job submitted with the idempotency key,
+	// done or not, or its pending record, or ErrNotFound.
+	FindByKey(idempotencyKey string) (Job, error)
+	// ReleaseKey removes the pending record of the idempotency key,
+	// once its submission is known to have created no job.
+	ReleaseKey(idempotencyKey string) error
+}
+
+// records are the jobs of a store indexed by ID and by idempotency key.
+type records struct {
+	jobs map[string]Job
+	// keys maps idempotency keys to the record of their latest job.
+	keys map[string]string
+}
+
+func newRecords() *records {
+	return &records{jobs: make(map[string]Job), keys: make(map[string]string)}
+}
+
+// recordID returns the ID a job is stored under. Pending records, which have
+// no job ID yet, are stored under their idempotency key.
+func recordID(job Job) string {
+	if job.ID == "" {
+		return "key:" + job.IdempotencyKey
+	}
+
+	return job.ID
+}
+
+func (r *records) save(job Job) {
+	id := recordID(job)
+	r.jobs[id] = job
+	if job.IdempotencyKey == "" {
+		return
+	}
+
+	// The submitted job replaces the pending record of its key.
+	if job.ID != "" {
+		delete(r.jobs, "key:"+job.IdempotencyKey)
+	}
+	previous := r.keys[job.IdempotencyKey]
+	latest, ok := r.jobs[previous]
+	if !ok || !job.SubmittedAt.Before(latest.SubmittedAt) {
+		r.keys[job.IdempotencyKey] = id
+		r.prune(previous)
+	}
+}
+
+func (r *records) findByKey(idempotencyKey string) (Job, error) {
+	job, ok := r.jobs[r.keys[idempotencyKey]]
+	if idempotencyKey == "" || !ok {
+		return Job{}, ErrNotFound
+	}
+
+	return job, nil
+}
+
+func (r *records) releaseKey(idempotencyKey string) {
+	id := "key:" + idempotencyKey
+	if _, ok := r.jobs[id]; !ok {
+		return
+	}
+	delete(r.jobs, id)
+	if r.keys[idempotencyKey] == id {
+		delete(r.keys, idempotencyKey)
+	}
+}
+
+// markDone marks the job as done and prunes it, unless it is the latest job
+// of its idempotency key which FindByKey must still return.
+func (r *records) markDone(jobID string) error {
+	job, ok := r.jobs[jobID]
+	if !ok || job.ID == "" {
+		return ErrNotFound
+	}
+	job.Done = true
+	r.jobs[jobID] = job
+	r.prune(jobID)
+
+	return nil
+}
+
+// prune removes the record if its job is done and it is not the latest
+// record of its idempotency key.
+func (r *records) prune(id string) {
+	job, ok := r.jobs[id]
+	if ok && job.Done && (job.IdempotencyKey == "" || r.keys[job.IdempotencyKey] != id) {
+		delete(r.jobs, id)
+	}
+}
@@ -0,0 +1,77 @@
+package jobstore
+
+import (
+	"sort"
+	"sync"
+)
+
+// MemoryStore is a Store which keeps jobs in memory.
+// It does not survive restarts and is mostly useful for tests.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records *records
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: newRecords()}
+}
+
+// Save persists a submitted job.
+func (s *MemoryStore) Save(job Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records.save(job)
+
+	return nil
+}
+
+// Load returns all jobs which are not marked as done, oldest first.
+func (s *MemoryStore) Load() ([]Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return pendingJobs(s.records.jobs), nil
+}
+
+// FindByKey returns the job submitted with the idempotency key.
+func (s *MemoryStore) FindByKey(idempotencyKey string) (Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.records.findByKey(idempotencyKey)
+}
+
+// ReleaseKey removes the pending record of the idempotency key.
+func (s *MemoryStore) ReleaseKey(idempotencyKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records.releaseKey(idempotencyKey)
+
+	return nil
+}
+
+// MarkDone marks the job as done.
+func (s *MemoryStore) MarkDone(jobID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.records.markDone(jobID)
+}
+
+// pendingJobs returns the submitted jobs which are not done sorted by submission time.
+func pendingJobs(jobs map[string]Job) []Job {
+	pending := []Job{}
+	for _, job := range jobs {
+		if !job.Done && !job.Pending {
+			pending = append(pending, job)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].SubmittedAt.Before(pending[j].SubmittedAt)
+	})
+
+	return pending
+}
@@ -0,0 +1,72 @@
+package oxylabs
+
+import (
+	"context"
+	"sync"
+)
+
+// AsyncResult is the result of an async scrape, produced in the background
+// once its job has finished.
+//
+// The resp is buffered in the Results channel, which is closed once the scrape
+// has finished, so the scrape never blocks on a caller which does not receive it.
+// Err reports why no resp was delivered and Done is closed.
+type AsyncResult[T any] struct {
+	results chan T
+	done    chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// RunAsync returns the AsyncResult of produce, which is run in a new goroutine,
+// e.g. polling a job and decoding its resp.
+func RunAsync[T any](ctx context.Context, produce func(ctx context.Context) (T, error)) *AsyncResult[T] {
+	r := &AsyncResult[T]{
+		results: make(chan T, 1),
+		done:    make(chan struct{}),
+	}
+
+	go func() {
+		defer close(r.done)
+		defer close(r.results)
+
+		val, err := produce(ctx)
+		if err != nil {
+			r.setErr(err)
+			return
+		}
+
+		r.results <- val
+	}()
+
+	return r
+}
+
+// Results returns the channel the resp is sent on. It is closed once the
+// scrape has finished, after the resp was buffered or the scrape failed.
+func (r *AsyncResult[T]) Results() <-chan T {
+	return r.results
+}
+
+// Err returns the error which ended the scrape without a resp. It is nil while
+// the scrape is running, so it should be checked after Results is closed.
+func (r *AsyncResult[T]) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+// Done returns a channel which is closed once the scrape has finished,
+// after its resp was buffered or it failed.
+func (r *AsyncResult[T]) Done() <-chan struct{} {
+	return r.done
+}
+
+func (r *AsyncResult[T]) setErr(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.err = err
+}
@@ -0,0 +1,28 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditRecord is the record of a req submitting a job to the Oxylabs API.
+type AuditRecord struct {
+	Time time.Time
+	// Url is the url the req was sent to.
+	Url string
+	// Username is the API username of the req. Passwords are never recorded.
+	Username string
+	// Payload is the payload of the req with the values of
+	// authorization headers and cookies redacted.
+	Payload json.RawMessage
+	// JobID is the ID of the job returned by the API, if any.
+	JobID string
+	// StatusCode is the status code of the resp, 0 if the req failed.
+	StatusCode int
+	// Err is the error of the req, if any.
+	Err error
+}
+
+// AuditHook is called synchronously with the record of every req submitting a job,
+// so it should return quickly. It must be safe for concurrent use.
+type AuditHook func(record AuditRecord)
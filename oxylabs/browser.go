@@ -0,0 +1,125 @@
+package oxylabs
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// BrowserInstructionType is the type of a browser instruction.
+type BrowserInstructionType string
+
+const (
+	BrowserClick          BrowserInstructionType = "click"
+	BrowserInput          BrowserInstructionType = "input"
+	BrowserScroll         BrowserInstructionType = "scroll"
+	BrowserScrollToBottom BrowserInstructionType = "scroll_to_bottom"
+	BrowserWait           BrowserInstructionType = "wait"
+	BrowserWaitForElement BrowserInstructionType = "wait_for_element"
+)
+
+// SelectorType is the type of a browser instruction selector.
+type SelectorType string
+
+const (
+	SelectorXpath SelectorType = "xpath"
+	SelectorCss   SelectorType = "css"
+	SelectorText  SelectorType = "text"
+)
+
+// Selector selects the element a browser instruction acts on.
+type Selector struct {
+	Type  SelectorType `json:"type"`
+	Value string       `json:"value"`
+}
+
+// BrowserInstruction is an action performed by the browser while rendering,
+// such as waiting for an element before the page is returned.
+// Browser instructions require the html render.
+type BrowserInstruction struct {
+	Type      BrowserInstructionType `json:"type"`
+	Selector  *Selector              `json:"selector,omitempty"`
+	Value     string                 `json:"value,omitempty"`
+	X         int                    `json:"x,omitempty"`
+	Y         int                    `json:"y,omitempty"`
+	TimeoutS  int                    `json:"timeout_s,omitempty"`
+	WaitTimeS int                    `json:"wait_time_s,omitempty"`
+}
+
+// Wait waits for the duration, rounded up to whole seconds.
+func Wait(d time.Duration) BrowserInstruction {
+	return BrowserInstruction{Type: BrowserWait, WaitTimeS: seconds(d)}
+}
+
+// WaitForElement waits until the selected element appears, for at most timeout.
+func WaitForElement(selector Selector, timeout time.Duration) BrowserInstruction {
+	return BrowserInstruction{Type: BrowserWaitForElement, Selector: &selector, TimeoutS: seconds(timeout)}
+}
+
+// Click clicks the selected element.
+func Click(selector Selector) BrowserInstruction {
+	return BrowserInstruction{Type: BrowserClick, Selector: &selector}
+}
+
+// Input types the value into the selected element.
+func Input(selector Selector, value string) BrowserInstruction {
+	return BrowserInstruction{Type: BrowserInput, Selector: &selector, Value: value}
+}
+
+// Scroll scrolls the page by x and y pixels.
+func Scroll(x, y int) BrowserInstruction {
+	return BrowserInstruction{Type: BrowserScroll, X: x, Y: y}
+}
+
+// ScrollToBottom scrolls to the bottom of the page, for at most timeout.
+func ScrollToBottom(timeout time.Duration) BrowserInstruction {
+	return BrowserInstruction{Type: BrowserScrollToBottom, TimeoutS: seconds(timeout)}
+}
+
+// seconds returns d in whole seconds, rounded up.
+func seconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
+
+// ValidateBrowserInstructions checks that each instruction has the
+// fields its type requires.
+func ValidateBrowserInstructions(instructions []BrowserInstruction) error {
+	var errs []error
+	for i, instruction := range instructions {
+		if err := instruction.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid browser instruction %d: %v", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (i BrowserInstruction) validate() error {
+	if i.TimeoutS < 0 || i.WaitTimeS < 0 {
+		return fmt.Errorf("negative timeout_s or wait_time_s")
+	}
+
+	switch i.Type {
+	case BrowserClick, BrowserInput, BrowserWaitForElement:
+		if i.Selector == nil || i.Selector.Value == "" {
+			return fmt.Errorf("%s requires a selector", i.Type)
+		}
+		switch i.Selector.Type {
+		case SelectorXpath, SelectorCss, SelectorText:
+		default:
+			return fmt.Errorf("invalid selector type: %v", i.Selector.Type)
+		}
+		if i.Type == BrowserInput && i.Value == "" {
+			return fmt.Errorf("input requires a value")
+		}
+	case BrowserWait:
+		if i.WaitTimeS == 0 {
+			return fmt.Errorf("wait requires wait_time_s")
+		}
+	case BrowserScroll, BrowserScrollToBottom:
+	default:
+		return fmt.Errorf("invalid type: %v", i.Type)
+	}
+
+	return nil
+}
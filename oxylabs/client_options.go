@@ -0,0 +1,420 @@
+package oxylabs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/cache"
+	"github.com/oxylabs/oxylabs-sdk-go/jobstore"
+)
+
+// ErrClientClosed is returned by scrapes made after a client was closed or shut down.
+var ErrClientClosed = errors.New("client closed")
+
+// ErrInvalidCredentials is returned by Ping if the API rejects the credentials.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// ErrMissingCredentials is returned by the New constructors if the username
+// or password is empty and no credentials provider is set.
+var ErrMissingCredentials = errors.New("missing credentials")
+
+// ErrRespTooLarge is returned when a resp body exceeds the maximum set with WithMaxRespSize.
+var ErrRespTooLarge = errors.New("resp too large")
+
+// ClientConfig contains the configuration of the HTTP client used by the SDK clients.
+// Zero values leave the corresponding net/http defaults in place.
+type ClientConfig struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	DisableHTTP2        bool
+	ProxyURL            *url.URL
+	TLSConfig           *tls.Config
+	Certificates        []tls.Certificate
+	RootCAs             *x509.CertPool
+	Credentials         CredentialsProvider
+	AuditHook           AuditHook
+	Integration         Integration
+	PushPullFallback    time.Duration
+	Timeouts            Timeouts
+	Settings            Settings
+	JobStore            jobstore.Store
+	Defaults            Defaults
+	Cache               cache.Interface
+	CacheTTL            time.Duration
+	Deduplicate         bool
+	RetryFaultedJobs    int
+	VerifyCredentials   bool
+	Codec               Codec
+	MaxRespSize         int64
+	ConcurrentJobs      int
+	HedgeDelay          time.Duration
+	EventListener       EventListener
+	EchoPayload         bool
+	EchoFields          []string
+	QueryNormalization  QueryNormalization
+	PollCurves          map[Source]PollCurve
+	MaxPollDuration     time.Duration
+	PageParallelism     int
+	InsecureProxyTLS    bool
+}
+
+// Defaults contains the parameters applied to every req of a client
+// unless the req sets them itself. Parameters a source does not support are ignored.
+type Defaults struct {
+	GeoLocation GeoLocation
+	UserAgent   UserAgent
+	CallbackUrl string
+	Locale      Locale
+	Render      Render
+}
+
+// Settings contains the SDK defaults of a client.
+// Zero values keep the SDK defaults.
+type Settings struct {
+	// Timeout is the timeout of scrape methods without a context, 50s by default.
+	Timeout time.Duration
+	// PollInterval is the time between job status reqs of async clients.
+	// If set, jobs are polled at this constant interval instead of the
+	// poll curves of their sources, see PollCurveFor.
+	PollInterval time.Duration
+	// StartPage is the default start_page parameter, 1 by default.
+	StartPage int
+	// Pages is the default pages parameter, 1 by default.
+	Pages int
+	// Limit is the default limit parameter, 10 for SERP and 48 for
+	// E-Commerce sources by default.
+	Limit int
+}
+
+// Timeouts contains the timeouts of the phases of async scrapes.
+// Zero values leave the phase bounded only by the context of the scrape.
+type Timeouts struct {
+	// Submit is the timeout of the job submission req.
+	Submit time.Duration
+	// Poll is the max total duration of polling the job status.
+	Poll time.Duration
+	// Fetch is the timeout of downloading the results, including reading the resp body.
+	Fetch time.Duration
+}
+
+// QueryNormalization configures the normalization of the queries of search sources.
+// By default queries are trimmed, their whitespace collapsed and control characters
+// dropped, see query.Normalize, and queries which are not valid UTF-8 are rejected.
+type QueryNormalization struct {
+	// Disabled sends queries as given.
+	Disabled bool
+	// Lowercase lowercases queries, e.g. for more cache hits.
+	Lowercase bool
+}
+
+type ClientOption func(*ClientConfig)
+
+// WithMaxIdleConns sets the max number of idle connections across all hosts.
+func WithMaxIdleConns(n int) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.MaxIdleConns = n
+	}
+}
+
+// WithMaxIdleConnsPerHost sets the max number of idle connections kept per host.
+func WithMaxIdleConnsPerHost(n int) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.MaxIdleConnsPerHost = n
+	}
+}
+
+// WithMaxConnsPerHost sets the max number of connections per host,
+// including connections in the dialing, active, and idle states.
+func WithMaxConnsPerHost(n int) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.MaxConnsPerHost = n
+	}
+}
+
+// WithIdleConnTimeout sets the max amount of time an idle connection
+// will remain idle before closing itself.
+func WithIdleConnTimeout(timeout time.Duration) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.IdleConnTimeout = timeout
+	}
+}
+
+// WithDisableHTTP2 disables HTTP/2 and forces HTTP/1.1 for environments
+// where middleboxes break HTTP/2 connections.
+func WithDisableHTTP2() ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.DisableHTTP2 = true
+	}
+}
+
+// WithProxyURL routes the SDK's own reqs to the Oxylabs API through the given
+// HTTP, HTTPS or SOCKS5 proxy, e.g. a corporate egress proxy. By default the
+// proxy is read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY env vars.
+// The proxy endpoint integration, which is itself a proxy, cannot be used with it.
+func WithProxyURL(u *url.URL) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.ProxyURL = u
+	}
+}
+
+// WithTLSConfig sets the TLS config used for reqs to the Oxylabs API.
+// Certificates and root CAs set with WithClientCertificates and
+// WithRootCAs are applied on top of a copy of it.
+func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.TLSConfig = tlsConfig
+	}
+}
+
+// WithClientCertificates sets the client certificates presented for mTLS,
+// e.g. to a private gateway to the Oxylabs API.
+func WithClientCertificates(certs ...tls.Certificate) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Certificates = certs
+	}
+}
+
+// WithRootCAs sets the CAs used to verify the server certificates,
+// e.g. in environments with TLS interception.
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.RootCAs = pool
+	}
+}
+
+// LoadCABundle returns a cert pool with the system CAs and the PEM encoded
+// CAs of the bundle at path, for use with WithRootCAs.
+func LoadCABundle(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ca bundle: %v", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in ca bundle %s", path)
+	}
+
+	return pool, nil
+}
+
+// WithCredentialsProvider sets the provider of the API credentials, which
+// takes precedence over the username and password the client was created with.
+func WithCredentialsProvider(provider CredentialsProvider) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Credentials = provider
+	}
+}
+
+// WithAuditHook sets a hook called with the record of every req submitting
+// a job, e.g. to store an audit trail of all scrapes.
+func WithAuditHook(hook AuditHook) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.AuditHook = hook
+	}
+}
+
+// WithIntegration sets the integration method over which unified clients route scrapes.
+// Realtime is used by default.
+func WithIntegration(integration Integration) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Integration = integration
+	}
+}
+
+// WithPushPullFallback makes unified clients using the realtime integration fall
+// back to push-pull for long jobs which are not done within timeout, and for
+// scrapes which failed before they were sent. Fallback is opt-in, as a realtime
+// job which is not done within timeout may still run, and is then billed as well.
+func WithPushPullFallback(timeout time.Duration) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.PushPullFallback = timeout
+	}
+}
+
+// WithTimeouts sets separate timeouts for submitting async jobs, polling their
+// status and fetching their results. Scrape methods without a context are then
+// bounded by the sum of the phase timeouts instead of a single default timeout.
+func WithTimeouts(timeouts Timeouts) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Timeouts = timeouts
+	}
+}
+
+// WithSettings overrides the SDK defaults for the client.
+func WithSettings(settings Settings) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Settings = settings
+	}
+}
+
+// WithJobStore sets the store in which async clients persist submitted
+// job IDs so that polling can be resumed after a restart.
+func WithJobStore(store jobstore.Store) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.JobStore = store
+	}
+}
+
+// WithRetryFaultedJobs makes async clients resubmit a job which ends faulted
+// up to n times before returning the JobFaultError.
+// Jobs which faulted because of invalid parameters are not resubmitted.
+// A resubmission of a job with an idempotency key is made with the key
+// suffixed with "-retry-" and the number of the failed attempt.
+func WithRetryFaultedJobs(n int) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.RetryFaultedJobs = n
+	}
+}
+
+// WithDefaults sets the parameters applied to every req of the client.
+func WithDefaults(defaults Defaults) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Defaults = defaults
+	}
+}
+
+// WithCache sets the cache in which results are stored for ttl, so that identical
+// reqs within that window do not spend API credits. Realtime clients cache the
+// resp, async clients cache the job ID and fetch the results of the cached job.
+// Job IDs are cached for at most 24 hours, as long as the API keeps the results,
+// and are evicted once the job faults or is not found.
+func WithCache(c cache.Interface, ttl time.Duration) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Cache = c
+		cfg.CacheTTL = ttl
+	}
+}
+
+// WithDeduplication collapses identical reqs made concurrently by the client
+// into a single API req, or a single job for async clients. All callers get the
+// result of the shared req, which keeps the values of the first caller's context
+// but is only canceled once the context of every caller is done, so it runs until
+// the latest deadline of its callers.
+func WithDeduplication() ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Deduplicate = true
+	}
+}
+
+// WithVerifyCredentials makes the New constructors ping the API,
+// so that a client with rejected credentials is never returned.
+func WithVerifyCredentials() ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.VerifyCredentials = true
+	}
+}
+
+// WithCodec sets the JSON codec with which the client decodes resps.
+func WithCodec(codec Codec) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.Codec = codec
+	}
+}
+
+// WithMaxRespSize caps the size in bytes of the resp bodies the client reads,
+// after decompression. Larger resps fail with ErrRespTooLarge.
+func WithMaxRespSize(n int64) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.MaxRespSize = n
+	}
+}
+
+// WithPlanLimits gates the job submissions of async clients, so that at most
+// concurrentJobs jobs are in flight as allowed by the account plan. Excess
+// submissions are queued until a job finishes or its submitting context is done.
+// Clones share the limit with the client.
+func WithPlanLimits(concurrentJobs int) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.ConcurrentJobs = concurrentJobs
+	}
+}
+
+// WithHedging makes realtime clients send a second attempt of a req which has
+// not responded within delay, returning whichever resp arrives first, to tame
+// tail latency. Both attempts may be billed, so set delay to a high percentile
+// of the latency of your reqs, e.g. the p95.
+func WithHedging(delay time.Duration) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.HedgeDelay = delay
+	}
+}
+
+// WithEventListener sets the listener of the client events, e.g. job
+// submissions, polls and retries, for monitoring without parsing logs.
+func WithEventListener(listener EventListener) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.EventListener = listener
+	}
+}
+
+// WithPayloadEcho includes the submitted payload of a req in its Resp as Payload,
+// e.g. for auditing or replaying reqs. If fields are given, only those top-level
+// fields of the payload are included, e.g. "source" and "query".
+func WithPayloadEcho(fields ...string) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.EchoPayload = true
+		cfg.EchoFields = fields
+	}
+}
+
+// WithQueryNormalization configures the normalization of the queries of search
+// sources, e.g. to lowercase them, or to send them as given.
+func WithQueryNormalization(normalization QueryNormalization) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.QueryNormalization = normalization
+	}
+}
+
+// WithPollCurve sets the poll curve of the jobs of the source,
+// overriding its default, see PollCurveFor.
+func WithPollCurve(source Source, curve PollCurve) ClientOption {
+	return func(cfg *ClientConfig) {
+		// Copy the curves, so that clones don't share them.
+		curves := make(map[Source]PollCurve, len(cfg.PollCurves)+1)
+		for s, c := range cfg.PollCurves {
+			curves[s] = c
+		}
+		curves[source] = curve
+		cfg.PollCurves = curves
+	}
+}
+
+// WithMaxPollDuration sets the max duration a job is polled for, after which
+// polling gives up with a JobTimeoutError, regardless of the deadline of the
+// context of the scrape. Unlike the Poll timeout it replaces the default
+// timeout of scrapes without a deadline.
+func WithMaxPollDuration(d time.Duration) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.MaxPollDuration = d
+	}
+}
+
+// WithPageParallelism splits realtime reqs of multiple pages into single-page
+// reqs, made n at a time, whose results are reassembled in page order.
+// This cuts the time of deep SERP pulls, but each page is a separate job.
+func WithPageParallelism(n int) ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.PageParallelism = n
+	}
+}
+
+// WithInsecureProxyTLS skips the verification of the certificates of the targets
+// scraped over the proxy endpoint integration, which re-signs the TLS connections
+// to them. Without it, the certificate of the proxy endpoint must be trusted,
+// e.g. with WithRootCAs.
+func WithInsecureProxyTLS() ClientOption {
+	return func(cfg *ClientConfig) {
+		cfg.InsecureProxyTLS = true
+	}
+}
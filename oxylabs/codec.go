@@ -0,0 +1,22 @@
+package oxylabs
+
+import "encoding/json"
+
+// Codec decodes JSON. Clients decode resps with it, so that
+// encoding/json can be swapped for a faster implementation, e.g. jsoniter
+// or sonic, when decoding very large parsed resps.
+// Implementations must be safe for concurrent use and honor json struct tags,
+// json.RawMessage and the json.Unmarshaler implementations of the SDK types.
+// Resps decoded with a custom codec are not read into pooled buffers, so the
+// decoded values may reference the data passed to Unmarshal without copying.
+// Req payloads are small and are always encoded with encoding/json.
+type Codec interface {
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// StdCodec is the Codec backed by encoding/json, used by default.
+type StdCodec struct{}
+
+func (StdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
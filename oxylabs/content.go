@@ -0,0 +1,105 @@
+package oxylabs
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"unicode/utf8"
+)
+
+// ContentKind describes the kind of the content of a result.
+type ContentKind string
+
+const (
+	ContentHTML   ContentKind = "html"
+	ContentJSON   ContentKind = "json"
+	ContentPNG    ContentKind = "png"
+	ContentBinary ContentKind = "binary"
+)
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// minBinaryLen is the minimum length of base64 encoded binary content. Shorter
+// text, e.g. "abcd", also decodes as base64 into bytes which are not UTF-8.
+const minBinaryLen = 64
+
+// DetectContentKind returns the kind of raw, unparsed content.
+// Images, e.g. of PNG rendered pages, and other binary content are
+// returned base64 encoded by the API.
+func DetectContentKind(content string) ContentKind {
+	trimmed := bytes.TrimSpace([]byte(content))
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		return ContentJSON
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil || len(decoded) == 0 {
+		return ContentHTML
+	}
+	if bytes.HasPrefix(decoded, pngSignature) {
+		return ContentPNG
+	}
+	if len(content) >= minBinaryLen && isBinary(decoded) {
+		return ContentBinary
+	}
+
+	return ContentHTML
+}
+
+// isBinary reports whether the decoded bytes are binary rather than text:
+// they are not UTF-8 and contain control characters other than whitespace.
+func isBinary(decoded []byte) bool {
+	if utf8.Valid(decoded) {
+		return false
+	}
+
+	controls := 0
+	for _, b := range decoded {
+		if b < 0x20 && b != '\t' && b != '\n' && b != '\r' {
+			controls++
+		}
+	}
+
+	// Binary formats are dense with control bytes, e.g. zero padding,
+	// while text decoded by chance has some.
+	return controls*16 >= len(decoded)
+}
+
+// DecodeContent returns the bytes of raw content of the given kind,
+// decoding base64 encoded images and binary content.
+func DecodeContent(content string, kind ContentKind) ([]byte, error) {
+	switch kind {
+	case ContentPNG, ContentBinary:
+		return base64.StdEncoding.DecodeString(content)
+	default:
+		return []byte(content), nil
+	}
+}
+
+// binaryExtensions maps the sniffed media types of binary content to file extensions.
+var binaryExtensions = map[string]string{
+	"image/jpeg":      "jpg",
+	"image/gif":       "gif",
+	"image/webp":      "webp",
+	"image/bmp":       "bmp",
+	"image/x-icon":    "ico",
+	"application/pdf": "pdf",
+	"application/zip": "zip",
+}
+
+// ContentExtension returns the file extension of decoded content of the given kind.
+// The extension of binary content is sniffed from its bytes, and is bin if unknown.
+func ContentExtension(kind ContentKind, decoded []byte) string {
+	switch kind {
+	case ContentJSON, ContentPNG:
+		return string(kind)
+	case ContentBinary:
+		if ext, ok := binaryExtensions[http.DetectContentType(decoded)]; ok {
+			return ext
+		}
+		return "bin"
+	default:
+		return "html"
+	}
+}
@@ -0,0 +1,54 @@
+package oxylabs
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectContentKind(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), 0x00, 0x01)
+	binary := append([]byte("%PDF-1.7\n\xe2\xe3\xcf\xd3\n"), make([]byte, 64)...)
+	tests := []struct {
+		content string
+		want    ContentKind
+	}{
+		{content: "<html><body>adidas</body></html>", want: ContentHTML},
+		{content: `{"title": "adidas"}`, want: ContentJSON},
+		{content: base64.StdEncoding.EncodeToString(png), want: ContentPNG},
+		{content: base64.StdEncoding.EncodeToString(binary), want: ContentBinary},
+		{content: "", want: ContentHTML},
+		// Short text is valid base64 too.
+		{content: "abcd", want: ContentHTML},
+		{content: "Adidas", want: ContentHTML},
+		{content: base64.StdEncoding.EncodeToString([]byte{0xff, 0xfe, 0x00}), want: ContentHTML},
+		{content: "OutOfStockOutOfStockOutOfStockOutOfStockOutOfStockOutOfStockOutO", want: ContentHTML},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, DetectContentKind(tt.content), tt.content)
+	}
+
+	decoded, err := DecodeContent(base64.StdEncoding.EncodeToString(png), ContentPNG)
+	assert.NoError(t, err)
+	assert.Equal(t, png, decoded)
+}
+
+func TestContentExtension(t *testing.T) {
+	jpeg := append([]byte("\xff\xd8\xff\xe0"), make([]byte, 64)...)
+	tests := []struct {
+		kind    ContentKind
+		decoded []byte
+		want    string
+	}{
+		{kind: ContentHTML, decoded: []byte("<html></html>"), want: "html"},
+		{kind: ContentJSON, decoded: []byte(`{}`), want: "json"},
+		{kind: ContentPNG, decoded: []byte("\x89PNG\r\n\x1a\n"), want: "png"},
+		{kind: ContentBinary, decoded: jpeg, want: "jpg"},
+		{kind: ContentBinary, decoded: []byte("%PDF-1.7\n"), want: "pdf"},
+		{kind: ContentBinary, decoded: make([]byte, 64), want: "bin"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ContentExtension(tt.kind, tt.decoded), tt.want)
+	}
+}
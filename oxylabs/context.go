@@ -12,6 +12,9 @@ type KeyValue struct {
 	Value string `json:"value"`
 }
 
+// Cookie is a cookie sent to the target with the req.
+type Cookie = KeyValue
+
 // LimitPerPage sets the limits_per_page context option.
 func LimitPerPage(limits []PageLimit) func(ContextOption) {
 	return func(ctx ContextOption) {
@@ -200,3 +203,25 @@ func AutoselectVariant(variant bool) func(ContextOption) {
 		ctx["autoselect_variant"] = variant
 	}
 }
+
+// DeliveryZip sets the delivery_zip context option, the zip or postal code
+// of the delivery location which prices and the buybox depend on.
+func DeliveryZip(zip string) func(ContextOption) {
+	return func(ctx ContextOption) {
+		ctx["delivery_zip"] = zip
+	}
+}
+
+// Currency sets the currency context option, an ISO 4217 code.
+func Currency(currency string) func(ContextOption) {
+	return func(ctx ContextOption) {
+		ctx["currency"] = currency
+	}
+}
+
+// Language sets the language context option, an ISO 639-1 code, e.g. es or es_US.
+func Language(language string) func(ContextOption) {
+	return func(ctx ContextOption) {
+		ctx["language"] = language
+	}
+}
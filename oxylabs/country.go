@@ -0,0 +1,130 @@
+package oxylabs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// countryCodes contains the ISO 3166-1 alpha-2 country codes.
+var countryCodes = toSet([]string{
+	"AD", "AE", "AF", "AG", "AI", "AL", "AM", "AO", "AQ", "AR", "AS", "AT",
+	"AU", "AW", "AX", "AZ", "BA", "BB", "BD", "BE", "BF", "BG", "BH", "BI",
+	"BJ", "BL", "BM", "BN", "BO", "BQ", "BR", "BS", "BT", "BV", "BW", "BY",
+	"BZ", "CA", "CC", "CD", "CF", "CG", "CH", "CI", "CK", "CL", "CM", "CN",
+	"CO", "CR", "CU", "CV", "CW", "CX", "CY", "CZ", "DE", "DJ", "DK", "DM",
+	"DO", "DZ", "EC", "EE", "EG", "EH", "ER", "ES", "ET", "FI", "FJ", "FK",
+	"FM", "FO", "FR", "GA", "GB", "GD", "GE", "GF", "GG", "GH", "GI", "GL",
+	"GM", "GN", "GP", "GQ", "GR", "GS", "GT", "GU", "GW", "GY", "HK", "HM",
+	"HN", "HR", "HT", "HU", "ID", "IE", "IL", "IM", "IN", "IO", "IQ", "IR",
+	"IS", "IT", "JE", "JM", "JO", "JP", "KE", "KG", "KH", "KI", "KM", "KN",
+	"KP", "KR", "KW", "KY", "KZ", "LA", "LB", "LC", "LI", "LK", "LR", "LS",
+	"LT", "LU", "LV", "LY", "MA", "MC", "MD", "ME", "MF", "MG", "MH", "MK",
+	"ML", "MM", "MN", "MO", "MP", "MQ", "MR", "MS", "MT", "MU", "MV", "MW",
+	"MX", "MY", "MZ", "NA", "NC", "NE", "NF", "NG", "NI", "NL", "NO", "NP",
+	"NR", "NU", "NZ", "OM", "PA", "PE", "PF", "PG", "PH", "PK", "PL", "PM",
+	"PN", "PR", "PS", "PT", "PW", "PY", "QA", "RE", "RO", "RS", "RU", "RW",
+	"SA", "SB", "SC", "SD", "SE", "SG", "SH", "SI", "SJ", "SK", "SL", "SM",
+	"SN", "SO", "SR", "SS", "ST", "SV", "SX", "SY", "SZ", "TC", "TD", "TF",
+	"TG", "TH", "TJ", "TK", "TL", "TM", "TN", "TO", "TR", "TT", "TV", "TW",
+	"TZ", "UA", "UG", "UM", "US", "UY", "UZ", "VA", "VC", "VE", "VG", "VI",
+	"VN", "VU", "WF", "WS", "YE", "YT", "ZA", "ZM", "ZW",
+})
+
+func toSet(list []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(list))
+	for _, item := range list {
+		set[item] = struct{}{}
+	}
+
+	return set
+}
+
+// IsCountryCodeValid checks if code is an ISO 3166-1 alpha-2 country code.
+// The check is case-insensitive.
+func IsCountryCodeValid(code string) bool {
+	_, ok := countryCodes[strings.ToUpper(code)]
+	return ok
+}
+
+// GeoLocation is the geo_location parameter. The constructors of the geo
+// package build the documented formats, and any string can be converted
+// to a GeoLocation for formats they do not cover.
+type GeoLocation string
+
+// IsGeoLocationValid checks the geo_location parameter.
+// Sources accept different geo_location formats (country names, cities,
+// zip codes), so only values which look like a two letter country code
+// are checked against the ISO 3166-1 alpha-2 list, and coordinates
+// against their valid ranges.
+func IsGeoLocationValid(geoLocation GeoLocation) bool {
+	s := strings.TrimSpace(string(geoLocation))
+	if s == "" {
+		return false
+	}
+
+	if len(s) == 2 && isLetters(s) {
+		return IsCountryCodeValid(s)
+	}
+
+	if strings.HasPrefix(s, "lat:") {
+		return areCoordsValid(s)
+	}
+
+	return true
+}
+
+// areCoordsValid checks a "lat: 47.6205, lng: -122.3493, rad: 25000" geo location.
+func areCoordsValid(s string) bool {
+	var lat, lng float64
+	var rad int
+	if _, err := fmt.Sscanf(s, "lat: %g, lng: %g, rad: %d", &lat, &lng, &rad); err != nil {
+		return false
+	}
+
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180 && rad > 0
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsZipCodeValid checks if zip is a US zip code, e.g. 10001 or 10001-1234.
+func IsZipCodeValid(zip string) bool {
+	code, ext, hasExt := strings.Cut(zip, "-")
+	if len(code) != 5 || !isDigits(code) {
+		return false
+	}
+
+	return !hasExt || (len(ext) == 4 && isDigits(ext))
+}
+
+// IsPostalCodeValid checks if code looks like a postal code of any country,
+// e.g. SW1A 1AA or 75008.
+func IsPostalCodeValid(code string) bool {
+	if len(code) < 3 || len(code) > 10 || strings.TrimSpace(code) != code {
+		return false
+	}
+	for _, r := range code {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && r != ' ' && r != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
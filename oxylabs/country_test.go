@@ -0,0 +1,50 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsGeoLocationValid(t *testing.T) {
+	tests := []struct {
+		geoLocation GeoLocation
+		want        bool
+	}{
+		{geoLocation: "US", want: true},
+		{geoLocation: "de", want: true},
+		{geoLocation: "UK", want: false},
+		{geoLocation: "XX", want: false},
+		{geoLocation: "United States", want: true},
+		{geoLocation: "London,England,United Kingdom", want: true},
+		{geoLocation: "90210", want: true},
+		{geoLocation: " ", want: false},
+		{geoLocation: "lat: 47.6205, lng: -122.3493, rad: 25000", want: true},
+		{geoLocation: "lat: 91, lng: 0, rad: 25000", want: false},
+		{geoLocation: "lat: 47.6205, lng: -122.3493", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.geoLocation), func(t *testing.T) {
+			assert.Equal(t, tt.want, IsGeoLocationValid(tt.geoLocation))
+		})
+	}
+}
+
+func TestIsZipCodeValid(t *testing.T) {
+	tests := []struct {
+		zip  string
+		want bool
+	}{
+		{zip: "10001", want: true},
+		{zip: "10001-1234", want: true},
+		{zip: "1000", want: false},
+		{zip: "10001-12", want: false},
+		{zip: "ABCDE", want: false},
+		{zip: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.zip, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsZipCodeValid(tt.zip))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package oxylabs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// CredentialsProvider provides the API credentials for each req, e.g. from
+// a secret manager, so that credentials can be rotated without recreating clients.
+// Implementations must be safe for concurrent use and should cache credentials,
+// as they are requested for every req.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (username string, password string, err error)
+}
+
+// CredentialsProviderFunc adapts a function to a CredentialsProvider.
+type CredentialsProviderFunc func(ctx context.Context) (string, string, error)
+
+// Credentials calls f(ctx).
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (string, string, error) {
+	return f(ctx)
+}
+
+// PooledCredentials are the credentials of an account of a CredentialsPool.
+// Weight is the share of reqs sent with them relative to the other
+// accounts of the pool, 1 if not set.
+type PooledCredentials struct {
+	Username string
+	Password string
+	Weight   int
+}
+
+// CredentialsPool is a CredentialsProvider spreading reqs across several
+// accounts, e.g. sub-accounts isolating quotas. Accounts are picked in
+// weighted round-robin order, or plain round-robin order if no weights are set.
+// The status and results reqs of an async job use the account it was submitted with.
+type CredentialsPool struct {
+	mu       sync.Mutex
+	accounts []PooledCredentials
+	current  []int
+	total    int
+}
+
+// NewCredentialsPool returns a pool of the given accounts.
+func NewCredentialsPool(accounts ...PooledCredentials) (*CredentialsPool, error) {
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("credentials pool has no accounts")
+	}
+
+	p := &CredentialsPool{
+		accounts: make([]PooledCredentials, len(accounts)),
+		current:  make([]int, len(accounts)),
+	}
+	for i, account := range accounts {
+		if account.Username == "" || account.Password == "" {
+			return nil, fmt.Errorf("account %d of credentials pool: %w", i, ErrMissingCredentials)
+		}
+		if account.Weight < 0 {
+			return nil, fmt.Errorf("account %d of credentials pool has a negative weight", i)
+		}
+		if account.Weight == 0 {
+			account.Weight = 1
+		}
+		p.accounts[i] = account
+		p.total += account.Weight
+	}
+
+	return p, nil
+}
+
+// Credentials returns the credentials of the next account. Accounts are picked
+// with smooth weighted round-robin, so reqs to an account are spread evenly.
+func (p *CredentialsPool) Credentials(ctx context.Context) (string, string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	next := 0
+	for i, account := range p.accounts {
+		p.current[i] += account.Weight
+		if p.current[i] > p.current[next] {
+			next = i
+		}
+	}
+	p.current[next] -= p.total
+
+	return p.accounts[next].Username, p.accounts[next].Password, nil
+}
+
+// Account returns the account of the pool with username, e.g. to resume
+// a job with the account it was submitted with.
+func (p *CredentialsPool) Account(username string) (PooledCredentials, bool) {
+	for _, account := range p.accounts {
+		if account.Username == username {
+			return account, true
+		}
+	}
+
+	return PooledCredentials{}, false
+}
+
+type reqCredentialsCtxKey struct{}
+
+type reqCredentials struct {
+	username string
+	password string
+}
+
+// WithReqCredentials returns a copy of ctx carrying API credentials which override
+// the credentials of the client for the reqs made with ctx, e.g. to scrape on behalf
+// of a customer with their own account. The status and results reqs of an async
+// job must be made with the same credentials, which ctx ensures when passed to
+// the Ctx methods.
+func WithReqCredentials(ctx context.Context, username string, password string) context.Context {
+	return context.WithValue(ctx, reqCredentialsCtxKey{}, reqCredentials{username: username, password: password})
+}
+
+// ReqCredentials returns the credentials of ctx set with WithReqCredentials, if any.
+func ReqCredentials(ctx context.Context) (username string, password string, ok bool) {
+	credentials, ok := ctx.Value(reqCredentialsCtxKey{}).(reqCredentials)
+
+	return credentials.username, credentials.password, ok
+}
@@ -0,0 +1,27 @@
+package oxylabs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialsPool_Weighted(t *testing.T) {
+	pool, err := NewCredentialsPool(
+		PooledCredentials{Username: "a", Password: "pass", Weight: 3},
+		PooledCredentials{Username: "b", Password: "pass", Weight: 1},
+	)
+	assert.NoError(t, err)
+
+	var usernames []string
+	for i := 0; i < 8; i++ {
+		username, _, err := pool.Credentials(context.Background())
+		assert.NoError(t, err)
+		usernames = append(usernames, username)
+	}
+	assert.Equal(t, []string{"a", "a", "b", "a", "a", "a", "b", "a"}, usernames)
+
+	_, err = NewCredentialsPool(PooledCredentials{Username: "a"})
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+}
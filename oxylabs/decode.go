@@ -0,0 +1,25 @@
+package oxylabs
+
+// DecodeStrategy selects how the content of the results of a job is decoded.
+type DecodeStrategy string
+
+const (
+	// DecodeRaw keeps the content as a string, e.g. raw HTML.
+	DecodeRaw DecodeStrategy = "raw"
+	// DecodeParsed decodes the JSON content of the built-in parsers.
+	DecodeParsed DecodeStrategy = "parsed"
+	// DecodeCustomParsed decodes the JSON content of custom parsing instructions.
+	DecodeCustomParsed DecodeStrategy = "custom_parsed"
+)
+
+// DecodeStrategyFor returns the decode strategy matching the parse options of a req.
+func DecodeStrategyFor(parse bool, customParser bool) DecodeStrategy {
+	switch {
+	case customParser:
+		return DecodeCustomParsed
+	case parse:
+		return DecodeParsed
+	default:
+		return DecodeRaw
+	}
+}
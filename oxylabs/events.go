@@ -0,0 +1,80 @@
+package oxylabs
+
+import "time"
+
+// Event is an event of a client: *JobSubmittedEvent, *JobPolledEvent, *JobCompletedEvent,
+// *JobFaultedEvent, *RequestRetriedEvent or *JobStoreFailedEvent.
+type Event interface {
+	event()
+}
+
+// JobSubmittedEvent is emitted when a job is submitted, including resubmissions.
+type JobSubmittedEvent struct {
+	Time   time.Time
+	JobID  string
+	Source string
+}
+
+// JobPolledEvent is emitted with the status of a job on every poll of its status.
+type JobPolledEvent struct {
+	Time   time.Time
+	JobID  string
+	Status JobStatus
+}
+
+// JobCompletedEvent is emitted when a job is done and its results are about to be fetched.
+type JobCompletedEvent struct {
+	Time  time.Time
+	JobID string
+	// Waited is the time spent polling the status of the job.
+	Waited time.Duration
+}
+
+// JobFaultedEvent is emitted when a job ends faulted.
+type JobFaultedEvent struct {
+	Time time.Time
+	Err  *JobFaultError
+}
+
+// RequestRetriedEvent is emitted when a req is sent again: a faulted job is
+// resubmitted or a hedged req sends its second attempt.
+type RequestRetriedEvent struct {
+	Time time.Time
+	Url  string
+	// JobID is the ID of the faulted job which is resubmitted, if any.
+	JobID string
+	// Attempt is the number of the new attempt, starting at 2.
+	Attempt int
+	Reason  string
+}
+
+// JobStoreFailedEvent is emitted when the job store fails to save a submitted job
+// or to release an idempotency key. The job is not failed for it, as it is already running.
+type JobStoreFailedEvent struct {
+	Time           time.Time
+	JobID          string
+	IdempotencyKey string
+	Err            error
+}
+
+func (*JobSubmittedEvent) event()   {}
+func (*JobPolledEvent) event()      {}
+func (*JobCompletedEvent) event()   {}
+func (*JobFaultedEvent) event()     {}
+func (*RequestRetriedEvent) event() {}
+func (*JobStoreFailedEvent) event() {}
+
+// EventListener receives the events of a client, e.g. for custom monitoring.
+// OnEvent is called synchronously, so it should return quickly. It must be
+// safe for concurrent use.
+type EventListener interface {
+	OnEvent(event Event)
+}
+
+// EventListenerFunc adapts a function to an EventListener.
+type EventListenerFunc func(event Event)
+
+// OnEvent calls f(event).
+func (f EventListenerFunc) OnEvent(event Event) {
+	f(event)
+}
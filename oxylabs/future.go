@@ -0,0 +1,91 @@
+package oxylabs
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoResult is returned by Future.Get if the result channel was closed without a result.
+var ErrNoResult = errors.New("no result received")
+
+// Future is the result of a scrape, common to the realtime and push-pull
+// integration methods, so that code can be written once for both.
+// It is safe for concurrent use and Get may be called any number of times.
+type Future[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// ResolvedFuture returns a Future of an already available result,
+// e.g. of a realtime scrape.
+func ResolvedFuture[T any](val T, err error) *Future[T] {
+	f := &Future[T]{done: make(chan struct{}), val: val, err: err}
+	close(f.done)
+
+	return f
+}
+
+// ChanFuture returns a Future of the result received from ch,
+// e.g. of a push-pull scrape. If err is not nil the Future resolves to it.
+func ChanFuture[T any](ch <-chan T, err error) *Future[T] {
+	if err != nil {
+		var zero T
+		return ResolvedFuture(zero, err)
+	}
+
+	f := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+
+		val, ok := <-ch
+		if !ok {
+			f.err = ErrNoResult
+			return
+		}
+		f.val = val
+	}()
+
+	return f
+}
+
+// AsyncFuture returns a Future of the resp of res, e.g. of a push-pull scrape.
+// If err is not nil the Future resolves to it.
+func AsyncFuture[T any](res *AsyncResult[T], err error) *Future[T] {
+	if err != nil {
+		var zero T
+		return ResolvedFuture(zero, err)
+	}
+
+	f := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+
+		val, ok := <-res.Results()
+		if !ok {
+			if f.err = res.Err(); f.err == nil {
+				f.err = ErrNoResult
+			}
+			return
+		}
+		f.val = val
+	}()
+
+	return f
+}
+
+// Get waits for the result until it is available or ctx is done.
+func (f *Future[T]) Get(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.val, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Done returns a channel which is closed once the result is available.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
@@ -0,0 +1,59 @@
+package oxylabs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChanFuture(t *testing.T) {
+	ch := make(chan string, 1)
+	f := ChanFuture(ch, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err := f.Get(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	ch <- "resp"
+	close(ch)
+	for i := 0; i < 2; i++ {
+		v, err := f.Get(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "resp", v)
+	}
+
+	_, err = ChanFuture[string](nil, errors.New("invalid")).Get(context.Background())
+	assert.EqualError(t, err, "invalid")
+}
+
+func TestAsyncFuture(t *testing.T) {
+	res := RunAsync(context.Background(), func(ctx context.Context) (string, error) {
+		return "resp", nil
+	})
+	v, err := AsyncFuture(res, nil).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "resp", v)
+
+	res = RunAsync(context.Background(), func(ctx context.Context) (string, error) {
+		return "", errors.New("faulted")
+	})
+	_, err = AsyncFuture(res, nil).Get(context.Background())
+	assert.EqualError(t, err, "faulted")
+}
+
+func TestRunAsync_DoesNotBlock(t *testing.T) {
+	res := RunAsync(context.Background(), func(ctx context.Context) (string, error) {
+		return "resp", nil
+	})
+
+	// The scrape finishes before its resp is received.
+	<-res.Done()
+	assert.NoError(t, res.Err())
+	assert.Equal(t, "resp", <-res.Results())
+	_, ok := <-res.Results()
+	assert.False(t, ok)
+}
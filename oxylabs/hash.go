@@ -0,0 +1,28 @@
+package oxylabs
+
+import "github.com/oxylabs/oxylabs-sdk-go/internal/payloads"
+
+// PayloadHash returns a stable hash of the payload of a scrape of source with
+// the query, url or product id and opts, e.g. *serp.GoogleSearchOpts or nil.
+// The payload is built as a client without client defaults or settings
+// would send it, so the package of the source, serp or ecommerce, must be
+// imported, otherwise the source is reported as unsupported.
+//
+// The hash is the one HashPayload returns for the payload, so it can key user
+// storage. The cache and deduplication keys of a client are derived from it
+// but also scoped by endpoint and account, so they differ from the hash.
+func PayloadHash(source Source, query string, opts interface{}) (string, error) {
+	payload, err := payloads.Build(string(source), query, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return HashPayload(payload)
+}
+
+// HashPayload returns a stable hash of the JSON payload, e.g. the payload
+// echoed on a resp, ignoring the order of its keys and whitespace.
+// It does not depend on the package of the source being imported.
+func HashPayload(jsonPayload []byte) (string, error) {
+	return payloads.Key(jsonPayload)
+}
@@ -0,0 +1,19 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashPayload(t *testing.T) {
+	a, err := HashPayload([]byte(`{"source": "google_search", "query": "adidas"}`))
+	assert.NoError(t, err)
+	b, err := HashPayload([]byte(`{"query":"adidas","source":"google_search"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, a, b)
+
+	// The serp package which registers the source is not imported.
+	_, err = PayloadHash(GoogleSearch, "adidas", nil)
+	assert.EqualError(t, err, "unsupported source: google_search, or its package is not imported")
+}
@@ -0,0 +1,50 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TargetHeaders are the resp headers of the scraped target page.
+// Repeated headers, e.g. Set-Cookie, keep each of their values.
+type TargetHeaders http.Header
+
+// UnmarshalJSON accepts header values as strings or lists of strings.
+func (h *TargetHeaders) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	headers := make(http.Header, len(raw))
+	for key, value := range raw {
+		switch value := value.(type) {
+		case []interface{}:
+			for _, v := range value {
+				headers.Add(key, fmt.Sprint(v))
+			}
+		case nil:
+		default:
+			headers.Add(key, fmt.Sprint(value))
+		}
+	}
+	*h = TargetHeaders(headers)
+
+	return nil
+}
+
+// Get returns the first value of the header, matching its name case-insensitively.
+func (h TargetHeaders) Get(key string) string {
+	return http.Header(h).Get(key)
+}
+
+// Values returns all values of the header, matching its name case-insensitively.
+func (h TargetHeaders) Values(key string) []string {
+	return http.Header(h).Values(key)
+}
+
+// Header returns the headers as an http.Header.
+func (h TargetHeaders) Header() http.Header {
+	return http.Header(h)
+}
@@ -0,0 +1,30 @@
+package oxylabs
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSubmissionUnknown is returned for an idempotency key whose job submission
+// failed without a resp, so it is unknown whether the job was created.
+var ErrSubmissionUnknown = errors.New("outcome of job submission unknown")
+
+type idempotencyKeyCtxKey struct{}
+
+// WithIdempotencyKey returns a copy of ctx carrying a client-generated idempotency key
+// for an async job submission. A retried submission with the same key reuses the job
+// tracked under it in the job store instead of creating a duplicate job.
+// The key is recorded in the job store before the job is submitted and is sent
+// to the API in the Idempotency-Key header. If a submission fails without a resp,
+// retries with the key return ErrSubmissionUnknown until the key is released with
+// jobstore.KeyFinder.ReleaseKey. It requires a job store, see WithJobStore.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+// IdempotencyKey returns the idempotency key of ctx, if any.
+func IdempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyCtxKey{}).(string)
+
+	return key
+}
@@ -0,0 +1,13 @@
+package oxylabs
+
+// Integration is the integration method used by unified clients.
+type Integration string
+
+const (
+	// Realtime keeps the connection open until the job is done.
+	Realtime Integration = "realtime"
+	// PushPull submits a job and polls for its results.
+	PushPull Integration = "push_pull"
+	// ProxyEndpoint sends reqs via the proxy endpoint. Only url sources support it.
+	ProxyEndpoint Integration = "proxy_endpoint"
+)
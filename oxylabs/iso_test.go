@@ -0,0 +1,34 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCurrencyValid(t *testing.T) {
+	assert.True(t, IsCurrencyValid("EUR"))
+	assert.True(t, IsCurrencyValid("usd"))
+	assert.False(t, IsCurrencyValid("EURO"))
+	assert.False(t, IsCurrencyValid("XXX"))
+}
+
+func TestIsLanguageValid(t *testing.T) {
+	tests := []struct {
+		language string
+		want     bool
+	}{
+		{language: "es", want: true},
+		{language: "es_US", want: true},
+		{language: "pt-BR", want: true},
+		{language: "english", want: false},
+		{language: "es_XX", want: false},
+		{language: "es_", want: false},
+		{language: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsLanguageValid(tt.language))
+		})
+	}
+}
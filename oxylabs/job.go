@@ -0,0 +1,87 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// JobStatus is the status of an async job.
+type JobStatus string
+
+const (
+	JobPending JobStatus = "pending"
+	JobDone    JobStatus = "done"
+	JobFaulted JobStatus = "faulted"
+)
+
+// Finished reports whether the job has finished, successfully or not.
+func (s JobStatus) Finished() bool {
+	return s == JobDone || s == JobFaulted
+}
+
+// ErrJobTimeout is matched by the JobTimeoutError returned when polling a job
+// exceeds the duration set with WithMaxPollDuration.
+var ErrJobTimeout = errors.New("job timeout")
+
+// JobTimeoutError is returned when polling a job exceeds the duration set with
+// WithMaxPollDuration. The job may still finish, so it can be resumed by its ID.
+type JobTimeoutError struct {
+	JobID string
+	// MaxPollDuration is the duration the job was polled for.
+	MaxPollDuration time.Duration
+}
+
+func (e *JobTimeoutError) Error() string {
+	return fmt.Sprintf("job %s not done after polling for %s", e.JobID, e.MaxPollDuration)
+}
+
+// Is reports whether target is ErrJobTimeout.
+func (e *JobTimeoutError) Is(target error) bool {
+	return target == ErrJobTimeout
+}
+
+// JobFaultError is returned when an async job ends faulted.
+// It carries the failure details reported by the API, so that e.g. blocks
+// by the target can be told apart from invalid parameters.
+type JobFaultError struct {
+	JobID  string
+	Source string
+	// StatusCode is the status code of the failed result, if the API reported one.
+	StatusCode int
+	// Reason is the failure reason reported by the API, if any.
+	Reason string
+	// Job is the job object returned by the job endpoint.
+	Job json.RawMessage
+}
+
+func (e *JobFaultError) Error() string {
+	msg := fmt.Sprintf("there was an error processing your query: job %s faulted", e.JobID)
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf(" with status code %d", e.StatusCode)
+	}
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+
+	return msg
+}
+
+// Blocked reports whether the job failed because the target blocked or
+// rate limited the scrape, in which case a retry may succeed.
+func (e *JobFaultError) Blocked() bool {
+	switch e.StatusCode {
+	case http.StatusForbidden, http.StatusTooManyRequests, 613:
+		return true
+	default:
+		return false
+	}
+}
+
+// BadParameters reports whether the job failed because of invalid parameters,
+// in which case a retry will not succeed.
+func (e *JobFaultError) BadParameters() bool {
+	return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+}
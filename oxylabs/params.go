@@ -0,0 +1,239 @@
+package oxylabs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SourceParams lists the payload parameters and the context keys a source supports.
+// MaxLimit is the maximum of the limit parameter, if the source supports it.
+type SourceParams struct {
+	Params   []string
+	Context  []string
+	MaxLimit int
+}
+
+// Parameters accepted by all sources.
+var commonParams = []string{"source", "user_agent_type", "callback_url"}
+
+// Parameters of url and query sources.
+var (
+	urlParams    = []string{"url", "geo_location", "render", "parse", "parsing_instructions"}
+	searchParams = []string{"domain", "query", "start_page", "pages", "locale", "geo_location", "render", "parse", "parsing_instructions"}
+)
+
+// sourceParams is the per-source parameter compatibility matrix.
+var sourceParams = map[Source]SourceParams{
+	GoogleUrl: {Params: urlParams},
+	GoogleSearch: {
+		Params:   append(searchParams, "limit", "limit_per_page"),
+		Context:  []string{"results_language", "filter", "nfpr", "safe_search", "fpstate", "tbm", "tbs", "limit_per_page"},
+		MaxLimit: 100,
+	},
+	GoogleAds: {
+		Params:  searchParams,
+		Context: []string{"results_language", "nfpr", "tbm", "tbs"},
+	},
+	GoogleSuggestions: {
+		Params: []string{"query", "locale", "geo_location", "render", "parse", "parsing_instructions"},
+	},
+	GoogleHotels: {
+		Params:   append(searchParams, "limit"),
+		Context:  []string{"results_language", "nfpr", "hotel_occupancy", "hotel_dates"},
+		MaxLimit: 100,
+	},
+	GoogleTravelHotels: {
+		Params:  searchParams,
+		Context: []string{"hotel_occupancy", "hotel_classes", "hotel_dates"},
+	},
+	GoogleImages: {
+		Params:  searchParams,
+		Context: []string{"nfpr", "results_language"},
+	},
+	GoogleTrendsExplore: {
+		Params:  []string{"query", "geo_location", "parse", "parsing_instructions"},
+		Context: []string{"search_type", "date_from", "date_to", "category_id"},
+	},
+
+	BingUrl:    {Params: urlParams},
+	BingSearch: {Params: append(searchParams, "limit"), MaxLimit: 50},
+
+	GoogleShoppingUrl: {Params: urlParams},
+	GoogleShoppingSearch: {
+		Params:  append(searchParams, "results_language"),
+		Context: []string{"nfpr", "sort_by", "min_price", "max_price"},
+	},
+	GoogleShoppingProduct: {Params: append(searchParams, "results_language")},
+	GoogleShoppingPricing: {Params: append(searchParams, "results_language")},
+
+	Wayfair:       {Params: []string{"url", "parse", "parsing_instructions"}},
+	WayfairSearch: {Params: []string{"query", "start_page", "pages", "limit", "parse", "parsing_instructions"}, MaxLimit: 96},
+
+	Universal: {
+		Params:  []string{"url", "geo_location", "locale", "render", "browser_instructions", "js", "content_encoding", "parse", "parser_type", "parsing_instructions"},
+		Context: []string{"content", "cookies", "follow_redirects", "headers", "http_method", "session_id", "successful_status_codes"},
+	},
+
+	Target:        {Params: []string{"url", "parse", "parsing_instructions"}},
+	TargetSearch:  {Params: []string{"query", "start_page", "pages", "parse", "parsing_instructions"}},
+	TargetProduct: {Params: []string{"query", "parse", "parsing_instructions"}},
+
+	EtsySearch:  {Params: []string{"query", "start_page", "pages", "parse", "parsing_instructions"}},
+	EtsyProduct: {Params: []string{"query", "parse", "parsing_instructions"}},
+
+	Kroger:        {Params: []string{"url", "store_id", "delivery_zip", "fulfillment_type", "parse", "parsing_instructions"}},
+	KrogerSearch:  {Params: []string{"query", "start_page", "pages", "store_id", "delivery_zip", "fulfillment_type", "parse", "parsing_instructions"}},
+	KrogerProduct: {Params: []string{"query", "store_id", "delivery_zip", "fulfillment_type", "parse", "parsing_instructions"}},
+
+	AmazonUrl: {Params: []string{"url", "render", "parse", "parsing_instructions"}},
+	AmazonSearch: {
+		Params:  []string{"domain", "query", "start_page", "pages", "geo_location", "render", "parse", "parsing_instructions"},
+		Context: []string{"category_id", "merchant_id", "delivery_zip", "currency", "language"},
+	},
+	AmazonProduct: {
+		Params:  []string{"domain", "query", "geo_location", "render", "parse", "parsing_instructions"},
+		Context: []string{"autoselect_variant", "delivery_zip", "currency", "language"},
+	},
+	AmazonPricing: {
+		Params:  []string{"domain", "query", "start_page", "pages", "geo_location", "render", "parse", "parsing_instructions"},
+		Context: []string{"delivery_zip", "currency", "language"},
+	},
+	AmazonReviews:   {Params: []string{"domain", "query", "start_page", "pages", "geo_location", "render", "parse", "parsing_instructions"}},
+	AmazonQuestions: {Params: []string{"domain", "query", "geo_location", "render", "parse", "parsing_instructions"}},
+	AmazonBestsellers: {
+		Params:  []string{"domain", "query", "start_page", "pages", "geo_location", "render", "parse", "parsing_instructions"},
+		Context: []string{"category_id", "currency", "language"},
+	},
+	AmazonSellers: {Params: []string{"domain", "query", "geo_location", "render", "parse", "parsing_instructions"}},
+}
+
+// ParamsFor returns the parameters supported by the source.
+// The second return value is false for sources the matrix does not know.
+func ParamsFor(source Source) (SourceParams, bool) {
+	params, ok := sourceParams[source]
+	if !ok {
+		return SourceParams{}, false
+	}
+
+	return SourceParams{
+		Params:   append(append([]string{}, commonParams...), params.Params...),
+		Context:  append([]string{}, params.Context...),
+		MaxLimit: params.MaxLimit,
+	}, true
+}
+
+// CheckSourceParams checks that every parameter set in the payload, including
+// the context keys, is supported by its source, and that the limit is within
+// the maxima of the source, so that invalid payloads are rejected before the
+// req is made. Parameters set to their zero value are ignored, as are unknown sources.
+func CheckSourceParams(payload map[string]interface{}) error {
+	source := Source(fmt.Sprint(payload["source"]))
+	params, ok := ParamsFor(source)
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	for _, key := range sortedKeys(payload) {
+		value := payload[key]
+		if key == "context" || isZero(value) || contains(params.Params, key) {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("parameter %s is not supported by source %s", key, source))
+	}
+
+	for _, entry := range contextEntries(payload["context"]) {
+		key, _ := entry["key"].(string)
+		if isZero(entry["value"]) || contains(params.Context, key) {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("context parameter %s is not supported by source %s", key, source))
+	}
+
+	if err := checkLimit(source, params.MaxLimit, payload); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkLimit checks the limit against the maximum of the source.
+func checkLimit(source Source, maxLimit int, payload map[string]interface{}) error {
+	limit, _ := toInt(payload["limit"])
+	if maxLimit != 0 && limit > maxLimit {
+		return fmt.Errorf("limit parameter %d exceeds the maximum of %d for source %s", limit, maxLimit, source)
+	}
+
+	return nil
+}
+
+// toInt returns the integer value of a Go or decoded JSON number.
+func toInt(value interface{}) (int, bool) {
+	switch value := value.(type) {
+	case int:
+		return value, true
+	case int64:
+		return int(value), true
+	case float64:
+		return int(value), true
+	default:
+		return 0, false
+	}
+}
+
+// contextEntries returns the key/value entries of the payload context.
+func contextEntries(context interface{}) []map[string]interface{} {
+	switch context := context.(type) {
+	case []map[string]interface{}:
+		return context
+	case []interface{}:
+		var entries []map[string]interface{}
+		for _, entry := range context {
+			if entry, ok := entry.(map[string]interface{}); ok {
+				entries = append(entries, entry)
+			}
+		}
+		return entries
+	default:
+		return nil
+	}
+}
+
+// isZero reports whether the parameter value is unset.
+func isZero(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil() || isZero(v.Elem().Interface())
+	default:
+		return v.IsZero()
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,57 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSourceParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr string
+	}{
+		{
+			name:    "supported",
+			payload: `{"source": "google_search", "query": "adidas", "limit": 10, "context": [{"key": "nfpr", "value": true}]}`,
+		},
+		{
+			name:    "zero values ignored",
+			payload: `{"source": "google", "url": "https://www.google.com", "limit": 0, "locale": "", "context": [{"key": "nfpr", "value": null}]}`,
+		},
+		{
+			name:    "unknown source",
+			payload: `{"source": "example", "limit": 10}`,
+		},
+		{
+			name:    "unsupported param",
+			payload: `{"source": "google", "url": "https://www.google.com", "limit": 10}`,
+			wantErr: "parameter limit is not supported by source google",
+		},
+		{
+			name:    "limit above maximum",
+			payload: `{"source": "bing_search", "query": "adidas", "limit": 100}`,
+			wantErr: "limit parameter 100 exceeds the maximum of 50 for source bing_search",
+		},
+		{
+			name:    "unsupported context",
+			payload: `{"source": "bing_search", "query": "adidas", "context": [{"key": "tbm", "value": "isch"}]}`,
+			wantErr: "context parameter tbm is not supported by source bing_search",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var payload map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.payload), &payload))
+
+			err := CheckSourceParams(payload)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
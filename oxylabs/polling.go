@@ -0,0 +1,71 @@
+package oxylabs
+
+import "time"
+
+// PollCurve is the schedule of the job status reqs of an async scrape.
+// The first status req is made after InitialDelay, and the interval between
+// the following reqs starts at Interval and grows by Multiplier up to MaxInterval.
+type PollCurve struct {
+	// InitialDelay is the wait between submitting the job and the first status req.
+	InitialDelay time.Duration
+	// Interval is the wait after the first status req.
+	Interval time.Duration
+	// Multiplier grows the interval after each status req. Values up to 1 keep it constant.
+	Multiplier float64
+	// MaxInterval caps the interval. 0 leaves it uncapped.
+	MaxInterval time.Duration
+}
+
+// ConstantPollCurve returns a PollCurve polling every interval from the start.
+func ConstantPollCurve(interval time.Duration) PollCurve {
+	return PollCurve{Interval: interval}
+}
+
+// Next returns the interval which follows interval.
+func (p PollCurve) Next(interval time.Duration) time.Duration {
+	if p.Multiplier > 1 {
+		interval = time.Duration(float64(interval) * p.Multiplier)
+	}
+	if p.MaxInterval != 0 && interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+
+	return interval
+}
+
+// Default poll curves by the type of source. Jobs of SERP sources usually
+// finish within seconds, while rendered jobs can take up to a minute.
+var (
+	serpPollCurve      = PollCurve{InitialDelay: 2 * time.Second, Interval: time.Second, Multiplier: 1.5, MaxInterval: 5 * time.Second}
+	ecommercePollCurve = PollCurve{InitialDelay: 4 * time.Second, Interval: time.Second, Multiplier: 1.5, MaxInterval: 8 * time.Second}
+	universalPollCurve = PollCurve{InitialDelay: 5 * time.Second, Interval: 2 * time.Second, Multiplier: 1.5, MaxInterval: 10 * time.Second}
+	renderPollCurve    = PollCurve{InitialDelay: 10 * time.Second, Interval: 2 * time.Second, Multiplier: 1.5, MaxInterval: 15 * time.Second}
+)
+
+// PollCurveFor returns the default PollCurve of jobs of the source,
+// rendered with JavaScript if render is set.
+func PollCurveFor(source Source, render bool) PollCurve {
+	if render {
+		return renderPollCurve
+	}
+
+	switch source {
+	case
+		GoogleSearch,
+		GoogleAds,
+		GoogleHotels,
+		GoogleImages,
+		GoogleSuggestions,
+		GoogleTravelHotels,
+		GoogleTrendsExplore,
+		GoogleUrl,
+		BingSearch,
+		BingUrl:
+		return serpPollCurve
+	case
+		Universal:
+		return universalPollCurve
+	default:
+		return ecommercePollCurve
+	}
+}
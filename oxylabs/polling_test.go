@@ -0,0 +1,56 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollCurveFor(t *testing.T) {
+	curves := map[Source]PollCurve{
+		GoogleUrl:           serpPollCurve,
+		GoogleAds:           serpPollCurve,
+		GoogleHotels:        serpPollCurve,
+		GoogleSearch:        serpPollCurve,
+		GoogleImages:        serpPollCurve,
+		GoogleSuggestions:   serpPollCurve,
+		GoogleTravelHotels:  serpPollCurve,
+		GoogleTrendsExplore: serpPollCurve,
+		BingUrl:             serpPollCurve,
+		BingSearch:          serpPollCurve,
+
+		GoogleShoppingUrl:     ecommercePollCurve,
+		GoogleShoppingSearch:  ecommercePollCurve,
+		GoogleShoppingProduct: ecommercePollCurve,
+		GoogleShoppingPricing: ecommercePollCurve,
+		Wayfair:               ecommercePollCurve,
+		WayfairSearch:         ecommercePollCurve,
+		AmazonUrl:             ecommercePollCurve,
+		AmazonSearch:          ecommercePollCurve,
+		AmazonProduct:         ecommercePollCurve,
+		AmazonPricing:         ecommercePollCurve,
+		AmazonReviews:         ecommercePollCurve,
+		AmazonQuestions:       ecommercePollCurve,
+		AmazonBestsellers:     ecommercePollCurve,
+		AmazonSellers:         ecommercePollCurve,
+		Target:                ecommercePollCurve,
+		TargetSearch:          ecommercePollCurve,
+		TargetProduct:         ecommercePollCurve,
+		EtsySearch:            ecommercePollCurve,
+		EtsyProduct:           ecommercePollCurve,
+		Kroger:                ecommercePollCurve,
+		KrogerSearch:          ecommercePollCurve,
+		KrogerProduct:         ecommercePollCurve,
+
+		Universal: universalPollCurve,
+	}
+
+	// Every source of the compatibility matrix has an intended curve.
+	for source := range sourceParams {
+		assert.Contains(t, curves, source)
+	}
+	for source, curve := range curves {
+		assert.Equal(t, curve, PollCurveFor(source, false), source)
+		assert.Equal(t, renderPollCurve, PollCurveFor(source, true), source)
+	}
+}
@@ -0,0 +1,76 @@
+package oxylabs
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// NewSessionId returns a random session id for the session_id context option.
+func NewSessionId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// SessionManager hands out session ids for sticky scraping, so that
+// consecutive reqs reuse the same proxy/session on the Oxylabs side.
+// The session id is rotated after MaxUses reqs or once it is older than MaxAge.
+// Zero values disable the respective rotation rule.
+// It is safe for concurrent use.
+type SessionManager struct {
+	MaxUses int
+	MaxAge  time.Duration
+
+	mu        sync.Mutex
+	id        string
+	uses      int
+	createdAt time.Time
+}
+
+// NewSessionManager returns a SessionManager with the given rotation rules.
+func NewSessionManager(maxUses int, maxAge time.Duration) *SessionManager {
+	return &SessionManager{
+		MaxUses: maxUses,
+		MaxAge:  maxAge,
+	}
+}
+
+// SessionId returns the current session id, rotating it first if needed.
+func (m *SessionManager) SessionId() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.id == "" ||
+		(m.MaxUses > 0 && m.uses >= m.MaxUses) ||
+		(m.MaxAge > 0 && time.Since(m.createdAt) >= m.MaxAge) {
+		m.rotate()
+	}
+	m.uses++
+
+	return m.id
+}
+
+// Rotate discards the current session id and returns a new one.
+func (m *SessionManager) Rotate() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rotate()
+
+	return m.id
+}
+
+// Context returns a context modifier setting the session_id context option
+// to the current session id.
+func (m *SessionManager) Context() func(ContextOption) {
+	return SessionId(m.SessionId())
+}
+
+func (m *SessionManager) rotate() {
+	m.id = NewSessionId()
+	m.uses = 0
+	m.createdAt = time.Now()
+}
@@ -0,0 +1,24 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionManager_RotatesAfterMaxUses(t *testing.T) {
+	m := NewSessionManager(2, 0)
+
+	first := m.SessionId()
+	assert.Len(t, first, 32)
+	assert.Equal(t, first, m.SessionId())
+
+	second := m.SessionId()
+	assert.NotEqual(t, first, second)
+
+	assert.NotEqual(t, second, m.Rotate())
+
+	ctx := make(ContextOption)
+	m.Context()(ctx)
+	assert.NotEmpty(t, ctx["session_id"])
+}
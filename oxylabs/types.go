@@ -1,5 +1,7 @@
 package oxylabs
 
+import "strings"
+
 type UserAgent string
 
 const (
@@ -38,6 +40,30 @@ func IsUserAgentValid(ua UserAgent) bool {
 	}
 }
 
+// UserAgents returns all the documented user_agent_type values.
+func UserAgents() []UserAgent {
+	return []UserAgent{
+		UA_MOBILE,
+		UA_TABLET,
+		UA_DESKTOP,
+		UA_MOBILE_IOS,
+		UA_TABLET_IOS,
+		UA_DESKTOP_EDGE,
+		UA_DESKTOP_OPERA,
+		UA_DESKTOP_SAFARI,
+		UA_MOBILE_ANDROID,
+		UA_DESKTOP_CHROME,
+		UA_TABLET_ANDROID,
+		UA_DESKTOP_FIREFOX,
+	}
+}
+
+// IsCustomUserAgentValid checks that a literal User-Agent string
+// is not empty and is safe to be sent as a header value.
+func IsCustomUserAgentValid(ua string) bool {
+	return strings.TrimSpace(ua) != "" && !strings.ContainsAny(ua, "\r\n")
+}
+
 type Render string
 
 const (
@@ -89,6 +115,17 @@ const (
 	AmazonQuestions   Source = "amazon_questions"
 	AmazonBestsellers Source = "amazon_bestsellers"
 	AmazonSellers     Source = "amazon_sellers"
+
+	Target        Source = "target"
+	TargetSearch  Source = "target_search"
+	TargetProduct Source = "target_product"
+
+	EtsySearch  Source = "etsy_search"
+	EtsyProduct Source = "etsy_product"
+
+	Kroger        Source = "kroger"
+	KrogerSearch  Source = "kroger_search"
+	KrogerProduct Source = "kroger_product"
 )
 
 type Domain string
@@ -0,0 +1,21 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserAgents(t *testing.T) {
+	userAgents := UserAgents()
+	assert.Len(t, userAgents, 12)
+	for _, ua := range userAgents {
+		assert.True(t, IsUserAgentValid(ua), ua)
+	}
+}
+
+func TestIsCustomUserAgentValid(t *testing.T) {
+	assert.True(t, IsCustomUserAgentValid("Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0"))
+	assert.False(t, IsCustomUserAgentValid(" "))
+	assert.False(t, IsCustomUserAgentValid("Mozilla/5.0\r\nX-Injected: 1"))
+}
@@ -0,0 +1,53 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// UsageStatsOpts contains all the query parameters available for usage statistics.
+type UsageStatsOpts struct {
+	DateFrom       string
+	DateTo         string
+	GroupBy        string
+	Source         Source
+	RequestTimeout time.Duration
+}
+
+// UsageStats is the usage statistics of the account returned by the stats endpoint:
+// counts of the results retrieved, not the limits or remaining quota of the plan.
+type UsageStats struct {
+	Data UsageStatsData `json:"data"`
+	Meta UsageStatsMeta `json:"meta"`
+}
+
+type UsageStatsData struct {
+	Sources []SourceUsage `json:"sources"`
+}
+
+// SourceUsage is the number of results retrieved with a source.
+type SourceUsage struct {
+	Title                string      `json:"title"`
+	ResultsCount         json.Number `json:"results_count"`
+	RealtimeResultsCount json.Number `json:"realtime_results_count"`
+}
+
+type UsageStatsMeta struct {
+	GroupBy  string `json:"group_by"`
+	DateFrom string `json:"date_from"`
+	DateTo   string `json:"date_to"`
+	Source   string `json:"source"`
+}
+
+// TotalResults returns the number of results retrieved with all sources
+// and all integration methods.
+func (s *UsageStats) TotalResults() int64 {
+	var total int64
+	for _, source := range s.Data.Sources {
+		results, _ := source.ResultsCount.Int64()
+		realtimeResults, _ := source.RealtimeResultsCount.Int64()
+		total += results + realtimeResults
+	}
+
+	return total
+}
@@ -0,0 +1,64 @@
+package pricing
+
+import (
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Job contains the parameters of a job which affect its cost.
+type Job struct {
+	Source oxylabs.Source
+	Pages  int
+	Render oxylabs.Render
+}
+
+// Estimator estimates the cost of jobs in units before they are submitted,
+// so that batch planners can budget reqs. Each page of a job is billed as a
+// separate result. Unit costs depend on the plan of the account and should be
+// configured accordingly.
+type Estimator struct {
+	// DefaultUnits is the cost of a result of a source missing from SourceUnits.
+	DefaultUnits float64
+	// SourceUnits is the cost of a result per source.
+	SourceUnits map[oxylabs.Source]float64
+	// RenderMultiplier multiplies the cost of results rendered with a browser.
+	RenderMultiplier float64
+}
+
+// NewEstimator returns an Estimator which bills every result as 1 unit.
+func NewEstimator() *Estimator {
+	return &Estimator{
+		DefaultUnits:     1,
+		SourceUnits:      map[oxylabs.Source]float64{},
+		RenderMultiplier: 1,
+	}
+}
+
+// Estimate returns the estimated cost of the job in units.
+func (e *Estimator) Estimate(job Job) float64 {
+	pages := job.Pages
+	if pages == 0 {
+		pages = internal.DefaultPages
+	}
+
+	units, ok := e.SourceUnits[job.Source]
+	if !ok {
+		units = e.DefaultUnits
+	}
+
+	if job.Render != "" && e.RenderMultiplier != 0 {
+		units *= e.RenderMultiplier
+	}
+
+	return units * float64(pages)
+}
+
+// EstimateAll returns the estimated total cost of the jobs in units.
+func (e *Estimator) EstimateAll(jobs []Job) float64 {
+	var total float64
+	for _, job := range jobs {
+		total += e.Estimate(job)
+	}
+
+	return total
+}
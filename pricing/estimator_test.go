@@ -0,0 +1,25 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimator_EstimateAll(t *testing.T) {
+	e := NewEstimator()
+	e.SourceUnits[oxylabs.Universal] = 2
+	e.RenderMultiplier = 3
+
+	jobs := []Job{
+		{Source: oxylabs.GoogleSearch, Pages: 3},
+		{Source: oxylabs.GoogleSearch},
+		{Source: oxylabs.Universal, Render: oxylabs.HTML},
+	}
+
+	assert.Equal(t, 3.0, e.Estimate(jobs[0]))
+	assert.Equal(t, 1.0, e.Estimate(jobs[1]))
+	assert.Equal(t, 6.0, e.Estimate(jobs[2]))
+	assert.Equal(t, 10.0, e.EstimateAll(jobs))
+}
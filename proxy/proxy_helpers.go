@@ -11,6 +11,12 @@ func AddUserAgentHeader(req *http.Request, userAgent oxylabs.UserAgent) {
 	req.Header.Add("x-oxylabs-user-agent-type", string(userAgent))
 }
 
+// AddCustomUserAgentHeader sets a literal User-Agent header which is forwarded to the target.
+// It should not be combined with AddUserAgentHeader.
+func AddCustomUserAgentHeader(req *http.Request, userAgent string) {
+	req.Header.Set("User-Agent", userAgent)
+}
+
 // AddRenderHeader adds the render header to the req.
 func AddRenderHeader(req *http.Request, render oxylabs.Render) {
 	req.Header.Add("x-oxylabs-render", string(render))
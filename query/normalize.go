@@ -0,0 +1,56 @@
+package query
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidUTF8 is returned by Normalize for queries which are not valid UTF-8.
+var ErrInvalidUTF8 = errors.New("query is not valid UTF-8")
+
+// Normalize trims the query, collapses runs of whitespace, including tabs,
+// newlines and Unicode spaces, to a single space, and drops control and
+// invisible formatting characters, e.g. zero-width spaces, which may make jobs
+// fail. Unsafe characters, i.e. Unicode noncharacters and private use characters,
+// which have no meaning to search engines, are encoded as the replacement
+// character U+FFFD. If lowercase is set the query is also lowercased.
+func Normalize(q string, lowercase bool) (string, error) {
+	if !utf8.ValidString(q) {
+		return "", ErrInvalidUTF8
+	}
+
+	var b strings.Builder
+	b.Grow(len(q))
+	space := false
+	for _, r := range q {
+		switch {
+		case unicode.IsSpace(r):
+			space = b.Len() > 0
+			continue
+		case unicode.IsControl(r), unicode.Is(unicode.Cf, r):
+			continue
+		case isUnsafe(r):
+			r = utf8.RuneError
+		}
+
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+
+	if lowercase {
+		return strings.ToLower(b.String()), nil
+	}
+
+	return b.String(), nil
+}
+
+// isUnsafe reports whether r is a noncharacter, which is reserved for internal use
+// by applications, or a private use character.
+func isUnsafe(r rune) bool {
+	return (r >= 0xFDD0 && r <= 0xFDEF) || r&0xFFFE == 0xFFFE || unicode.Is(unicode.Co, r)
+}
@@ -0,0 +1,147 @@
+// Package query composes search queries with advanced operators,
+// such as site: and intitle:, quoting their values as search engines expect.
+package query
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Query is a search query built from terms and operators.
+// The zero value is an empty query.
+type Query struct {
+	parts []string
+}
+
+// New returns a query of the terms.
+func New(terms ...string) *Query {
+	q := &Query{}
+	for _, term := range terms {
+		q.add(quote(term, false))
+	}
+
+	return q
+}
+
+// Term adds a term, quoted if it contains spaces.
+func (q *Query) Term(term string) *Query {
+	return q.add(quote(term, false))
+}
+
+// Phrase adds an exact phrase.
+func (q *Query) Phrase(phrase string) *Query {
+	return q.add(quote(phrase, true))
+}
+
+// Exclude excludes results containing the term.
+func (q *Query) Exclude(term string) *Query {
+	if term = quote(term, false); term != "" {
+		q.add("-" + term)
+	}
+
+	return q
+}
+
+// Or matches results containing any of the terms.
+func (q *Query) Or(terms ...string) *Query {
+	var quoted []string
+	for _, term := range terms {
+		if term = quote(term, false); term != "" {
+			quoted = append(quoted, term)
+		}
+	}
+	switch len(quoted) {
+	case 0:
+	case 1:
+		q.add(quoted[0])
+	default:
+		q.add("(" + strings.Join(quoted, " OR ") + ")")
+	}
+
+	return q
+}
+
+// Site restricts results to the domain. A url is reduced to its host.
+func (q *Query) Site(domain string) *Query {
+	return q.operator("site", host(domain))
+}
+
+// ExcludeSite excludes results from the domain.
+func (q *Query) ExcludeSite(domain string) *Query {
+	if domain = host(domain); domain != "" {
+		q.add("-site:" + domain)
+	}
+
+	return q
+}
+
+// InTitle matches results with the term in their title.
+func (q *Query) InTitle(term string) *Query {
+	return q.operator("intitle", term)
+}
+
+// InUrl matches results with the term in their url.
+func (q *Query) InUrl(term string) *Query {
+	return q.operator("inurl", term)
+}
+
+// InText matches results with the term in their text.
+func (q *Query) InText(term string) *Query {
+	return q.operator("intext", term)
+}
+
+// Filetype restricts results to the file extension, e.g. pdf.
+func (q *Query) Filetype(ext string) *Query {
+	return q.operator("filetype", strings.TrimPrefix(strings.TrimSpace(ext), "."))
+}
+
+// String returns the query to be passed to the scrape functions.
+func (q *Query) String() string {
+	return strings.Join(q.parts, " ")
+}
+
+// Encode returns the query encoded for a search url.
+func (q *Query) Encode() string {
+	return url.QueryEscape(q.String())
+}
+
+func (q *Query) operator(name, value string) *Query {
+	if value = quote(value, false); value != "" {
+		q.add(name + ":" + value)
+	}
+
+	return q
+}
+
+func (q *Query) add(part string) *Query {
+	if part != "" {
+		q.parts = append(q.parts, part)
+	}
+
+	return q
+}
+
+// quote returns the term with its whitespace collapsed, quoted if it
+// contains spaces or always is set. Search engines do not support
+// escaping quotes, so quotes within the term are removed.
+func quote(term string, always bool) string {
+	term = strings.Join(strings.Fields(strings.ReplaceAll(term, `"`, "")), " ")
+	if term == "" {
+		return ""
+	}
+	if always || strings.Contains(term, " ") {
+		return `"` + term + `"`
+	}
+
+	return term
+}
+
+// host returns the host of a url, or the domain as is.
+func host(domain string) string {
+	domain = strings.TrimSpace(domain)
+	if u, err := url.Parse(domain); err == nil && u.Host != "" {
+		return u.Host
+	}
+
+	return strings.TrimSuffix(domain, "/")
+}
@@ -0,0 +1,43 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	q := New("running shoes").
+		Site("https://www.nike.com/us/").
+		ExcludeSite("ebay.com").
+		InTitle("air max").
+		Phrase(`say "hi"  there`).
+		Exclude("kids").
+		Or("red", "", "light blue").
+		Filetype(".pdf").
+		InUrl("")
+
+	assert.Equal(t,
+		`"running shoes" site:www.nike.com -site:ebay.com intitle:"air max" "say hi there" -kids (red OR "light blue") filetype:pdf`,
+		q.String(),
+	)
+	assert.Equal(t, "site%3Anike.com+-kids", New().Site("nike.com").Exclude("kids").Encode())
+	assert.Empty(t, (&Query{}).String())
+}
+
+func TestNormalize(t *testing.T) {
+	q, err := Normalize("  running\tshoes \n  nike\u200b\x00 ", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "running shoes nike", q)
+
+	q, err = Normalize("Running  Shoes", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "running shoes", q)
+
+	q, err = Normalize("shoes \ufdd0\uffff\ue000", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "shoes \ufffd\ufffd\ufffd", q)
+
+	_, err = Normalize("shoes\xff", false)
+	assert.ErrorIs(t, err, ErrInvalidUTF8)
+}
@@ -0,0 +1,160 @@
+// Package ranktracker finds the positions of a domain in the organic
+// search results of a keyword.
+package ranktracker
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+)
+
+// Engine is the search engine whose results are tracked.
+type Engine string
+
+const (
+	Google Engine = "google"
+	Bing   Engine = "bing"
+)
+
+// Opts contains the options of a rank tracking req.
+type Opts struct {
+	Engine         Engine
+	Pages          int
+	Domain         oxylabs.Domain
+	Locale         oxylabs.Locale
+	GeoLocation    oxylabs.GeoLocation
+	UserAgent      oxylabs.UserAgent
+	RequestTimeout time.Duration
+}
+
+// Position is a position of the tracked domain in the organic results.
+type Position struct {
+	Page       int
+	Pos        int
+	PosOverall int
+	Url        string
+	Title      string
+}
+
+// Tracker tracks the ranks of domains using a SERP realtime client.
+type Tracker struct {
+	C *serp.SerpClient
+}
+
+// New returns a Tracker using the given client.
+func New(c *serp.SerpClient) *Tracker {
+	return &Tracker{C: c}
+}
+
+// Track returns the positions of domain in the organic results of keyword.
+// Subdomains of domain are matched too, e.g. shop.adidas.com for adidas.com.
+func (t *Tracker) Track(
+	keyword string,
+	domain string,
+	opts ...*Opts,
+) ([]Position, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(t.C.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
+	defer cancel()
+
+	return t.TrackCtx(ctx, keyword, domain, opts...)
+}
+
+// TrackCtx returns the positions of domain in the organic results of keyword.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (t *Tracker) TrackCtx(
+	ctx context.Context,
+	keyword string,
+	domain string,
+	opts ...*Opts,
+) ([]Position, error) {
+	// Prepare options.
+	opt := &Opts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	var resp *serp.Resp
+	var err error
+	switch opt.Engine {
+	case Google, "":
+		resp, err = t.C.ScrapeGoogleSearchCtx(ctx, keyword, &serp.GoogleSearchOpts{
+			Pages:       opt.Pages,
+			Domain:      opt.Domain,
+			Locale:      opt.Locale,
+			GeoLocation: opt.GeoLocation,
+			UserAgent:   opt.UserAgent,
+			Parse:       true,
+		})
+	case Bing:
+		resp, err = t.C.ScrapeBingSearchCtx(ctx, keyword, &serp.BingSearchOpts{
+			Pages:       opt.Pages,
+			Domain:      opt.Domain,
+			Locale:      opt.Locale,
+			GeoLocation: opt.GeoLocation,
+			UserAgent:   opt.UserAgent,
+			Parse:       true,
+		})
+	default:
+		return nil, fmt.Errorf("invalid engine parameter: %v", opt.Engine)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return Positions(resp, domain), nil
+}
+
+// Positions returns the positions of domain in the organic results of a parsed
+// SERP resp, ordered by page. A url listed on several pages is only returned once.
+func Positions(resp *serp.Resp, domain string) []Position {
+	domain = normalizeHost(domain)
+
+	var positions []Position
+	seen := map[string]bool{}
+	for _, result := range resp.Results {
+		page := result.ContentParsed.Page
+		if page == 0 {
+			page = result.Page
+		}
+
+		for _, organic := range result.ContentParsed.Results.Organic {
+			if seen[organic.Url] || !matchesDomain(organic.Url, domain) {
+				continue
+			}
+			seen[organic.Url] = true
+
+			positions = append(positions, Position{
+				Page:       page,
+				Pos:        organic.Pos,
+				PosOverall: organic.PosOverall,
+				Url:        organic.Url,
+				Title:      organic.Title,
+			})
+		}
+	}
+
+	return positions
+}
+
+// matchesDomain reports whether the host of rawUrl is domain or one of its subdomains.
+func matchesDomain(rawUrl string, domain string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return false
+	}
+	host := normalizeHost(u.Hostname())
+
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	return strings.TrimPrefix(host, "www.")
+}
@@ -0,0 +1,31 @@
+package ranktracker
+
+import (
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositions(t *testing.T) {
+	page1 := serp.Results{Page: 1}
+	page1.ContentParsed.Results.Organic = []serp.Organic{
+		{Pos: 1, Url: "https://www.nike.com/"},
+		{Pos: 2, Url: "https://www.adidas.com/us"},
+		{Pos: 3, Url: "https://shop.adidas.com/"},
+		{Pos: 4, Url: "https://notadidas.com/"},
+	}
+	page2 := serp.Results{Page: 2}
+	page2.ContentParsed.Results.Organic = []serp.Organic{
+		{Pos: 1, Url: "https://www.adidas.com/us"},
+		{Pos: 2, Url: "https://adidas.com/de"},
+	}
+	resp := &serp.Resp{Parse: true, Results: []serp.Results{page1, page2}}
+
+	positions := Positions(resp, "www.Adidas.com")
+	assert.Equal(t, []Position{
+		{Page: 1, Pos: 2, Url: "https://www.adidas.com/us"},
+		{Page: 1, Pos: 3, Url: "https://shop.adidas.com/"},
+		{Page: 2, Pos: 2, Url: "https://adidas.com/de"},
+	}, positions)
+}
@@ -0,0 +1,212 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule returns the next activation time after t.
+type Schedule interface {
+	Next(t time.Time) time.Time
+}
+
+// ParseCron parses a cron expression with the five standard fields:
+// minute, hour, day of month, month and day of week, e.g. "0 */6 * * mon-fri".
+// Fields accept "*", values, ranges, lists and steps, and month and day of week names.
+// The descriptors @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly,
+// and "@every <duration>", e.g. "@every 90m", are accepted as well.
+func ParseCron(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expr, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expr, err)
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("invalid cron expression %q: interval must be at least 1s", expr)
+		}
+		return every(d), nil
+	}
+	if descriptor, ok := descriptors[expr]; ok {
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	s := &cronSchedule{}
+	var err error
+	for i, f := range []struct {
+		set    *bits
+		bounds bounds
+	}{
+		{&s.minute, minutes},
+		{&s.hour, hours},
+		{&s.dom, daysOfMonth},
+		{&s.month, months},
+		{&s.dow, daysOfWeek},
+	} {
+		if *f.set, err = parseField(fields[i], f.bounds); err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expr, err)
+		}
+	}
+	s.domStar = fields[2] == "*" || fields[2] == "?"
+	s.dowStar = fields[4] == "*" || fields[4] == "?"
+
+	// Sunday may be given as 7.
+	if s.dow.has(7) {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type bounds struct {
+	min, max int
+	names    []string
+}
+
+var (
+	minutes     = bounds{min: 0, max: 59}
+	hours       = bounds{min: 0, max: 23}
+	daysOfMonth = bounds{min: 1, max: 31}
+	months      = bounds{min: 1, max: 12, names: []string{
+		"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec",
+	}}
+	daysOfWeek = bounds{min: 0, max: 7, names: []string{
+		"sun", "mon", "tue", "wed", "thu", "fri", "sat",
+	}}
+)
+
+// bits is a set of field values.
+type bits uint64
+
+func (b bits) has(v int) bool {
+	return b&(1<<uint(v)) != 0
+}
+
+// parseField parses a comma separated list of "*", values and ranges with optional steps.
+func parseField(field string, b bounds) (bits, error) {
+	var set bits
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rng = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+		}
+
+		lo, hi := b.min, b.max
+		switch {
+		case rng == "*" || rng == "?":
+		case strings.Contains(rng, "-"):
+			ends := strings.SplitN(rng, "-", 2)
+			var err error
+			if lo, err = parseValue(ends[0], b); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(ends[1], b); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+		default:
+			v, err := parseValue(rng, b)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			// A value with a step, e.g. "5/15", runs from the value to the maximum.
+			if step == 1 {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	return set, nil
+}
+
+func parseValue(s string, b bounds) (int, error) {
+	for i, name := range b.names {
+		if strings.EqualFold(s, name) {
+			return b.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < b.min || v > b.max {
+		return 0, fmt.Errorf("invalid value %q, must be between %d and %d", s, b.min, b.max)
+	}
+
+	return v, nil
+}
+
+// cronSchedule is the Schedule of a cron expression.
+type cronSchedule struct {
+	minute, hour, dom, month, dow bits
+	domStar, dowStar              bool
+}
+
+// Next returns the first matching minute after t, or the zero time if
+// there is none within five years, e.g. for "0 0 30 2 *".
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case !s.month.has(int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !s.hour.has(t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !s.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// dayMatches reports whether the day matches. As in cron, if both the day of month
+// and the day of week are restricted, a day matching either of them matches.
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dom := s.dom.has(t.Day())
+	dow := s.dow.has(int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// every is the Schedule of "@every <duration>".
+type every time.Duration
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
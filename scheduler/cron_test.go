@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/sink"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron_Next(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2024, 1, 10, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"@hourly", time.Date(2024, 1, 10, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 10, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2024, 1, 11, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 feb *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 15 * 0", time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC).AddDate(0, 0, 4)},
+		{"@every 90m", now.Add(90 * time.Minute)},
+	}
+	for _, tt := range tests {
+		s, err := ParseCron(tt.expr)
+		if assert.NoError(t, err, tt.expr) {
+			assert.Equal(t, tt.want, s.Next(now), tt.expr)
+		}
+	}
+
+	for _, expr := range []string{"* * * *", "60 * * * *", "* * * * 8", "*/0 * * * *", "@every 1ms"} {
+		_, err := ParseCron(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestKeywords(t *testing.T) {
+	s := New(2)
+	defer s.Close()
+
+	var mu sync.Mutex
+	var published []string
+	out := sink.Func[string](func(ctx context.Context, result string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		published = append(published, result)
+		return nil
+	})
+
+	job := Keywords(s, []string{"adidas", "nike", "puma"}, func(ctx context.Context, keyword string) (string, error) {
+		if keyword == "puma" {
+			return "", fmt.Errorf("blocked")
+		}
+		return "results for " + keyword, nil
+	}, out)
+
+	err := job(context.Background())
+	assert.EqualError(t, err, `error scraping keyword "puma": blocked`)
+	sort.Strings(published)
+	assert.Equal(t, []string{"results for adidas", "results for nike"}, published)
+}
@@ -0,0 +1,177 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/sink"
+)
+
+// Job is a recurring unit of work run by Cron, e.g. re-scraping a keyword list.
+type Job func(ctx context.Context) error
+
+// CronOpts contains the options of a recurring job.
+type CronOpts struct {
+	// Jitter delays each run by a random duration up to Jitter,
+	// so that schedules firing at the same time spread their load.
+	Jitter time.Duration
+	// OnError is called with the errors returned by the job.
+	OnError func(err error)
+}
+
+// Cron runs jobs on cron schedules. A run is skipped if the previous run
+// of the same job has not finished yet. It is safe for concurrent use.
+type Cron struct {
+	mu      sync.Mutex
+	ctx     context.Context
+	cancel  context.CancelFunc
+	entries []*entry
+	started bool
+	wg      sync.WaitGroup
+}
+
+type entry struct {
+	schedule Schedule
+	job      Job
+	opt      *CronOpts
+	running  sync.Mutex
+}
+
+// NewCron returns a Cron without jobs.
+func NewCron() *Cron {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Cron{ctx: ctx, cancel: cancel}
+}
+
+// Add runs the job on the schedule of the cron expression, see ParseCron.
+// Jobs may be added before or after Start.
+func (c *Cron) Add(expr string, job Job, opts ...*CronOpts) error {
+	schedule, err := ParseCron(expr)
+	if err != nil {
+		return err
+	}
+
+	// Prepare options.
+	opt := &CronOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+	if opt.Jitter < 0 {
+		return fmt.Errorf("jitter cannot be negative")
+	}
+
+	e := &entry{schedule: schedule, job: job, opt: opt}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ctx.Err() != nil {
+		return ErrClosed
+	}
+	c.entries = append(c.entries, e)
+	if c.started {
+		c.run(e)
+	}
+
+	return nil
+}
+
+// Start starts running the jobs on their schedules.
+func (c *Cron) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.started || c.ctx.Err() != nil {
+		return
+	}
+	c.started = true
+	for _, e := range c.entries {
+		c.run(e)
+	}
+}
+
+// Stop stops scheduling runs, cancels the context of the running jobs
+// and waits for them to return.
+func (c *Cron) Stop() {
+	c.mu.Lock()
+	c.cancel()
+	c.mu.Unlock()
+
+	c.wg.Wait()
+}
+
+// run schedules the runs of the entry until the cron is stopped.
+func (c *Cron) run(e *entry) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		for {
+			next := e.schedule.Next(time.Now())
+			if next.IsZero() {
+				return
+			}
+			if e.opt.Jitter > 0 {
+				next = next.Add(time.Duration(rand.Int63n(int64(e.opt.Jitter))))
+			}
+
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-c.ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			// Skip the run if the previous one is still running.
+			if !e.running.TryLock() {
+				continue
+			}
+			c.wg.Add(1)
+			go func() {
+				defer c.wg.Done()
+				defer e.running.Unlock()
+
+				if err := e.job(c.ctx); err != nil && e.opt.OnError != nil {
+					e.opt.OnError(err)
+				}
+			}()
+		}
+	}()
+}
+
+// Keywords returns a job which scrapes each keyword as a separate task of s,
+// bounding the scrapes in flight, and publishes the results to out.
+// The errors of all keywords are joined.
+func Keywords[T any](
+	s *Scheduler,
+	keywords []string,
+	scrape func(ctx context.Context, keyword string) (T, error),
+	out sink.ResultSink[T],
+) Job {
+	return func(ctx context.Context) error {
+		errcs := make([]<-chan error, 0, len(keywords))
+		for _, keyword := range keywords {
+			keyword := keyword
+			errcs = append(errcs, s.Submit(func() error {
+				result, err := scrape(ctx, keyword)
+				if err != nil {
+					return fmt.Errorf("error scraping keyword %q: %w", keyword, err)
+				}
+				return out.Publish(ctx, result)
+			}))
+		}
+
+		var errs []error
+		for _, errc := range errcs {
+			errs = append(errs, <-errc)
+		}
+
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,148 @@
+// Package scheduler queues scraping jobs and runs them with
+// a bounded number of jobs in flight against the API, and runs
+// recurring jobs on cron schedules.
+package scheduler
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned for tasks submitted after the scheduler was closed.
+var ErrClosed = errors.New("scheduler is closed")
+
+// Task is a unit of work, e.g. a scrape with a realtime client or
+// a scrape with an async client including reading its results.
+type Task func() error
+
+// Stats contains the metrics of a scheduler.
+type Stats struct {
+	// Queued is the number of tasks waiting to be run, i.e. the queue depth.
+	Queued int
+	// InFlight is the number of running tasks.
+	InFlight int
+	// Completed is the number of tasks which returned no error.
+	Completed uint64
+	// Failed is the number of tasks which returned an error.
+	Failed uint64
+}
+
+// Scheduler accepts any number of tasks and runs at most MaxInFlight
+// of them at a time, queuing the rest in submission order.
+// It is safe for concurrent use.
+type Scheduler struct {
+	maxInFlight int
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  []queued
+	stats  Stats
+	closed bool
+	wg     sync.WaitGroup
+}
+
+type queued struct {
+	task Task
+	errc chan error
+}
+
+// New returns a Scheduler running at most maxInFlight tasks at a time,
+// e.g. the concurrency limit of the account. A maxInFlight of 0 or less is treated as 1.
+func New(maxInFlight int) *Scheduler {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	s := &Scheduler{maxInFlight: maxInFlight}
+	s.cond = sync.NewCond(&s.mu)
+
+	s.wg.Add(maxInFlight)
+	for i := 0; i < maxInFlight; i++ {
+		go s.work()
+	}
+
+	return s
+}
+
+// Submit queues the task without blocking. Once the task has run, the returned
+// channel receives the error returned by the task, if any, and is closed, so
+// receiving from it yields nil for a task which succeeded.
+func (s *Scheduler) Submit(task Task) <-chan error {
+	errc := make(chan error, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		errc <- ErrClosed
+		close(errc)
+		return errc
+	}
+
+	s.queue = append(s.queue, queued{task: task, errc: errc})
+	s.stats.Queued++
+	s.cond.Signal()
+
+	return errc
+}
+
+// Stats returns the current metrics of the scheduler.
+func (s *Scheduler) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stats
+}
+
+// QueueDepth returns the number of tasks waiting to be run.
+func (s *Scheduler) QueueDepth() int {
+	return s.Stats().Queued
+}
+
+// Close stops accepting tasks and waits until the queued tasks have run.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.cond.Broadcast()
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
+
+// work runs queued tasks until the scheduler is closed and the queue is empty.
+func (s *Scheduler) work() {
+	defer s.wg.Done()
+
+	for {
+		s.mu.Lock()
+		for len(s.queue) == 0 && !s.closed {
+			s.cond.Wait()
+		}
+		if len(s.queue) == 0 {
+			s.mu.Unlock()
+			return
+		}
+		q := s.queue[0]
+		s.queue[0] = queued{}
+		s.queue = s.queue[1:]
+		s.stats.Queued--
+		s.stats.InFlight++
+		s.mu.Unlock()
+
+		err := q.task()
+
+		s.mu.Lock()
+		s.stats.InFlight--
+		if err != nil {
+			s.stats.Failed++
+		} else {
+			s.stats.Completed++
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			q.errc <- err
+		}
+		close(q.errc)
+	}
+}
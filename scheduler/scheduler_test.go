@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_BoundsInFlight(t *testing.T) {
+	s := New(3)
+
+	started := make(chan struct{}, 20)
+	release := make(chan struct{})
+	var inFlight, maxInFlight int32
+	for i := 0; i < 20; i++ {
+		s.Submit(func() error {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			started <- struct{}{}
+			<-release
+			atomic.AddInt32(&inFlight, -1)
+			return nil
+		})
+	}
+
+	// The other tasks stay queued while the first ones are blocked.
+	for i := 0; i < 3; i++ {
+		<-started
+	}
+	assert.Equal(t, 17, s.QueueDepth())
+	assert.Equal(t, 3, s.Stats().InFlight)
+
+	close(release)
+	s.Close()
+
+	assert.Len(t, started, 17)
+	assert.LessOrEqual(t, maxInFlight, int32(3))
+	assert.Equal(t, Stats{Completed: 20}, s.Stats())
+}
+
+func TestScheduler_Submit(t *testing.T) {
+	s := New(1)
+
+	// Channels are closed after the error of the task, if any.
+	errc := s.Submit(func() error { return errors.New("faulted") })
+	assert.EqualError(t, <-errc, "faulted")
+	_, ok := <-errc
+	assert.False(t, ok)
+
+	errc = s.Submit(func() error { return nil })
+	_, ok = <-errc
+	assert.False(t, ok)
+
+	s.Close()
+
+	errc = s.Submit(func() error { return nil })
+	assert.ErrorIs(t, <-errc, ErrClosed)
+	_, ok = <-errc
+	assert.False(t, ok)
+	assert.Equal(t, uint64(1), s.Stats().Failed)
+}
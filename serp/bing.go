@@ -3,6 +3,7 @@ package serp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -22,48 +23,60 @@ var BingSearchAcceptedDomainParameters = []oxylabs.Domain{
 
 // checkParameterValidity checks validity of ScrapeBingSearch parameters.
 func (opt *BingSearchOpts) checkParameterValidity() error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if opt.Domain != "" && !internal.InList(opt.Domain, BingSearchAcceptedDomainParameters) {
-		return fmt.Errorf("invalid domain parameter: %s", opt.Domain)
+		errs = append(errs, fmt.Errorf("invalid domain parameter: %s", opt.Domain))
 	}
 
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("limit, pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // checkParameterValidity checks validity of ScrapeBingUrl parameters.
 func (opt *BingUrlOpts) checkParameterValidity() error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // BingSearchOpts contains all the query parameters available for bing_search.
@@ -73,13 +86,34 @@ type BingSearchOpts struct {
 	Pages             int
 	Limit             int
 	Locale            oxylabs.Locale
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	CallbackUrl       string
 	Render            oxylabs.Render
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *BingSearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "BingSearchOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Limit:             &opt.Limit,
+		Locale:            &opt.Locale,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Render:            &opt.Render,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // ScrapeBingSearch scrapes bing via Oxylabs SERP API with bing_search as source.
@@ -87,7 +121,7 @@ func (c *SerpClient) ScrapeBingSearch(
 	query string,
 	opts ...*BingSearchOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeBingSearchCtx(ctx, query, opts...)
@@ -100,21 +134,40 @@ func (c *SerpClient) ScrapeBingSearchCtx(
 	query string,
 	opts ...*BingSearchOpts,
 ) (*Resp, error) {
+	req, err := prepareBingSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareBingSearch applies the defaults to the opts of a scrape with bing_search as source,
+// checks them and returns the req.
+func prepareBingSearch(
+	c *internal.Client,
+	query string,
+	opts []*BingSearchOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &BingSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity()
+	err = opt.checkParameterValidity()
 	if err != nil {
 		return nil, err
 	}
@@ -147,31 +200,43 @@ func (c *SerpClient) ScrapeBingSearchCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // BingUrlOpts contains all the query parameters available for bing.
 type BingUrlOpts struct {
 	UserAgent         oxylabs.UserAgent
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	Render            oxylabs.Render
 	CallbackUrl       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *BingUrlOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "BingUrlOpts",
+		UserAgent:         &opt.UserAgent,
+		GeoLocation:       &opt.GeoLocation,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // ScrapeBingUrl scrapes bing via Oxylabs SERP API with bing as source.
@@ -179,7 +244,7 @@ func (c *SerpClient) ScrapeBingUrl(
 	url string,
 	opts ...*BingUrlOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeBingUrlCtx(ctx, url, opts...)
@@ -192,8 +257,23 @@ func (c *SerpClient) ScrapeBingUrlCtx(
 	url string,
 	opts ...*BingUrlOpts,
 ) (*Resp, error) {
+	req, err := prepareBingUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareBingUrl applies the defaults to the opts of a scrape with bing as source,
+// checks them and returns the req.
+func prepareBingUrl(
+	c *internal.Client,
+	url string,
+	opts []*BingUrlOpts,
+) (*scrapeReq, error) {
 	// Check validity of url.
-	err := internal.ValidateUrl(url, "bing")
+	err := c.CheckUrl(url, "bing")
 	if err != nil {
 		return nil, err
 	}
@@ -201,11 +281,13 @@ func (c *SerpClient) ScrapeBingUrlCtx(
 	// Prepare options.
 	opt := &BingUrlOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
 
 	// Check validity of parameters.
 	err = opt.checkParameterValidity()
@@ -236,18 +318,14 @@ func (c *SerpClient) ScrapeBingUrlCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
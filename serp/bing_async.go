@@ -2,12 +2,8 @@ package serp
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
-	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // ScrapeBingSearch scrapes bing with async polling runtime via Oxylabs SERP API
@@ -15,11 +11,11 @@ import (
 func (c *SerpClientAsync) ScrapeBingSearch(
 	query string,
 	opts ...*BingSearchOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeBingSearchCtx(ctx, query, opts...)
 
-	return c.ScrapeBingSearchCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeBingSearchCtx scrapes bing with async polling runtime via Oxylabs SERP API
@@ -29,94 +25,37 @@ func (c *SerpClientAsync) ScrapeBingSearchCtx(
 	ctx context.Context,
 	query string,
 	opts ...*BingSearchOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &BingSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.BingSearch,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"limit":           opt.Limit,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-		"render":          opt.Render,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitBingSearch(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitBingSearch submits the job and returns it without polling.
+func (c *SerpClientAsync) submitBingSearch(
+	ctx context.Context,
+	query string,
+	opts ...*BingSearchOpts,
+) (*asyncJob, error) {
+	req, err := prepareBingSearch(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitBingSearch submits a job via Oxylabs SERP API with bing_search as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitBingSearch(
+	ctx context.Context,
+	query string,
+	opts ...*BingSearchOpts,
+) (string, error) {
+	return c.submitOnly(c.submitBingSearch(ctx, query, opts...))
 }
 
 // ScrapeBingUrl scrapes bing with async polling runtime via Oxylabs SERP API
@@ -124,11 +63,11 @@ func (c *SerpClientAsync) ScrapeBingSearchCtx(
 func (c *SerpClientAsync) ScrapeBingUrl(
 	url string,
 	opts ...*BingUrlOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeBingUrlCtx(ctx, url, opts...)
 
-	return c.ScrapeBingUrlCtx(ctx, url, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeBingUrlCtx scrapes bing with async polling runtime via Oxylabs SERP API
@@ -138,89 +77,35 @@ func (c *SerpClientAsync) ScrapeBingUrlCtx(
 	ctx context.Context,
 	url string,
 	opts ...*BingUrlOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Check validity of URL.
-	err := internal.ValidateUrl(url, "bing")
+) (*AsyncResult, error) {
+	job, err := c.submitBingUrl(ctx, url, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare options.
-	opt := &BingUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.BingUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"geo_location":    opt.GeoLocation,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitBingUrl submits the job and returns it without polling.
+func (c *SerpClientAsync) submitBingUrl(
+	ctx context.Context,
+	url string,
+	opts ...*BingUrlOpts,
+) (*asyncJob, error) {
+	req, err := prepareBingUrl(c.C, url, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitBingUrl submits a job via Oxylabs SERP API with bing as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitBingUrl(
+	ctx context.Context,
+	url string,
+	opts ...*BingUrlOpts,
+) (string, error) {
+	return c.submitOnly(c.submitBingUrl(ctx, url, opts...))
 }
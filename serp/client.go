@@ -1,11 +1,13 @@
 package serp
 
 import (
-	"net/http"
+	"context"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
+// SerpClient is safe for concurrent use by multiple goroutines.
 type SerpClient struct {
 	C *internal.Client
 }
@@ -14,19 +16,44 @@ type SerpClient struct {
 func Init(
 	username string,
 	password string,
+	opts ...oxylabs.ClientOption,
 ) *SerpClient {
 	return &SerpClient{
-		C: &internal.Client{
-			BaseUrl: internal.SyncBaseUrl,
-			ApiCredentials: &internal.ApiCredentials{
-				Username: username,
-				Password: password,
-			},
-			HttpClient: &http.Client{},
-		},
+		C: internal.NewClient(internal.SyncBaseUrl, username, password, opts...),
 	}
 }
 
+// NewSerpClient returns a client for the Sync runtime model. Unlike Init it
+// returns an error if credentials are missing, or with oxylabs.WithVerifyCredentials
+// if the API rejects them.
+func NewSerpClient(
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*SerpClient, error) {
+	return NewSerpClientCtx(context.Background(), username, password, opts...)
+}
+
+// NewSerpClientCtx returns a client for the Sync runtime model.
+// The provided context bounds the verification of the credentials.
+func NewSerpClientCtx(
+	ctx context.Context,
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*SerpClient, error) {
+	c, err := internal.NewClientCtx(ctx, internal.SyncBaseUrl, username, password, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SerpClient{C: c}, nil
+}
+
+// SerpClientAsync is safe for concurrent use by multiple goroutines.
+// Its scrape methods return an error if the job could not be submitted, or an
+// AsyncResult whose jobs are polled in the background until their Results
+// channel receives exactly one resp or Err reports why it failed.
 type SerpClientAsync struct {
 	C *internal.Client
 }
@@ -35,15 +62,110 @@ type SerpClientAsync struct {
 func InitAsync(
 	username string,
 	password string,
+	opts ...oxylabs.ClientOption,
 ) *SerpClientAsync {
 	return &SerpClientAsync{
-		C: &internal.Client{
-			BaseUrl: internal.AsyncBaseUrl,
-			ApiCredentials: &internal.ApiCredentials{
-				Username: username,
-				Password: password,
-			},
-			HttpClient: &http.Client{},
-		},
+		C: internal.NewClient(internal.AsyncBaseUrl, username, password, opts...),
+	}
+}
+
+// NewSerpClientAsync returns a client for the Async runtime model. Unlike InitAsync
+// it returns an error if credentials are missing, or with oxylabs.WithVerifyCredentials
+// if the API rejects them.
+func NewSerpClientAsync(
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*SerpClientAsync, error) {
+	return NewSerpClientAsyncCtx(context.Background(), username, password, opts...)
+}
+
+// NewSerpClientAsyncCtx returns a client for the Async runtime model.
+// The provided context bounds the verification of the credentials.
+func NewSerpClientAsyncCtx(
+	ctx context.Context,
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) (*SerpClientAsync, error) {
+	c, err := internal.NewClientCtx(ctx, internal.AsyncBaseUrl, username, password, opts...)
+	if err != nil {
+		return nil, err
 	}
+
+	return &SerpClientAsync{C: c}, nil
+}
+
+// Clone returns a copy of the client with the given client options applied,
+// e.g. for per-goroutine defaults. The connection pool is shared with the copy
+// unless the options change the HTTP transport.
+func (c *SerpClient) Clone(opts ...oxylabs.ClientOption) *SerpClient {
+	return &SerpClient{C: c.C.Clone(opts...)}
+}
+
+// Clone returns a copy of the client with the given client options applied,
+// e.g. for per-goroutine defaults. The connection pool is shared with the copy
+// unless the options change the HTTP transport.
+func (c *SerpClientAsync) Clone(opts ...oxylabs.ClientOption) *SerpClientAsync {
+	return &SerpClientAsync{C: c.C.Clone(opts...)}
+}
+
+// SetCredentials replaces the API credentials of the client without
+// recreating it, e.g. after a secret rotation. It is safe for concurrent use.
+func (c *SerpClient) SetCredentials(username string, password string) {
+	c.C.SetCredentials(username, password)
+}
+
+// SetCredentials replaces the API credentials of the client without
+// recreating it, e.g. after a secret rotation. It is safe for concurrent use.
+func (c *SerpClientAsync) SetCredentials(username string, password string) {
+	c.C.SetCredentials(username, password)
+}
+
+// Close rejects new reqs, waits for in-flight reqs to return and closes idle connections.
+func (c *SerpClient) Close() error {
+	return c.C.Close()
+}
+
+// Shutdown rejects new reqs and waits for in-flight reqs to return or ctx to be
+// done, then closes idle connections.
+func (c *SerpClient) Shutdown(ctx context.Context) error {
+	return c.C.Shutdown(ctx)
+}
+
+// Close stops issuing new polls, cancels in-flight polling and closes idle connections.
+func (c *SerpClientAsync) Close() error {
+	return c.C.Close()
+}
+
+// Shutdown stops issuing new polls and waits for in-flight polling to finish,
+// canceling it once ctx is done, then closes idle connections.
+func (c *SerpClientAsync) Shutdown(ctx context.Context) error {
+	return c.C.Shutdown(ctx)
+}
+
+// Ping validates the credentials and the reachability of the API, e.g. at
+// startup or in readiness probes. It costs no credits.
+func (c *SerpClient) Ping(ctx context.Context) error {
+	return c.C.Ping(ctx)
+}
+
+// Ping validates the credentials and the reachability of the API, e.g. at
+// startup or in readiness probes. It costs no credits.
+func (c *SerpClientAsync) Ping(ctx context.Context) error {
+	return c.C.Ping(ctx)
+}
+
+// Validate checks the query, url or product id and the opts of a scrape,
+// e.g. *GoogleSearchOpts, with the defaults of the client applied, as the
+// scrape would, without making the req.
+func (c *SerpClient) Validate(query string, opts interface{}) error {
+	return validate(c.C, query, opts)
+}
+
+// Validate checks the query, url or product id and the opts of a scrape,
+// e.g. *GoogleSearchOpts, with the defaults of the client applied, as the
+// scrape would, without making the req.
+func (c *SerpClientAsync) Validate(query string, opts interface{}) error {
+	return validate(c.C, query, opts)
 }
@@ -0,0 +1,273 @@
+package serp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/jobstore"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+// rewriteTransport sends all reqs, including the polling reqs
+// to data.oxylabs.io, to a test server.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClientAsync returns an async client backed by a test server
+// which completes every job immediately.
+func newTestClientAsync(t *testing.T) *SerpClientAsync {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": [{"content": "<html></html>", "job_id": "123", "status_code": 200}]}`))
+		default:
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	target, _ := url.Parse(server.URL)
+	c := InitAsync("username", "password")
+	c.C.BaseUrl = server.URL + "/v1/queries"
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	return c
+}
+
+func TestSerpClientAsync_AsyncResult(t *testing.T) {
+	c := newTestClientAsync(t)
+
+	res, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+
+	resp, ok := <-res.Results()
+	assert.True(t, ok)
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+
+	// A repeated receive must not block.
+	select {
+	case _, ok = <-res.Results():
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("receive from results channel blocked")
+	}
+	<-res.Done()
+	assert.NoError(t, res.Err())
+
+	// The scrape finishes without waiting for its resp to be received.
+	res, err = c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	select {
+	case <-res.Done():
+	case <-time.After(time.Second):
+		t.Fatal("scrape blocked until its resp was received")
+	}
+	resp, ok = <-res.Results()
+	assert.True(t, ok)
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+	assert.NoError(t, res.Err())
+}
+
+func TestSerpClientAsync_SubmitOnly(t *testing.T) {
+	c := newTestClientAsync(t)
+
+	jobID, err := c.SubmitOnly(context.Background(), "adidas", &GoogleSearchOpts{CallbackUrl: "https://example.com/callback"})
+	assert.NoError(t, err)
+	assert.Equal(t, "123", jobID)
+
+	_, err = c.SubmitOnly(context.Background(), "adidas", GoogleSearchOpts{})
+	assert.ErrorContains(t, err, "unsupported opts type serp.GoogleSearchOpts")
+
+	jobID, err = c.SubmitGoogleSearch(context.Background(), "adidas")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", jobID)
+
+	jobID, err = c.SubmitGoogleSearch(context.Background(), "adidas", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", jobID)
+}
+
+func TestSerpClientAsync_RetryFaultedJobs(t *testing.T) {
+	var submissions int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/1":
+			w.Write([]byte(`{"id": "1", "status": "faulted"}`))
+		case "/v1/queries/1/results":
+			w.Write([]byte(`{"results": [{"content": "", "status_code": 613}]}`))
+		case "/v1/queries/2":
+			w.Write([]byte(`{"id": "2", "status": "done"}`))
+		case "/v1/queries/2/results":
+			w.Write([]byte(`{"results": [{"content": "<html></html>", "job_id": "2", "status_code": 200}]}`))
+		default:
+			fmt.Fprintf(w, `{"id": "%d", "status": "pending"}`, atomic.AddInt32(&submissions, 1))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := InitAsync("username", "password", oxylabs.WithRetryFaultedJobs(1))
+	c.C.BaseUrl = server.URL + "/v1/queries"
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	res, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", (<-res.Results()).Results[0].JobID)
+	assert.Equal(t, int32(2), submissions)
+
+	// Without retries the fault is returned.
+	atomic.StoreInt32(&submissions, 0)
+	c = c.Clone(oxylabs.WithRetryFaultedJobs(0))
+	res, err = c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	_, ok := <-res.Results()
+	assert.False(t, ok)
+	var fault *oxylabs.JobFaultError
+	assert.ErrorAs(t, res.Err(), &fault)
+}
+
+func TestSerpClientAsync_Meta(t *testing.T) {
+	c := newTestClientAsync(t)
+	meta := map[string]string{"work_item": "42"}
+
+	res, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{PollInterval: time.Millisecond, Meta: meta})
+	assert.NoError(t, err)
+	assert.Equal(t, meta, (<-res.Results()).Meta)
+
+	res, err = c.ResumeJob("123", &ResumeJobOpts{PollInterval: time.Millisecond, Meta: meta})
+	assert.NoError(t, err)
+	assert.Equal(t, meta, (<-res.Results()).Meta)
+}
+
+func TestSerpClientAsync_ResumeJob(t *testing.T) {
+	var submissions int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/1":
+			w.Write([]byte(`{"id": "1", "status": "faulted"}`))
+		case "/v1/queries/2", "/v1/queries/3":
+			w.Write([]byte(`{"id": "3", "parse": true, "parsing_instructions": null, "status": "done"}`))
+		case "/v1/queries/2/results", "/v1/queries/3/results":
+			w.Write([]byte(`{"results": [{"content": {"results": {"organic": [{"pos": 1, "title": "Adidas"}]}}, "page": 1}]}`))
+		case "/v1/queries":
+			fmt.Fprintf(w, `{"id": "%d", "status": "pending"}`, atomic.AddInt32(&submissions, 1)+1)
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	store := jobstore.NewMemoryStore()
+	c := InitAsync("username", "password", oxylabs.WithJobStore(store), oxylabs.WithRetryFaultedJobs(1))
+	c.C.BaseUrl = server.URL + "/v1/queries"
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	// A job of the job store is decoded and resubmitted as it was submitted.
+	payload := []byte(`{"source": "google_search", "query": "adidas", "parse": true}`)
+	assert.NoError(t, store.Save(jobstore.Job{ID: "1", Payload: payload}))
+	res, err := c.ResumeJob("1", &ResumeJobOpts{PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	resp, ok := <-res.Results()
+	if assert.True(t, ok, res.Err()) {
+		assert.Equal(t, "Adidas", resp.Results[0].ContentParsed.Results.Organic[0].Title)
+	}
+	assert.Equal(t, int32(1), submissions)
+
+	// A job unknown to the job store is decoded as reported by the job endpoint.
+	res, err = c.ResumeJob("3", &ResumeJobOpts{PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	resp, ok = <-res.Results()
+	if assert.True(t, ok, res.Err()) {
+		assert.Equal(t, "Adidas", resp.Results[0].ContentParsed.Results.Organic[0].Title)
+	}
+}
+
+func TestSerpClientAsync_GetJobResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "start_page": 2}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": [
+				{"content": "<html>3</html>", "page": 3},
+				{"content": "<html>2</html>", "page": 2},
+				{"content": "<html>4</html>", "page": 4}
+			]}`))
+		case "/v1/queries/456":
+			w.Write([]byte(`{"id": "456", "parse": true}`))
+		case "/v1/queries/456/results":
+			w.Write([]byte(`{"results": [{"content": {"results": {"organic": [{"pos": 1, "title": "Adidas"}]}}, "page": 1}]}`))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := InitAsync("username", "password")
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	resp, err := c.GetJobResultsCtx(context.Background(), "123")
+	assert.NoError(t, err)
+	assert.Equal(t, "123", resp.Job.ID)
+	if assert.Len(t, resp.Results, 3) {
+		for i, result := range resp.Results {
+			assert.Equal(t, i+2, result.Page)
+			assert.Equal(t, fmt.Sprintf("<html>%d</html>", i+2), result.Content)
+		}
+	}
+
+	// The results of a parsed job are decoded as parsed.
+	resp, err = c.GetJobResultsCtx(context.Background(), "456")
+	assert.NoError(t, err)
+	assert.True(t, resp.Parse)
+	assert.Equal(t, "Adidas", resp.Results[0].ContentParsed.Results.Organic[0].Title)
+
+	_, err = c.GetJobResults("")
+	assert.Error(t, err)
+}
+
+func TestSerpClientAsync_CheckJobStatus(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			atomic.AddInt32(&polls, 1)
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "job not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := InitAsync("username", "password")
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	// A single status req is made, even if the job is not done.
+	status, err := c.CheckJobStatus(context.Background(), "123")
+	assert.NoError(t, err)
+	assert.Equal(t, oxylabs.JobPending, status)
+	assert.Equal(t, int32(1), polls)
+
+	_, err = c.CheckJobStatus(context.Background(), "456")
+	assert.ErrorContains(t, err, "404")
+
+	_, err = c.CheckJobStatus(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,185 @@
+package serp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/oxylabs/oxylabs-sdk-go/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerpClient_ConcurrentScrapes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password", oxylabs.WithDefaults(oxylabs.Defaults{
+		GeoLocation: "United States",
+		Render:      oxylabs.HTML,
+	}))
+	c.C.BaseUrl = server.URL
+
+	// A single opts struct shared by all goroutines must not be written to.
+	opts := &GoogleSearchOpts{Parse: false}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.ScrapeGoogleSearch("adidas", opts)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, &GoogleSearchOpts{}, opts)
+}
+
+func TestSerpClient_Clone(t *testing.T) {
+	c := Init("username", "password", oxylabs.WithMaxIdleConns(10))
+
+	clone := c.Clone(oxylabs.WithDefaults(oxylabs.Defaults{GeoLocation: "Germany"}))
+	assert.Equal(t, oxylabs.GeoLocation("Germany"), clone.C.Config.Defaults.GeoLocation)
+	assert.Empty(t, c.C.Config.Defaults.GeoLocation)
+	assert.Same(t, c.C.HttpClient, clone.C.HttpClient)
+
+	clone = c.Clone(oxylabs.WithMaxIdleConns(20))
+	assert.NotSame(t, c.C.HttpClient, clone.C.HttpClient)
+	assert.Equal(t, 10, c.C.Config.MaxIdleConns)
+}
+
+func TestNewSerpClient(t *testing.T) {
+	_, err := NewSerpClient("username", "")
+	assert.ErrorIs(t, err, oxylabs.ErrMissingCredentials)
+
+	provider := oxylabs.CredentialsProviderFunc(func(ctx context.Context) (string, string, error) {
+		return "username", "password", nil
+	})
+	c, err := NewSerpClientAsync("", "", oxylabs.WithCredentialsProvider(provider))
+	assert.NoError(t, err)
+	assert.Equal(t, internal.AsyncBaseUrl, c.C.BaseUrl)
+}
+
+func TestSerpClient_RequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	_, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{RequestTimeout: 20 * time.Millisecond})
+	assert.ErrorContains(t, err, "timeout error")
+
+	// The default timeout of the client applies if none is set.
+	_, err = c.ScrapeGoogleSearch("adidas", nil)
+	assert.NoError(t, err)
+}
+
+func TestSerpClient_QueryNormalization(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		sent, _ = payload["query"].(string)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password", oxylabs.WithQueryNormalization(oxylabs.QueryNormalization{Lowercase: true}))
+	c.C.BaseUrl = server.URL
+
+	_, err := c.ScrapeGoogleSearch("  Adidas\t\u200bShoes \n", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "adidas shoes", sent)
+
+	_, err = c.ScrapeGoogleSearch("adidas\xff", nil)
+	assert.ErrorIs(t, err, query.ErrInvalidUTF8)
+
+	_, err = c.ScrapeGoogleSearch(" \t\u200b", nil)
+	assert.EqualError(t, err, "query parameter is empty")
+
+	// The query is sent as given if normalization is disabled.
+	c = Init("username", "password", oxylabs.WithQueryNormalization(oxylabs.QueryNormalization{Disabled: true}))
+	c.C.BaseUrl = server.URL
+
+	_, err = c.ScrapeGoogleSearch(" Adidas  Shoes", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, " Adidas  Shoes", sent)
+}
+
+// fillOpts sets every field of the opts which is serialized into the payload.
+func fillOpts(opts reflect.Value) {
+	values := map[string]interface{}{
+		"Parse": true,
+		"ParseInstructions": &map[string]interface{}{
+			"title": map[string]interface{}{
+				"_fns": []map[string]interface{}{{"_fn": "xpath_one", "_args": []string{"//h1/text()"}}},
+			},
+		},
+		"CallbackUrl": "https://example.com/callback",
+		"GeoLocation": oxylabs.GeoLocation("United States"),
+		"Render":      oxylabs.HTML,
+		"Locale":      oxylabs.Locale("en"),
+		"UserAgent":   oxylabs.UA_DESKTOP,
+		"StartPage":   1,
+		"Pages":       2,
+		"Limit":       10,
+	}
+	for name, value := range values {
+		if field := opts.FieldByName(name); field.IsValid() {
+			field.Set(reflect.ValueOf(value).Convert(field.Type()))
+		}
+	}
+}
+
+func TestSerpClient_ScrapeOptsPassSourceParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": [{"content": {}, "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	client := reflect.ValueOf(c)
+	for i := 0; i < client.NumMethod(); i++ {
+		method := client.Type().Method(i)
+		if !strings.HasPrefix(method.Name, "Scrape") || !strings.HasSuffix(method.Name, "Ctx") ||
+			method.Type.NumIn() != 4 || !method.Type.IsVariadic() {
+			continue
+		}
+		t.Run(method.Name, func(t *testing.T) {
+			opts := reflect.New(method.Type.In(3).Elem().Elem())
+			fillOpts(opts.Elem())
+
+			query := "adidas"
+			if strings.HasPrefix(method.Name, "ScrapeBingUrl") {
+				query = "https://www.bing.com/search?q=adidas"
+			} else if strings.HasSuffix(method.Name, "UrlCtx") || method.Name == "ScrapeGoogleImagesCtx" {
+				query = "https://www.google.com/search?q=adidas"
+			}
+			out := client.Method(i).Call([]reflect.Value{
+				reflect.ValueOf(context.Background()), reflect.ValueOf(query), opts,
+			})
+			err, _ := out[1].Interface().(error)
+			assert.NoError(t, err)
+		})
+	}
+}
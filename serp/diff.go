@@ -0,0 +1,92 @@
+package serp
+
+import "sort"
+
+// RankChange is the change of the rank of a url in the organic results.
+// The rank is the 1-based position of the url across all pages, 0 if it is not ranked.
+type RankChange struct {
+	Url     string
+	Title   string
+	OldRank int
+	NewRank int
+}
+
+// Delta returns the number of positions gained, negative if positions were lost.
+func (c RankChange) Delta() int {
+	return c.OldRank - c.NewRank
+}
+
+// RankDiff is the difference between the organic results of two resps.
+type RankDiff struct {
+	Gained    []RankChange
+	Lost      []RankChange
+	Unchanged []RankChange
+	New       []RankChange
+	Removed   []RankChange
+}
+
+// Diff compares the organic results of two parsed resps for the same query.
+// Each slice of the returned diff is ordered by the new rank, Removed by the old rank.
+func Diff(before, after *Resp) *RankDiff {
+	oldRanks := organicRanks(before)
+	newRanks := organicRanks(after)
+
+	diff := &RankDiff{}
+	for url, n := range newRanks {
+		change := RankChange{Url: url, Title: n.title, NewRank: n.rank}
+		o, ok := oldRanks[url]
+		if !ok {
+			diff.New = append(diff.New, change)
+			continue
+		}
+
+		change.OldRank = o.rank
+		switch {
+		case change.Delta() > 0:
+			diff.Gained = append(diff.Gained, change)
+		case change.Delta() < 0:
+			diff.Lost = append(diff.Lost, change)
+		default:
+			diff.Unchanged = append(diff.Unchanged, change)
+		}
+	}
+	for url, o := range oldRanks {
+		if _, ok := newRanks[url]; !ok {
+			diff.Removed = append(diff.Removed, RankChange{Url: url, Title: o.title, OldRank: o.rank})
+		}
+	}
+
+	for _, changes := range [][]RankChange{diff.Gained, diff.Lost, diff.Unchanged, diff.New} {
+		sort.Slice(changes, func(i, j int) bool { return changes[i].NewRank < changes[j].NewRank })
+	}
+	sort.Slice(diff.Removed, func(i, j int) bool { return diff.Removed[i].OldRank < diff.Removed[j].OldRank })
+
+	return diff
+}
+
+type organicRank struct {
+	rank  int
+	title string
+}
+
+// organicRanks returns the rank of each url in the organic results of resp,
+// counting across pages. Only the first occurrence of a url is ranked.
+func organicRanks(resp *Resp) map[string]organicRank {
+	ranks := map[string]organicRank{}
+	if resp == nil {
+		return ranks
+	}
+
+	rank := 0
+	for _, result := range resp.Results {
+		for _, organic := range result.ContentParsed.Results.Organic {
+			if _, ok := ranks[organic.Url]; ok {
+				continue
+			}
+			rank++
+			ranks[organic.Url] = organicRank{rank: rank, title: organic.Title}
+		}
+	}
+
+	return ranks
+}
@@ -0,0 +1,34 @@
+package serp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func organicResp(urls ...string) *Resp {
+	result := Results{}
+	for i, url := range urls {
+		result.ContentParsed.Results.Organic = append(
+			result.ContentParsed.Results.Organic,
+			Organic{Pos: i + 1, Url: url},
+		)
+	}
+
+	return &Resp{Parse: true, Results: []Results{result}}
+}
+
+func TestDiff(t *testing.T) {
+	before := organicResp("a", "b", "c", "d")
+	after := organicResp("c", "a", "d", "e")
+
+	diff := Diff(before, after)
+	assert.Equal(t, []RankChange{
+		{Url: "c", OldRank: 3, NewRank: 1},
+		{Url: "d", OldRank: 4, NewRank: 3},
+	}, diff.Gained)
+	assert.Equal(t, []RankChange{{Url: "a", OldRank: 1, NewRank: 2}}, diff.Lost)
+	assert.Empty(t, diff.Unchanged)
+	assert.Equal(t, []RankChange{{Url: "e", NewRank: 4}}, diff.New)
+	assert.Equal(t, []RankChange{{Url: "b", OldRank: 2}}, diff.Removed)
+}
@@ -3,6 +3,7 @@ package serp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -32,198 +33,246 @@ var AcceptedSearchTypeParameters = []string{
 
 // checkParameterValidity checks validity of ScrapeGoogleSearch parameters.
 func (opt *GoogleSearchOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("limit, pages and start_page parameters must be greater than 0"))
 	}
 
 	if ctx["tbm"] != nil && !internal.InList(ctx["tbm"].(string), AcceptedTbmParameters) {
-		return fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"])
+		errs = append(errs, fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"]))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleUrl parameters.
 func (opt *GoogleUrlOpts) checkParameterValidity() error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleAds parameters.
 func (opt *GoogleAdsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if ctx["tbm"] != nil && !internal.InList(ctx["tbm"].(string), AcceptedTbmParameters) {
-		return fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"])
+		errs = append(errs, fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"]))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleSuggestions parameters.
 func (opt *GoogleSuggestionsOpts) checkParameterValidity() error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleHotels parameters.
 func (opt *GoogleHotelsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("limit, pages and start_page parameters must be greater than 0"))
 	}
 
 	if ctx["hotel_occupancy"] != nil && ctx["hotel_occupancy"].(int) < 0 {
-		return fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"])
+		errs = append(errs, fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"]))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleTravelHotels parameters.
 func (opt *GoogleTravelHotelsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.StartPage <= 0 {
-		return fmt.Errorf("start_page must be greater than 0")
+		errs = append(errs, fmt.Errorf("start_page must be greater than 0"))
 	}
 
 	if ctx["hotel_occupancy"] != nil && ctx["hotel_occupancy"].(int) < 0 {
-		return fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"])
+		errs = append(errs, fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"]))
 	}
 
 	if ctx["hotel_classes"] != nil {
 		for _, value := range ctx["hotel_classes"].([]int) {
 			if value < 2 || value > 5 {
-				return fmt.Errorf("invalid hotel_classes parameter: %v", value)
+				errs = append(errs, fmt.Errorf("invalid hotel_classes parameter: %v", value))
 			}
 		}
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleTrendsExplore parameters.
 func (opt *GoogleTrendsExploreOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if ctx["search_type"] != nil && !internal.InList(ctx["search_type"].(string), AcceptedSearchTypeParameters) {
-		return fmt.Errorf("invalid search_type parameter: %v", ctx["search_type"])
+		errs = append(errs, fmt.Errorf("invalid search_type parameter: %v", ctx["search_type"]))
 	}
 
 	if ctx["category_id"] != nil && ctx["category_id"].(int) < 0 {
-		return fmt.Errorf("invalid category_id")
+		errs = append(errs, fmt.Errorf("invalid category_id"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleImages parameters.
 func (opt *GoogleImagesOpts) checkParameterValidity() error {
+	var errs []error
+
+	if opt.GeoLocation != "" && !oxylabs.IsGeoLocationValid(opt.GeoLocation) {
+		errs = append(errs, fmt.Errorf("invalid geo_location parameter: %v", opt.GeoLocation))
+	}
+
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // GoogleSearchOpts contains all the query parameters available for google_search.
@@ -233,22 +282,44 @@ type GoogleSearchOpts struct {
 	Pages             int
 	Limit             int
 	Locale            oxylabs.Locale
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
 	Context           []func(oxylabs.ContextOption)
 }
 
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleSearchOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleSearchOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Limit:             &opt.Limit,
+		Locale:            &opt.Locale,
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+		Context:           &opt.Context,
+	}
+}
+
 // ScrapeGoogleSearch scrapes google via Oxylabs SERP API with google_search as source.
 func (c *SerpClient) ScrapeGoogleSearch(
 	query string,
 	opts ...*GoogleSearchOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleSearchCtx(ctx, query, opts...)
@@ -261,10 +332,27 @@ func (c *SerpClient) ScrapeGoogleSearchCtx(
 	query string,
 	opts ...*GoogleSearchOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleSearch(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleSearch applies the defaults to the opts of a scrape with google_search as source,
+// checks them and returns the req.
+func prepareGoogleSearch(
+	c *internal.Client,
+	query string,
+	opts []*GoogleSearchOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map and apply each provided context modifier function.
@@ -281,14 +369,16 @@ func (c *SerpClient) ScrapeGoogleSearchCtx(
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
+	err = opt.checkParameterValidity(context)
 	if err != nil {
 		return nil, err
 	}
@@ -357,31 +447,43 @@ func (c *SerpClient) ScrapeGoogleSearchCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleUrlOpts contains all the query parameters available for google.
 type GoogleUrlOpts struct {
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	CallbackUrl       string
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleUrlOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleUrlOpts",
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		CallbackUrl:       &opt.CallbackUrl,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // ScrapeGoogleUrl scrapes google via Oxylabs SERP API with google as source.
@@ -389,7 +491,7 @@ func (c *SerpClient) ScrapeGoogleUrl(
 	url string,
 	opts ...*GoogleUrlOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleUrlCtx(ctx, url, opts...)
@@ -402,8 +504,23 @@ func (c *SerpClient) ScrapeGoogleUrlCtx(
 	url string,
 	opts ...*GoogleUrlOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleUrl(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleUrl applies the defaults to the opts of a scrape with google as source,
+// checks them and returns the req.
+func prepareGoogleUrl(
+	c *internal.Client,
+	url string,
+	opts []*GoogleUrlOpts,
+) (*scrapeReq, error) {
 	// Check validity of URL.
-	err := internal.ValidateUrl(url, "google")
+	err := c.CheckUrl(url, "google")
 	if err != nil {
 		return nil, err
 	}
@@ -411,11 +528,13 @@ func (c *SerpClient) ScrapeGoogleUrlCtx(
 	// Prepare options.
 	opt := &GoogleUrlOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
 
 	// Check validity of parameters.
 	err = opt.checkParameterValidity()
@@ -446,20 +565,16 @@ func (c *SerpClient) ScrapeGoogleUrlCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleAdsOpts contains all the query parameters available for google_ads.
@@ -468,22 +583,43 @@ type GoogleAdsOpts struct {
 	StartPage         int
 	Pages             int
 	Locale            string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
 	Context           []func(oxylabs.ContextOption)
 }
 
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleAdsOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleAdsOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Locale:            (*oxylabs.Locale)(&opt.Locale),
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+		Context:           &opt.Context,
+	}
+}
+
 // ScrapeGoogleAds scrapes google via Oxylabs SERP API with google_ads as source.
 func (c *SerpClient) ScrapeGoogleAds(
 	query string,
 	opts ...*GoogleAdsOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleAdsCtx(ctx, query, opts...)
@@ -496,10 +632,27 @@ func (c *SerpClient) ScrapeGoogleAdsCtx(
 	query string,
 	opts ...*GoogleAdsOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleAds(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleAds applies the defaults to the opts of a scrape with google_ads as source,
+// checks them and returns the req.
+func prepareGoogleAds(
+	c *internal.Client,
+	query string,
+	opts []*GoogleAdsOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleAdsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map apply each provided context modifier function.
@@ -509,13 +662,16 @@ func (c *SerpClient) ScrapeGoogleAdsCtx(
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
+	err = opt.checkParameterValidity(context)
 	if err != nil {
 		return nil, err
 	}
@@ -564,39 +720,53 @@ func (c *SerpClient) ScrapeGoogleAdsCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleSuggestionsOpts contains all the query parameters available for google_shopping.
 type GoogleSuggestionsOpts struct {
 	Locale            string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
 	CallbackUrl       string
 }
 
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleSuggestionsOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleSuggestionsOpts",
+		Locale:            (*oxylabs.Locale)(&opt.Locale),
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+		CallbackUrl:       &opt.CallbackUrl,
+	}
+}
+
 // ScrapeGoogleSuggestions scrapes google via Oxylabs SERP API with google_suggestions as source.
 func (c *SerpClient) ScrapeGoogleSuggestions(
 	query string,
 	opts ...*GoogleSuggestionsOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleSuggestionsCtx(ctx, query, opts...)
@@ -609,17 +779,40 @@ func (c *SerpClient) ScrapeGoogleSuggestionsCtx(
 	query string,
 	opts ...*GoogleSuggestionsOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleSuggestions(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleSuggestions applies the defaults to the opts of a scrape with google_suggest as source,
+// checks them and returns the req.
+func prepareGoogleSuggestions(
+	c *internal.Client,
+	query string,
+	opts []*GoogleSuggestionsOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleSuggestionsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity()
+	err = opt.checkParameterValidity()
 	if err != nil {
 		return nil, err
 	}
@@ -635,9 +828,11 @@ func (c *SerpClient) ScrapeGoogleSuggestionsCtx(
 		"callback_url":    opt.CallbackUrl,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
+		payload["parse"] = true
 		payload["parsing_instructions"] = &opt.ParseInstructions
 		customParserFlag = true
 	}
@@ -647,20 +842,16 @@ func (c *SerpClient) ScrapeGoogleSuggestionsCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleHotelsOpts contains all the query parameters available for google_hotels.
@@ -670,21 +861,44 @@ type GoogleHotelsOpts struct {
 	Pages             int
 	Limit             int
 	Locale            string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
+	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
 	Context           []func(oxylabs.ContextOption)
 }
 
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleHotelsOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleHotelsOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Limit:             &opt.Limit,
+		Locale:            (*oxylabs.Locale)(&opt.Locale),
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+		Context:           &opt.Context,
+	}
+}
+
 // ScrapeGoogleHotels scrapes google via Oxylabs SERP API with google_hotels as source.
 func (c *SerpClient) ScrapeGoogleHotels(
 	query string,
 	opts ...*GoogleHotelsOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleHotelsCtx(ctx, query, opts...)
@@ -697,10 +911,27 @@ func (c *SerpClient) ScrapeGoogleHotelsCtx(
 	query string,
 	opts ...*GoogleHotelsOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleHotels(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleHotels applies the defaults to the opts of a scrape with google_hotels as source,
+// checks them and returns the req.
+func prepareGoogleHotels(
+	c *internal.Client,
+	query string,
+	opts []*GoogleHotelsOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleHotelsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map apply each provided context modifier function.
@@ -710,15 +941,17 @@ func (c *SerpClient) ScrapeGoogleHotelsCtx(
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
 	internal.SetDefaultHotelOccupancy(context)
 
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
+	err = opt.checkParameterValidity(context)
 	if err != nil {
 		return nil, err
 	}
@@ -756,7 +989,8 @@ func (c *SerpClient) ScrapeGoogleHotelsCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
 		payload["parse"] = true
@@ -769,20 +1003,16 @@ func (c *SerpClient) ScrapeGoogleHotelsCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleTravelHotelsOpts contains all the query parameters available for google_travel_hotels.
@@ -790,21 +1020,42 @@ type GoogleTravelHotelsOpts struct {
 	Domain            oxylabs.Domain
 	StartPage         int
 	Locale            string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
+	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
 	Context           []func(oxylabs.ContextOption)
 }
 
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleTravelHotelsOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleTravelHotelsOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Locale:            (*oxylabs.Locale)(&opt.Locale),
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+		Context:           &opt.Context,
+	}
+}
+
 // ScrapeGoogleTravelHotels scrapes google via Oxylabs SERP API with google_travel_hotels as source.
 func (c *SerpClient) ScrapeGoogleTravelHotels(
 	query string,
 	opts ...*GoogleTravelHotelsOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleTravelHotelsCtx(ctx, query, opts...)
@@ -817,10 +1068,27 @@ func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
 	query string,
 	opts ...*GoogleTravelHotelsOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleTravelHotels(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleTravelHotels applies the defaults to the opts of a scrape with google_travel_hotels as source,
+// checks them and returns the req.
+func prepareGoogleTravelHotels(
+	c *internal.Client,
+	query string,
+	opts []*GoogleTravelHotelsOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleTravelHotelsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map apply each provided context modifier function.
@@ -830,11 +1098,16 @@ func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
 	}
 
 	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
+	err = opt.checkParameterValidity(context)
 	if err != nil {
 		return nil, err
 	}
@@ -866,7 +1139,8 @@ func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
 		payload["parse"] = true
@@ -879,20 +1153,16 @@ func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleImagesOpts contains all the query parameters available for google_images.
@@ -901,22 +1171,43 @@ type GoogleImagesOpts struct {
 	StartPage         int
 	Pages             int
 	Locale            string
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
 	CallbackUrl       string
 	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
 	Context           []func(oxylabs.ContextOption)
 }
 
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleImagesOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleImagesOpts",
+		Domain:            &opt.Domain,
+		StartPage:         &opt.StartPage,
+		Pages:             &opt.Pages,
+		Locale:            (*oxylabs.Locale)(&opt.Locale),
+		GeoLocation:       &opt.GeoLocation,
+		UserAgent:         &opt.UserAgent,
+		Render:            &opt.Render,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+		Context:           &opt.Context,
+	}
+}
+
 // ScrapeGoogleImages scrapes google via Oxylabs SERP API with google_images as source.
 func (c *SerpClient) ScrapeGoogleImages(
 	url string,
 	opts ...*GoogleImagesOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleImagesCtx(ctx, url, opts...)
@@ -929,10 +1220,33 @@ func (c *SerpClient) ScrapeGoogleImagesCtx(
 	url string,
 	opts ...*GoogleImagesOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleImages(c.C, url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleImages applies the defaults to the opts of a scrape with google_images as source,
+// checks them and returns the req.
+func prepareGoogleImages(
+	c *internal.Client,
+	url string,
+	opts []*GoogleImagesOpts,
+) (*scrapeReq, error) {
+	// Check validity of URL.
+	err := c.CheckUrl(url, "google")
+	if err != nil {
+		return nil, err
+	}
+
 	// Prepare options.
 	opt := &GoogleImagesOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map apply each provided context modifier function.
@@ -942,13 +1256,10 @@ func (c *SerpClient) ScrapeGoogleImagesCtx(
 	}
 
 	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity()
+	err = opt.checkParameterValidity()
 	if err != nil {
 		return nil, err
 	}
@@ -990,31 +1301,43 @@ func (c *SerpClient) ScrapeGoogleImagesCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
-
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
 
 // GoogleTrendsExploreOpts contains all the query parameters available for google_trends_explore.
 type GoogleTrendsExploreOpts struct {
-	GeoLocation       string
+	GeoLocation       oxylabs.GeoLocation
 	Context           []func(oxylabs.ContextOption)
 	UserAgent         oxylabs.UserAgent
 	CallbackUrl       string
+	Parse             bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	RequestTimeout    time.Duration
+	Meta              map[string]string
+}
+
+// fields returns the fields of the opts which are shared between sources.
+func (opt *GoogleTrendsExploreOpts) fields() internal.Fields {
+	return internal.Fields{
+		Opts:              "GoogleTrendsExploreOpts",
+		GeoLocation:       &opt.GeoLocation,
+		Context:           &opt.Context,
+		UserAgent:         &opt.UserAgent,
+		CallbackUrl:       &opt.CallbackUrl,
+		Parse:             &opt.Parse,
+		ParseInstructions: &opt.ParseInstructions,
+		PollInterval:      &opt.PollInterval,
+	}
 }
 
 // ScrapeGoogleTrendsExplore scrapes google via Oxylabs SERP API with google_trends_explore as source.
@@ -1022,7 +1345,7 @@ func (c *SerpClient) ScrapeGoogleTrendsExplore(
 	query string,
 	opts ...*GoogleTrendsExploreOpts,
 ) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.Settings().Timeout, internal.LastOpts(opts).RequestTimeout))
 	defer cancel()
 
 	return c.ScrapeGoogleTrendsExploreCtx(ctx, query, opts...)
@@ -1035,10 +1358,27 @@ func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
 	query string,
 	opts ...*GoogleTrendsExploreOpts,
 ) (*Resp, error) {
+	req, err := prepareGoogleTrendsExplore(c.C, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.scrape(ctx, req)
+}
+
+// prepareGoogleTrendsExplore applies the defaults to the opts of a scrape with google_trends_explore as source,
+// checks them and returns the req.
+func prepareGoogleTrendsExplore(
+	c *internal.Client,
+	query string,
+	opts []*GoogleTrendsExploreOpts,
+) (*scrapeReq, error) {
 	// Prepare options.
 	opt := &GoogleTrendsExploreOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
+		// Copy so that defaults are not written to the caller's opts.
+		o := *opts[len(opts)-1]
+		opt = &o
 	}
 
 	// Initialize the context map apply each provided context modifier function.
@@ -1048,10 +1388,16 @@ func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
 	}
 
 	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
+	c.ApplyDefaults(opt.fields(), internal.DefaultLimit_SERP)
+
+	// Normalize the query.
+	query, err := c.NormalizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
+	err = opt.checkParameterValidity(context)
 	if err != nil {
 		return nil, err
 	}
@@ -1087,9 +1433,11 @@ func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
 		payload["geo_location"] = opt.GeoLocation
 	}
 
-	// Add custom parsing instructions to the payload if provided.
+	// Request the built-in parser, or the custom parser if parsing instructions are provided.
+	payload["parse"] = opt.Parse
 	customParserFlag := false
 	if opt.ParseInstructions != nil {
+		payload["parse"] = true
 		payload["parsing_instructions"] = &opt.ParseInstructions
 		customParserFlag = true
 	}
@@ -1099,18 +1447,14 @@ func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %v", err)
 	}
-
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
-	if err != nil {
+	if err := internal.CheckPayload(jsonPayload); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return &scrapeReq{
+		Payload:      jsonPayload,
+		PollInterval: opt.PollInterval,
+		Strategy:     oxylabs.DecodeStrategyFor(opt.Parse, customParserFlag),
+		Meta:         opt.Meta,
+	}, nil
 }
@@ -2,12 +2,8 @@ package serp
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/oxylabs/oxylabs-sdk-go/internal"
-	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
 )
 
 // ScrapeGoogleSearch scrapes google with async polling runtime via Oxylabs SERP API
@@ -15,11 +11,11 @@ import (
 func (c *SerpClientAsync) ScrapeGoogleSearch(
 	query string,
 	opts ...*GoogleSearchOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleSearchCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleSearchCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleSearchCtx scrapes google with async polling runtime via Oxylabs SERP API
@@ -29,143 +25,37 @@ func (c *SerpClientAsync) ScrapeGoogleSearchCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleSearchOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Check if limit_per_page context parameter is used together with limit, start_page or pages parameters.
-	if (opt.Limit != 0 || opt.StartPage != 0 || opt.Pages != 0) && context["limit_per_page"] != nil {
-		return nil, fmt.Errorf(
-			"limit, start_page and pages parameters cannot be used together with limit_per_page context parameter",
-		)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleSearch,
-		"domain":          opt.Domain,
-		"query":           query,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"parse":           opt.Parse,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "filter",
-				"value": context["filter"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "safe_search",
-				"value": context["safe_search"],
-			},
-			{
-				"key":   "fpstate",
-				"value": context["fpstate"],
-			},
-			{
-				"key":   "tbm",
-				"value": context["tbm"],
-			},
-			{
-				"key":   "tbs",
-				"value": context["tbs"],
-			},
-		},
-	}
-
-	// If user sends limit_per_page context parameter, use it instead of limit, start_page, and pages parameters.
-	if context["limit_per_page"] != nil {
-		payload["limit_per_page"] = context["limit_per_page"]
-	} else {
-		payload["start_page"] = opt.StartPage
-		payload["pages"] = opt.Pages
-		payload["limit"] = opt.Limit
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleSearch(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitGoogleSearch submits the job and returns it without polling.
+func (c *SerpClientAsync) submitGoogleSearch(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSearchOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleSearch(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleSearch submits a job via Oxylabs SERP API with google_search as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitGoogleSearch(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSearchOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleSearch(ctx, query, opts...))
 }
 
 // ScrapeGoogleUrl scrapes google with async polling runtime via Oxylabs SERP API
@@ -173,11 +63,11 @@ func (c *SerpClientAsync) ScrapeGoogleSearchCtx(
 func (c *SerpClientAsync) ScrapeGoogleUrl(
 	url string,
 	opts ...*GoogleUrlOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleUrlCtx(ctx, url, opts...)
 
-	return c.ScrapeGoogleUrlCtx(ctx, url, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleUrlCtx scrapes google with async polling runtime via Oxylabs SERP API
@@ -187,91 +77,37 @@ func (c *SerpClientAsync) ScrapeGoogleUrlCtx(
 	ctx context.Context,
 	url string,
 	opts ...*GoogleUrlOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Check validity of URL.
-	err := internal.ValidateUrl(url, "google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"geo_location":    opt.GeoLocation,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleUrl(ctx, url, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitGoogleUrl submits the job and returns it without polling.
+func (c *SerpClientAsync) submitGoogleUrl(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleUrlOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleUrl(c.C, url, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleUrl submits a job via Oxylabs SERP API with google as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitGoogleUrl(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleUrlOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleUrl(ctx, url, opts...))
 }
 
 // ScrapeGoogleAds scrapes google with async polling runtime via Oxylabs SERP API
@@ -279,11 +115,11 @@ func (c *SerpClientAsync) ScrapeGoogleUrlCtx(
 func (c *SerpClientAsync) ScrapeGoogleAds(
 	query string,
 	opts ...*GoogleAdsOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleAdsCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleAdsCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleAdsCtx scrapes google with async polling runtime via Oxylabs SERP API
@@ -293,115 +129,37 @@ func (c *SerpClientAsync) ScrapeGoogleAdsCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleAdsOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleAdsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleAds,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"parse":           opt.Parse,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "tbm",
-				"value": context["tbm"],
-			},
-			{
-				"key":   "tbs",
-				"value": context["tbs"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleAds(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitGoogleAds submits the job and returns it without polling.
+func (c *SerpClientAsync) submitGoogleAds(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleAdsOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleAds(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleAds submits a job via Oxylabs SERP API with google_ads as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitGoogleAds(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleAdsOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleAds(ctx, query, opts...))
 }
 
 // ScrapeGoogleSuggestions scrapes google with async polling runtime via Oxylabs SERP API
@@ -409,11 +167,11 @@ func (c *SerpClientAsync) ScrapeGoogleAdsCtx(
 func (c *SerpClientAsync) ScrapeGoogleSuggestions(
 	query string,
 	opts ...*GoogleSuggestionsOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleSuggestionsCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleSuggestionsCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleSuggestionsCtx scrapes google with async polling runtime via Oxylabs SERP API
@@ -423,85 +181,37 @@ func (c *SerpClientAsync) ScrapeGoogleSuggestionsCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleSuggestionsOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleSuggestionsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleSuggestions(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleSuggestions,
-		"query":           query,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+// submitGoogleSuggestions submits the job and returns it without polling.
+func (c *SerpClientAsync) submitGoogleSuggestions(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSuggestionsOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleSuggestions(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleSuggestions submits a job via Oxylabs SERP API with google_suggest as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitGoogleSuggestions(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSuggestionsOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleSuggestions(ctx, query, opts...))
 }
 
 // ScrapeGoogleHotels scrapes google with async polling runtime via Oxylabs SERP API
@@ -509,11 +219,11 @@ func (c *SerpClientAsync) ScrapeGoogleSuggestionsCtx(
 func (c *SerpClientAsync) ScrapeGoogleHotels(
 	query string,
 	opts ...*GoogleHotelsOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleHotelsCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleHotelsCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleHotelsCtx scrapes google with async polling runtime via Oxylabs SERP API
@@ -523,118 +233,37 @@ func (c *SerpClientAsync) ScrapeGoogleHotelsCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleHotelsOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleHotelsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleHotels,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"limit":           opt.Limit,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "hotel_occupancy",
-				"value": context["hotel_occupancy"],
-			},
-			{
-				"key":   "hotel_dates",
-				"value": context["hotel_dates"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleHotels(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+// submitGoogleHotels submits the job and returns it without polling.
+func (c *SerpClientAsync) submitGoogleHotels(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleHotelsOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleHotels(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleHotels submits a job via Oxylabs SERP API with google_hotels as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitGoogleHotels(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleHotelsOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleHotels(ctx, query, opts...))
 }
 
 // ScrapeGoogleTravelHotels scrapes google with async polling runtime via Oxylabs SERP API
@@ -642,11 +271,11 @@ func (c *SerpClientAsync) ScrapeGoogleHotelsCtx(
 func (c *SerpClientAsync) ScrapeGoogleTravelHotels(
 	query string,
 	opts ...*GoogleTravelHotelsOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleTravelHotelsCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleTravelHotelsCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleTravelHotelsCtx scrapes google with async polling runtime via Oxylabs SERP API
@@ -656,109 +285,37 @@ func (c *SerpClientAsync) ScrapeGoogleTravelHotelsCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleTravelHotelsOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleTravelHotelsOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleTravelHotels,
-		"domain":          opt.Domain,
-		"query":           query,
-		"start_page":      opt.StartPage,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"context": []map[string]interface{}{
-			{
-				"key":   "hotel_occupancy",
-				"value": context["hotel_occupancy"],
-			},
-			{
-				"key":   "hotel_classes",
-				"value": context["hotel_classes"],
-			},
-			{
-				"key":   "hotel_dates",
-				"value": context["hotel_dates"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleTravelHotels(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+// submitGoogleTravelHotels submits the job and returns it without polling.
+func (c *SerpClientAsync) submitGoogleTravelHotels(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleTravelHotelsOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleTravelHotels(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleTravelHotels submits a job via Oxylabs SERP API with google_travel_hotels as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitGoogleTravelHotels(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleTravelHotelsOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleTravelHotels(ctx, query, opts...))
 }
 
 // ScrapeGoogleImages scrapes google with async polling runtime via Oxylabs SERP API
@@ -766,11 +323,11 @@ func (c *SerpClientAsync) ScrapeGoogleTravelHotelsCtx(
 func (c *SerpClientAsync) ScrapeGoogleImages(
 	url string,
 	opts ...*GoogleImagesOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleImagesCtx(ctx, url, opts...)
 
-	return c.ScrapeGoogleImagesCtx(ctx, url, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleImagesCtx scrapes google with async polling runtime via Oxylabs SERP API
@@ -780,108 +337,37 @@ func (c *SerpClientAsync) ScrapeGoogleImagesCtx(
 	ctx context.Context,
 	url string,
 	opts ...*GoogleImagesOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Check validity of URL.
-	err := internal.ValidateUrl(url, "google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleImagesOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultPages(&opt.Pages)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleImages(ctx, url, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleImages,
-		"domain":          opt.Domain,
-		"query":           url,
-		"start_page":      opt.StartPage,
-		"pages":           opt.Pages,
-		"locale":          opt.Locale,
-		"geo_location":    opt.GeoLocation,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"parse":           opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "results_language",
-				"value": context["results_language"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// submitGoogleImages submits the job and returns it without polling.
+func (c *SerpClientAsync) submitGoogleImages(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleImagesOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleImages(c.C, url, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleImages submits a job via Oxylabs SERP API with google_images as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitGoogleImages(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleImagesOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleImages(ctx, url, opts...))
 }
 
 // ScrapeGoogleTrendsExplore scrapes google with async polling runtime via Oxylabs SERP API
@@ -889,11 +375,11 @@ func (c *SerpClientAsync) ScrapeGoogleImagesCtx(
 func (c *SerpClientAsync) ScrapeGoogleTrendsExplore(
 	query string,
 	opts ...*GoogleTrendsExploreOpts,
-) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
+) (*AsyncResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	res, err := c.ScrapeGoogleTrendsExploreCtx(ctx, query, opts...)
 
-	return c.ScrapeGoogleTrendsExploreCtx(ctx, query, opts...)
+	return internal.CancelOnDone(cancel, res, err)
 }
 
 // ScrapeGoogleTrendsExploreCtx scrapes google with async polling runtime via Oxylabs SERP API
@@ -903,105 +389,35 @@ func (c *SerpClientAsync) ScrapeGoogleTrendsExploreCtx(
 	ctx context.Context,
 	query string,
 	opts ...*GoogleTrendsExploreOpts,
-) (chan *Resp, error) {
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-	errChan := make(chan error)
-
-	// Prepare options.
-	opt := &GoogleTrendsExploreOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
+) (*AsyncResult, error) {
+	job, err := c.submitGoogleTrendsExplore(ctx, query, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":       oxylabs.GoogleTrendsExplore,
-		"query":        query,
-		"geo_location": opt.GeoLocation,
-		"context": []map[string]interface{}{
-			{
-				"key":   "search_type",
-				"value": context["search_type"],
-			},
-			{
-				"key":   "date_from",
-				"value": context["date_from"],
-			},
-			{
-				"key":   "date_to",
-				"value": context["date_to"],
-			},
-			{
-				"key":   "category_id",
-				"value": context["category_id"],
-			},
-		},
-		"user_agent_type": opt.UserAgent,
-		"callback_url":    opt.CallbackUrl,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
+	return c.poll(ctx, job), nil
+}
 
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+// submitGoogleTrendsExplore submits the job and returns it without polling.
+func (c *SerpClientAsync) submitGoogleTrendsExplore(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleTrendsExploreOpts,
+) (*asyncJob, error) {
+	req, err := prepareGoogleTrendsExplore(c.C, query, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
+	return c.submit(ctx, req)
+}
 
-	return respChan, nil
+// SubmitGoogleTrendsExplore submits a job via Oxylabs SERP API with google_trends_explore as source
+// and returns its ID without polling, see SubmitOnly.
+func (c *SerpClientAsync) SubmitGoogleTrendsExplore(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleTrendsExploreOpts,
+) (string, error) {
+	return c.submitOnly(c.submitGoogleTrendsExplore(ctx, query, opts...))
 }
@@ -0,0 +1,46 @@
+package serp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoogleSearchOpts_checkParameterValidity_AggregatesErrors(t *testing.T) {
+	opt := &GoogleSearchOpts{
+		UserAgent: "invalid",
+		Render:    "invalid",
+		Limit:     10,
+		Pages:     0,
+		StartPage: 1,
+	}
+	ctx := oxylabs.ContextOption{"tbm": "invalid"}
+
+	err := opt.checkParameterValidity(ctx)
+	assert.ErrorContains(t, err, "invalid user agent parameter: invalid")
+	assert.ErrorContains(t, err, "invalid render parameter: invalid")
+	assert.ErrorContains(t, err, "limit, pages and start_page parameters must be greater than 0")
+	assert.ErrorContains(t, err, "invalid tbm parameter: invalid")
+}
+
+func TestSerpClient_ScrapeGoogleSuggestions_Parse(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"results": [{"content": {"suggestions": []}, "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	resp, err := c.ScrapeGoogleSuggestions("adidas", &GoogleSuggestionsOpts{Parse: true})
+	assert.NoError(t, err)
+	assert.True(t, resp.Parse)
+	assert.Equal(t, true, payload["parse"])
+	assert.Nil(t, payload["parsing_instructions"])
+}
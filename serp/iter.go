@@ -0,0 +1,84 @@
+//go:build go1.23
+
+package serp
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// PageResult is a single page of search results yielded by SearchAll.
+type PageResult struct {
+	Page int
+	Resp *Resp
+}
+
+// SearchAll returns an iterator over the pages of google search results for the query,
+// scraping each page when the iteration reaches it:
+//
+//	for page, err := range c.SearchAll(ctx, "adidas", &serp.GoogleSearchOpts{Parse: true}) {
+//		...
+//	}
+//
+// Iteration starts at StartPage and covers Pages pages. If Pages is not set,
+// iteration continues until the last page with organic results, which requires Parse.
+// Iteration stops after the first error.
+func (c *SerpClient) SearchAll(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSearchOpts,
+) iter.Seq2[*PageResult, error] {
+	return func(yield func(*PageResult, error) bool) {
+		// Prepare options.
+		opt := &GoogleSearchOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that the page options are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+
+		if opt.Pages == 0 && !opt.Parse {
+			yield(nil, fmt.Errorf("pages parameter must be set unless parse is enabled"))
+			return
+		}
+
+		startPage := opt.StartPage
+		if startPage == 0 {
+			startPage = c.C.Settings().StartPage
+		}
+
+		for page := startPage; opt.Pages == 0 || page < startPage+opt.Pages; page++ {
+			pageOpt := *opt
+			pageOpt.StartPage = page
+			pageOpt.Pages = 1
+
+			resp, err := c.ScrapeGoogleSearchCtx(ctx, query, &pageOpt)
+			if err != nil {
+				yield(nil, fmt.Errorf("error scraping page %d: %w", page, err))
+				return
+			}
+			if !yield(&PageResult{Page: page, Resp: resp}, nil) {
+				return
+			}
+
+			if opt.Pages == 0 && lastPage(resp, page) {
+				return
+			}
+		}
+	}
+}
+
+// lastPage reports whether the parsed resp of the page is the last page with results.
+func lastPage(resp *Resp, page int) bool {
+	if len(resp.Results) == 0 {
+		return true
+	}
+
+	content := resp.Results[0].ContentParsed
+	if len(content.Results.Organic) == 0 {
+		return true
+	}
+
+	return content.LastVisiblePage != 0 && page >= content.LastVisiblePage
+}
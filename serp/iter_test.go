@@ -0,0 +1,53 @@
+//go:build go1.23
+
+package serp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerpClient_SearchAll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		page := int(payload["start_page"].(float64))
+
+		organic := `[{"pos": 1, "url": "https://www.adidas.com"}]`
+		if page == 3 {
+			organic = `[]`
+		}
+		fmt.Fprintf(w, `{"results": [{"content": {"page": %d, "results": {"organic": %s}}, "page": %d, "status_code": 200}]}`, page, organic, page)
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	var pages []int
+	for page, err := range c.SearchAll(context.Background(), "adidas", &GoogleSearchOpts{Parse: true}) {
+		assert.NoError(t, err)
+		pages = append(pages, page.Page)
+	}
+	assert.Equal(t, []int{1, 2, 3}, pages)
+
+	pages = nil
+	for page, err := range c.SearchAll(context.Background(), "adidas", &GoogleSearchOpts{StartPage: 2, Pages: 5, Parse: true}) {
+		assert.NoError(t, err)
+		pages = append(pages, page.Page)
+		if page.Page == 4 {
+			break
+		}
+	}
+	assert.Equal(t, []int{2, 3, 4}, pages)
+
+	for _, err := range c.SearchAll(context.Background(), "adidas") {
+		assert.ErrorContains(t, err, "pages parameter must be set")
+	}
+}
@@ -0,0 +1,94 @@
+package serp
+
+import (
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Option is a functional option for the opts structs of this package.
+// Options leave all other parameters untouched, so their defaults stay intact.
+type Option = internal.Option
+
+// NewOpts returns a new opts struct of type T with the given options applied, e.g.
+//
+//	opts, err := serp.NewOpts[serp.GoogleSearchOpts](serp.WithUserAgent(oxylabs.UA_MOBILE))
+//
+// An error is returned if an option is not supported by T.
+func NewOpts[T any, P interface {
+	*T
+	fields() internal.Fields
+}](options ...Option) (*T, error) {
+	opts := new(T)
+	if err := internal.ApplyOptions(P(opts).fields(), options); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// WithDomain sets the domain parameter.
+func WithDomain(domain oxylabs.Domain) Option {
+	return internal.SetOption("Domain", func(f internal.Fields) *oxylabs.Domain { return f.Domain }, domain)
+}
+
+// WithStartPage sets the start_page parameter.
+func WithStartPage(startPage int) Option {
+	return internal.SetOption("StartPage", func(f internal.Fields) *int { return f.StartPage }, startPage)
+}
+
+// WithPages sets the pages parameter.
+func WithPages(pages int) Option {
+	return internal.SetOption("Pages", func(f internal.Fields) *int { return f.Pages }, pages)
+}
+
+// WithLimit sets the limit parameter.
+func WithLimit(limit int) Option {
+	return internal.SetOption("Limit", func(f internal.Fields) *int { return f.Limit }, limit)
+}
+
+// WithLocale sets the locale parameter.
+func WithLocale(locale oxylabs.Locale) Option {
+	return internal.SetOption("Locale", func(f internal.Fields) *oxylabs.Locale { return f.Locale }, locale)
+}
+
+// WithGeoLocation sets the geo_location parameter.
+func WithGeoLocation(geoLocation oxylabs.GeoLocation) Option {
+	return internal.SetOption("GeoLocation", func(f internal.Fields) *oxylabs.GeoLocation { return f.GeoLocation }, geoLocation)
+}
+
+// WithUserAgent sets the user_agent_type parameter.
+func WithUserAgent(userAgent oxylabs.UserAgent) Option {
+	return internal.SetOption("UserAgent", func(f internal.Fields) *oxylabs.UserAgent { return f.UserAgent }, userAgent)
+}
+
+// WithCallbackUrl sets the callback_url parameter.
+func WithCallbackUrl(callbackUrl string) Option {
+	return internal.SetOption("CallbackUrl", func(f internal.Fields) *string { return f.CallbackUrl }, callbackUrl)
+}
+
+// WithRender sets the render parameter.
+func WithRender(render oxylabs.Render) Option {
+	return internal.SetOption("Render", func(f internal.Fields) *oxylabs.Render { return f.Render }, render)
+}
+
+// WithParse sets the parse parameter.
+func WithParse(parse bool) Option {
+	return internal.SetOption("Parse", func(f internal.Fields) *bool { return f.Parse }, parse)
+}
+
+// WithParseInstructions sets the parsing_instructions parameter.
+func WithParseInstructions(instructions map[string]interface{}) Option {
+	return internal.SetOption("ParseInstructions", func(f internal.Fields) **map[string]interface{} { return f.ParseInstructions }, &instructions)
+}
+
+// WithPollInterval sets the time to wait between polling reqs of async clients.
+func WithPollInterval(pollInterval time.Duration) Option {
+	return internal.SetOption("PollInterval", func(f internal.Fields) *time.Duration { return f.PollInterval }, pollInterval)
+}
+
+// WithContext appends context modifiers, e.g. oxylabs.ResultsLanguage("en").
+func WithContext(modifiers ...func(oxylabs.ContextOption)) Option {
+	return internal.AppendOption("Context", func(f internal.Fields) *[]func(oxylabs.ContextOption) { return f.Context }, modifiers)
+}
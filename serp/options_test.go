@@ -0,0 +1,90 @@
+package serp
+
+import (
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/geo"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOpts(t *testing.T) {
+	opts, err := NewOpts[GoogleSearchOpts](
+		WithDomain(oxylabs.DOMAIN_DE),
+		WithPages(3),
+		WithLocale("de"),
+		WithContext(oxylabs.Filter(1)),
+		WithContext(oxylabs.Nfpr(true)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, oxylabs.DOMAIN_DE, opts.Domain)
+	assert.Equal(t, 3, opts.Pages)
+	assert.Equal(t, "de", string(opts.Locale))
+	assert.Len(t, opts.Context, 2)
+
+	// Unset parameters keep their zero value so that defaults are applied.
+	assert.Equal(t, 0, opts.Limit)
+
+	_, err = NewOpts[GoogleUrlOpts](WithPages(3))
+	assert.ErrorContains(t, err, "option Pages is not supported by GoogleUrlOpts")
+}
+
+func TestOpts_Validate(t *testing.T) {
+	assert.NoError(t, (&GoogleSearchOpts{}).Validate())
+	assert.NoError(t, (&BingUrlOpts{Parse: true}).Validate())
+
+	opt := &GoogleSearchOpts{UserAgent: "invalid"}
+	assert.ErrorContains(t, opt.Validate(), "invalid user agent parameter: invalid")
+	assert.Equal(t, oxylabs.UserAgent("invalid"), opt.UserAgent)
+
+	opt = &GoogleSearchOpts{Pages: 2, Context: []func(oxylabs.ContextOption){oxylabs.LimitPerPage(nil)}}
+	assert.ErrorContains(t, opt.Validate(), "cannot be used together with limit_per_page")
+}
+
+func TestOpts_Validate_GeoLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		geoLocation oxylabs.GeoLocation
+		wantErr     bool
+	}{
+		{name: "country code", geoLocation: geo.Country("de")},
+		{name: "city", geoLocation: geo.City("London,England,United Kingdom")},
+		{name: "coords", geoLocation: geo.Coords(47.6205, -122.3493, 25000)},
+		{name: "unknown country code", geoLocation: geo.Country("XX"), wantErr: true},
+		{name: "coords out of range", geoLocation: geo.Coords(200, 500, -1), wantErr: true},
+		{name: "blank", geoLocation: geo.Raw(" "), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := map[string]interface{ Validate() error }{
+				"google_search":         &GoogleSearchOpts{GeoLocation: tt.geoLocation},
+				"google_url":            &GoogleUrlOpts{GeoLocation: tt.geoLocation},
+				"google_ads":            &GoogleAdsOpts{GeoLocation: tt.geoLocation},
+				"google_suggestions":    &GoogleSuggestionsOpts{GeoLocation: tt.geoLocation},
+				"google_hotels":         &GoogleHotelsOpts{GeoLocation: tt.geoLocation},
+				"google_travel_hotels":  &GoogleTravelHotelsOpts{GeoLocation: tt.geoLocation},
+				"google_images":         &GoogleImagesOpts{GeoLocation: tt.geoLocation},
+				"google_trends_explore": &GoogleTrendsExploreOpts{GeoLocation: tt.geoLocation},
+				"bing_search":           &BingSearchOpts{GeoLocation: tt.geoLocation},
+				"bing_url":              &BingUrlOpts{GeoLocation: tt.geoLocation},
+			}
+			for source, opt := range opts {
+				if tt.wantErr {
+					assert.ErrorContains(t, opt.Validate(), "invalid geo_location parameter", source)
+				} else {
+					assert.NoError(t, opt.Validate(), source)
+				}
+			}
+		})
+	}
+}
+
+func TestSerpClient_Validate(t *testing.T) {
+	c := Init("username", "password", oxylabs.WithDefaults(oxylabs.Defaults{UserAgent: "invalid"}))
+
+	assert.NoError(t, (&GoogleSearchOpts{}).Validate())
+	assert.ErrorContains(t, c.Validate("adidas", &GoogleSearchOpts{}), "invalid user agent parameter: invalid")
+	assert.NoError(t, c.Validate("adidas", &GoogleSearchOpts{UserAgent: oxylabs.UA_DESKTOP}))
+	assert.ErrorContains(t, c.Validate("https://example.com", &GoogleUrlOpts{UserAgent: oxylabs.UA_DESKTOP}), "google")
+	assert.EqualError(t, c.Validate("adidas", GoogleSearchOpts{}), "unsupported opts type serp.GoogleSearchOpts")
+}
@@ -0,0 +1,46 @@
+package serp
+
+import (
+	"fmt"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/internal/payloads"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// Register the payload builders of the sources for oxylabs.PayloadHash.
+func init() {
+	payloads.Register(string(oxylabs.BingSearch), payloadBuilder(prepareBingSearch))
+	payloads.Register(string(oxylabs.BingUrl), payloadBuilder(prepareBingUrl))
+	payloads.Register(string(oxylabs.GoogleSearch), payloadBuilder(prepareGoogleSearch))
+	payloads.Register(string(oxylabs.GoogleUrl), payloadBuilder(prepareGoogleUrl))
+	payloads.Register(string(oxylabs.GoogleAds), payloadBuilder(prepareGoogleAds))
+	payloads.Register(string(oxylabs.GoogleSuggestions), payloadBuilder(prepareGoogleSuggestions))
+	payloads.Register(string(oxylabs.GoogleHotels), payloadBuilder(prepareGoogleHotels))
+	payloads.Register(string(oxylabs.GoogleTravelHotels), payloadBuilder(prepareGoogleTravelHotels))
+	payloads.Register(string(oxylabs.GoogleImages), payloadBuilder(prepareGoogleImages))
+	payloads.Register(string(oxylabs.GoogleTrendsExplore), payloadBuilder(prepareGoogleTrendsExplore))
+}
+
+// payloadBuilder returns the payloads.Builder of the source prepared by prepare,
+// which builds the payload as a client without client defaults would send it.
+func payloadBuilder[T any](
+	prepare func(c *internal.Client, query string, opts []*T) (*scrapeReq, error),
+) payloads.Builder {
+	return func(query string, opts interface{}) ([]byte, error) {
+		var opt *T
+		if opts != nil {
+			var ok bool
+			if opt, ok = opts.(*T); !ok {
+				return nil, fmt.Errorf("unsupported opts type %T", opts)
+			}
+		}
+
+		req, err := prepare(&internal.Client{}, query, []*T{opt})
+		if err != nil {
+			return nil, err
+		}
+
+		return req.Payload, nil
+	}
+}
@@ -0,0 +1,43 @@
+package serp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal/payloads"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayloadHash_MatchesSentPayload(t *testing.T) {
+	var sent []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent, _ = io.ReadAll(r.Body)
+		w.Write([]byte(`{"results": [{"content": "<html></html>", "status_code": 200}]}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	_, err := c.ScrapeGoogleSearch(" adidas ", &GoogleSearchOpts{Pages: 2})
+	assert.NoError(t, err)
+	key, err := payloads.Key(sent)
+	assert.NoError(t, err)
+
+	hash, err := oxylabs.PayloadHash(oxylabs.GoogleSearch, " adidas ", &GoogleSearchOpts{Pages: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, key, hash)
+	sentHash, err := oxylabs.HashPayload(sent)
+	assert.NoError(t, err)
+	assert.Equal(t, hash, sentHash)
+
+	other, err := oxylabs.PayloadHash(oxylabs.GoogleSearch, "adidas", nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	_, err = oxylabs.PayloadHash(oxylabs.GoogleSearch, "adidas", &BingSearchOpts{})
+	assert.EqualError(t, err, "unsupported opts type *serp.BingSearchOpts")
+}
@@ -0,0 +1,288 @@
+package serp
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResp_JSONRoundTrip(t *testing.T) {
+	apiResp := []byte(`{
+		"results": [{
+			"content": {
+				"url": "https://www.google.com/search?q=adidas",
+				"page": 1,
+				"results": {"organic": [{"pos": 1, "url": "https://www.adidas.com", "title": "adidas"}]}
+			},
+			"created_at": "2024-01-01 00:00:00",
+			"page": 1,
+			"job_id": "123",
+			"status_code": 200
+		}],
+		"job": {"id": "123", "source": "google_search", "status": "done"}
+	}`)
+
+	tests := []struct {
+		name         string
+		parse        bool
+		customParser bool
+		body         []byte
+		status       oxylabs.JobStatus
+	}{
+		{name: "parsed", parse: true, body: apiResp, status: oxylabs.JobDone},
+		{name: "custom parsed", parse: true, customParser: true, body: apiResp, status: oxylabs.JobDone},
+		{name: "raw", body: []byte(`{"results":[{"content":"<html></html>","page":1}],"job":{"id":"1"}}`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &Resp{Parse: tt.parse, ParseInstructions: tt.customParser}
+			assert.NoError(t, resp.UnmarshalJSON(tt.body))
+			assert.Equal(t, tt.status, resp.Job.Status)
+			resp.StatusCode = 200
+			resp.Status = "200 OK"
+
+			data, err := json.Marshal(resp)
+			assert.NoError(t, err)
+
+			reloaded := &Resp{}
+			assert.NoError(t, json.Unmarshal(data, reloaded))
+			assert.Equal(t, resp, reloaded)
+		})
+	}
+}
+
+func TestDecodeResp_Strategies(t *testing.T) {
+	tests := []struct {
+		strategy oxylabs.DecodeStrategy
+		body     string
+		check    func(t *testing.T, result Results)
+	}{
+		{
+			strategy: oxylabs.DecodeRaw,
+			body:     `{"results":[{"content":"<html></html>","page":1}],"job":{"id":"1"}}`,
+			check: func(t *testing.T, result Results) {
+				assert.Equal(t, "<html></html>", result.Content)
+			},
+		},
+		{
+			strategy: oxylabs.DecodeParsed,
+			body:     `{"results":[{"content":{"url":"https://www.google.com","page":1},"page":1}],"job":{"id":"1"}}`,
+			check: func(t *testing.T, result Results) {
+				assert.Equal(t, "https://www.google.com", result.ContentParsed.Url)
+			},
+		},
+		{
+			strategy: oxylabs.DecodeCustomParsed,
+			body:     `{"results":[{"content":{"title":"adidas"},"page":1}],"job":{"id":"1"}}`,
+			check: func(t *testing.T, result Results) {
+				assert.Equal(t, "adidas", result.CustomContentParsed["title"])
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			httpResp := &http.Response{
+				StatusCode: 200,
+				Status:     "200 OK",
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}
+			resp, err := DecodeResp(httpResp, tt.strategy)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.strategy, resp.Strategy())
+			assert.Equal(t, 1, resp.Results[0].Page)
+			tt.check(t, resp.Results[0])
+		})
+	}
+}
+
+func TestResp_FailedPages(t *testing.T) {
+	body := []byte(`{
+		"results": [
+			{"content": {"url": "https://www.google.com", "page": 1}, "page": 1, "status_code": 200},
+			{"content": "Request failed", "page": 2, "status_code": 613},
+			{"content": {"url": "https://www.google.com", "page": 3}, "page": 3, "status_code": 404}
+		],
+		"job": {"id": "1"}
+	}`)
+
+	resp := &Resp{Parse: true}
+	assert.NoError(t, resp.UnmarshalJSON(body))
+	assert.Len(t, resp.Results, 3)
+	assert.Equal(t, []int{2, 3}, resp.FailedPages())
+	assert.Equal(t, "Request failed", resp.Results[1].Content)
+	assert.NoError(t, resp.Results[0].Err())
+	assert.ErrorContains(t, resp.Errs(), "page 2: error decoding content")
+	assert.ErrorContains(t, resp.Errs(), "page 3: failed with status code 404")
+}
+
+func TestResp_Redirected(t *testing.T) {
+	body := `{"results": [{"content": "<html></html>", "url": "https://www.google.com/search?q=adidas&hl=en"}],
+		"job": {"id": "1", "url": "https://google.com/search?q=adidas"}}`
+	httpResp := &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}
+
+	resp, err := DecodeResp(httpResp, oxylabs.DecodeRaw)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://google.com/search?q=adidas", resp.RequestedUrl())
+	assert.Equal(t, "https://www.google.com/search?q=adidas&hl=en", resp.Results[0].FinalUrl())
+	assert.True(t, resp.Redirected())
+
+	resp.Results[0].Url = resp.RequestedUrl()
+	assert.False(t, resp.Redirected())
+
+	// Urls differing only by their normalization are not redirects.
+	for _, url := range []string{
+		"http://GOOGLE.com/search/?q=adidas",
+		"https://google.com:443/search?q=adidas#top",
+	} {
+		resp.Results[0].Url = url
+		assert.False(t, resp.Redirected(), url)
+	}
+	resp.Job.Url = "https://google.com/search?hl=en&q=adidas"
+	resp.Results[0].Url = "https://google.com/search?q=adidas&hl=en"
+	assert.False(t, resp.Redirected())
+}
+
+func TestResp_TargetHeaders(t *testing.T) {
+	body := `{"results": [{"content": "<html></html>", "status_code": 200,
+		"headers": {"Content-Type": "text/html", "Set-Cookie": ["a=1", "b=2"]}}]}`
+	httpResp := &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}
+
+	resp, err := DecodeResp(httpResp, oxylabs.DecodeRaw)
+	assert.NoError(t, err)
+
+	headers := resp.Results[0].Headers
+	assert.Equal(t, "text/html", headers.Get("content-type"))
+	assert.Equal(t, "a=1", headers.Header().Get("Set-Cookie"))
+	assert.Equal(t, []string{"a=1", "b=2"}, headers.Values("set-cookie"))
+}
+
+func TestResults_HTML(t *testing.T) {
+	content, err := (&Results{Content: "<html></html>", ContentKind: oxylabs.ContentHTML}).HTML()
+	assert.NoError(t, err)
+	assert.Equal(t, "<html></html>", content)
+
+	_, err = (&Results{Page: 1, ContentKind: oxylabs.ContentPNG}).HTML()
+	assert.EqualError(t, err, "page 1: content of kind png is not html")
+}
+
+// countingCodec counts the calls of encoding/json it delegates to.
+type countingCodec struct {
+	oxylabs.StdCodec
+	unmarshals int
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshals++
+	return c.StdCodec.Unmarshal(data, v)
+}
+
+func TestDecodeRespWith_Codec(t *testing.T) {
+	codec := &countingCodec{}
+	httpResp := &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(`{"results": [{"content": "<html></html>", "page": 1}], "job": {"id": "1"}}`)),
+	}
+
+	resp, err := DecodeRespWith(httpResp, oxylabs.DecodeRaw, codec)
+	assert.NoError(t, err)
+	assert.Equal(t, "<html></html>", resp.Results[0].Content)
+	assert.Equal(t, "1", resp.Job.ID)
+	// The resp is decoded once, and its page from the raw result.
+	assert.Equal(t, 2, codec.unmarshals)
+}
+
+// benchRespBody returns a parsed API resp of the given number of pages
+// with 100 organic results each, and a raw one with as much html.
+// Every failEvery-th parsed page, if any, failed with an error message as content.
+func benchRespBody(pages int, failEvery int) (parsed []byte, raw []byte) {
+	organic := make([]Organic, 100)
+	for i := range organic {
+		organic[i] = Organic{Pos: i + 1, Url: "https://www.adidas.com", Title: "adidas", Desc: strings.Repeat("shoes ", 20)}
+	}
+	content := Content{Url: "https://www.google.com/search?q=adidas", Results: Result{Organic: organic}}
+
+	var parsedResults, rawResults []map[string]interface{}
+	for page := 1; page <= pages; page++ {
+		text, _ := json.Marshal(content)
+		if failEvery > 0 && page%failEvery == 0 {
+			parsedResults = append(parsedResults, map[string]interface{}{"content": "Service unavailable", "page": page, "status_code": 503})
+		} else {
+			parsedResults = append(parsedResults, map[string]interface{}{"content": content, "page": page, "status_code": 200})
+		}
+		rawResults = append(rawResults, map[string]interface{}{"content": "<html>" + string(text) + "</html>", "page": page, "status_code": 200})
+	}
+	job := map[string]interface{}{"id": "123", "source": "google_search", "status": "done"}
+
+	parsed, _ = json.Marshal(map[string]interface{}{"results": parsedResults, "job": job})
+	raw, _ = json.Marshal(map[string]interface{}{"results": rawResults, "job": job})
+
+	return parsed, raw
+}
+
+func BenchmarkDecodeResp(b *testing.B) {
+	parsed, raw := benchRespBody(10, 0)
+	failed, _ := benchRespBody(10, 3)
+	benchmarks := []struct {
+		name     string
+		strategy oxylabs.DecodeStrategy
+		body     []byte
+	}{
+		{name: "parsed", strategy: oxylabs.DecodeParsed, body: parsed},
+		{name: "custom_parsed", strategy: oxylabs.DecodeCustomParsed, body: parsed},
+		{name: "raw", strategy: oxylabs.DecodeRaw, body: raw},
+		{name: "parsed_failed_pages", strategy: oxylabs.DecodeParsed, body: failed},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(bm.body)))
+			for i := 0; i < b.N; i++ {
+				httpResp := &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader(bm.body))}
+				if _, err := DecodeResp(httpResp, bm.strategy); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeTypedResp(b *testing.B) {
+	parsed, _ := benchRespBody(10, 0)
+
+	b.Run("typed", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(parsed)))
+		for i := 0; i < b.N; i++ {
+			httpResp := &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader(parsed))}
+			if _, err := DecodeTypedResp[Content](httpResp); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	// Custom parsed content decoded into a struct with DecodeContent, for comparison.
+	b.Run("decode_content", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(parsed)))
+		for i := 0; i < b.N; i++ {
+			httpResp := &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader(parsed))}
+			resp, err := DecodeResp(httpResp, oxylabs.DecodeCustomParsed)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for j := range resp.Results {
+				var content Content
+				if err := resp.Results[j].DecodeContent(&content); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
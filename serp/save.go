@@ -0,0 +1,67 @@
+package serp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// SaveTo writes the content of each result to a file in dir and returns the
+// paths of the written files. Files are named after the job id and page of the
+// result, e.g. "<job_id>_1.html", with an extension matching the content kind
+// of the result. Images and binary content are written decoded.
+func (r *Resp) SaveTo(dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating directory: %v", err)
+	}
+
+	paths := []string{}
+	for i := range r.Results {
+		result := &r.Results[i]
+
+		data, ext, err := result.fileContent()
+		if err != nil {
+			return paths, err
+		}
+
+		jobID := result.JobID
+		if jobID == "" {
+			jobID = r.Job.ID
+		}
+		page := result.Page
+		if page == 0 {
+			page = i + 1
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("%s_%d.%s", jobID, page, ext))
+		if err = os.WriteFile(path, data, 0o644); err != nil {
+			return paths, fmt.Errorf("error writing result to file: %v", err)
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// fileContent returns the content of the result and its file extension,
+// decoding images and binary content.
+func (r *Results) fileContent() ([]byte, string, error) {
+	kind := r.contentKind()
+	switch {
+	case r.CustomContentParsed != nil:
+		data, err := json.MarshalIndent(r.CustomContentParsed, "", "  ")
+		return data, "json", err
+	case r.Content == "":
+		data, err := json.MarshalIndent(r.ContentParsed, "", "  ")
+		return data, "json", err
+	default:
+		data, err := r.Bytes()
+		if err != nil {
+			return nil, "", fmt.Errorf("error decoding content of page %d: %w", r.Page, err)
+		}
+		return data, oxylabs.ContentExtension(kind, data), nil
+	}
+}
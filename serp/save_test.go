@@ -0,0 +1,82 @@
+package serp
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResp_SaveTo_ContentKind(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), 0x00, 0x01)
+	jpeg := append([]byte("\xff\xd8\xff\xe0"), make([]byte, 64)...)
+	resp := &Resp{Results: []Results{
+		{JobID: "123", Page: 1, Content: base64.StdEncoding.EncodeToString(jpeg), ContentKind: oxylabs.ContentBinary},
+		{JobID: "123", Page: 2, Content: base64.StdEncoding.EncodeToString(png), ContentKind: oxylabs.ContentPNG},
+	}}
+
+	// Images and binary content are written decoded, with the extension of their format.
+	dir := t.TempDir()
+	paths, err := resp.SaveTo(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "123_1.jpg"), filepath.Join(dir, "123_2.png")}, paths)
+	for i, want := range [][]byte{jpeg, png} {
+		data, err := os.ReadFile(paths[i])
+		assert.NoError(t, err)
+		assert.Equal(t, want, data)
+	}
+}
+
+func TestResp_SaveTo(t *testing.T) {
+	resp := &Resp{
+		Job: Job{ID: "456"},
+		Results: []Results{
+			{JobID: "123", Page: 1, Content: "<html></html>", ContentKind: oxylabs.ContentHTML},
+			{JobID: "123", Page: 2, Content: `{"title": "adidas"}`, ContentKind: oxylabs.ContentJSON},
+			{JobID: "123", Page: 3, CustomContentParsed: map[string]interface{}{"title": "adidas"}, ContentKind: oxylabs.ContentJSON},
+			{Content: "<html></html>"},
+			{ContentParsed: Content{Url: "https://www.google.com/search?q=adidas"}, ContentKind: oxylabs.ContentJSON},
+		},
+	}
+
+	// Results without a job ID or page are named after the job and their position.
+	dir := t.TempDir()
+	paths, err := resp.SaveTo(dir)
+	assert.NoError(t, err)
+	var names []string
+	for _, path := range paths {
+		names = append(names, filepath.Base(path))
+	}
+	assert.Equal(t, []string{"123_1.html", "123_2.json", "123_3.json", "456_4.html", "456_5.json"}, names)
+
+	data, err := os.ReadFile(paths[1])
+	assert.NoError(t, err)
+	assert.Equal(t, `{"title": "adidas"}`, string(data))
+	data, err = os.ReadFile(paths[2])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"title": "adidas"}`, string(data))
+	data, err = os.ReadFile(paths[4])
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "https://www.google.com/search?q=adidas")
+
+	// A directory which cannot be created fails the save.
+	file := filepath.Join(t.TempDir(), "file")
+	assert.NoError(t, os.WriteFile(file, nil, 0o644))
+	_, err = resp.SaveTo(filepath.Join(file, "results"))
+	assert.ErrorContains(t, err, "error creating directory")
+
+	// A file which cannot be written fails the save.
+	dir = t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "123_1.html"), 0o755))
+	_, err = resp.SaveTo(dir)
+	assert.ErrorContains(t, err, "error writing result to file")
+
+	// A result which cannot be decoded fails the save, returning the files written so far.
+	resp.Results[1] = Results{JobID: "123", Page: 2, Content: "not base64!", ContentKind: oxylabs.ContentBinary}
+	paths, err = resp.SaveTo(t.TempDir())
+	assert.ErrorContains(t, err, "error decoding content of page 2")
+	assert.Len(t, paths, 1)
+}
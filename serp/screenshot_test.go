@@ -0,0 +1,67 @@
+package serp
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResp_Screenshot(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), 0x00, 0x01)
+	tests := []struct {
+		name    string
+		resp    *Resp
+		want    []byte
+		wantErr string
+	}{
+		{
+			name: "png",
+			resp: &Resp{Results: []Results{{Content: base64.StdEncoding.EncodeToString(png), ContentKind: oxylabs.ContentPNG}}},
+			want: png,
+		},
+		{
+			name: "png without content kind",
+			resp: &Resp{Results: []Results{{Content: base64.StdEncoding.EncodeToString(png)}}},
+			want: png,
+		},
+		{
+			name:    "html",
+			resp:    &Resp{Results: []Results{{Content: "<html></html>", ContentKind: oxylabs.ContentHTML}}},
+			wantErr: "result content is not a png screenshot",
+		},
+		{
+			name:    "invalid base64",
+			resp:    &Resp{Results: []Results{{Content: "not base64!", ContentKind: oxylabs.ContentPNG}}},
+			wantErr: "error decoding screenshot",
+		},
+		{
+			name:    "empty content",
+			resp:    &Resp{Results: []Results{{}}},
+			wantErr: "result has no content",
+		},
+		{
+			name:    "no results",
+			resp:    &Resp{},
+			wantErr: "resp has no results",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screenshot, err := tt.resp.Screenshot()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, screenshot)
+		})
+	}
+}
+
+func TestOpts_Validate_Render(t *testing.T) {
+	assert.NoError(t, (&GoogleSearchOpts{Render: oxylabs.PNG}).Validate())
+	assert.NoError(t, (&BingUrlOpts{Render: oxylabs.HTML}).Validate())
+	assert.ErrorContains(t, (&GoogleSearchOpts{Render: "jpg"}).Validate(), "invalid render parameter: jpg")
+}
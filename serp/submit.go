@@ -0,0 +1,108 @@
+package serp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// asyncJob is a submitted job with the options needed to poll for and decode its results.
+// Payload is needed to resubmit the job if it faults.
+type asyncJob struct {
+	ID           string
+	Payload      []byte
+	PollInterval time.Duration
+	Strategy     oxylabs.DecodeStrategy
+	Meta         map[string]string
+	// Curve is the poll curve of a resumed job, which defaults to the one of its payload.
+	Curve *oxylabs.PollCurve
+}
+
+// SubmitOnly submits a job via Oxylabs SERP API and returns its ID without polling,
+// e.g. when results are delivered to a callback url or cloud storage.
+// The source is selected by the type of opts, e.g. *BingSearchOpts for the
+// source of ScrapeBingSearch, and query is the url for url sources.
+// The Submit methods of the sources, e.g. SubmitBingSearch, take typed opts instead.
+func (c *SerpClientAsync) SubmitOnly(
+	ctx context.Context,
+	query string,
+	opts interface{},
+) (string, error) {
+	var job *asyncJob
+	var err error
+	switch o := opts.(type) {
+	case *BingSearchOpts:
+		job, err = c.submitBingSearch(ctx, query, o)
+	case *BingUrlOpts:
+		job, err = c.submitBingUrl(ctx, query, o)
+	case *GoogleSearchOpts:
+		job, err = c.submitGoogleSearch(ctx, query, o)
+	case *GoogleUrlOpts:
+		job, err = c.submitGoogleUrl(ctx, query, o)
+	case *GoogleAdsOpts:
+		job, err = c.submitGoogleAds(ctx, query, o)
+	case *GoogleSuggestionsOpts:
+		job, err = c.submitGoogleSuggestions(ctx, query, o)
+	case *GoogleHotelsOpts:
+		job, err = c.submitGoogleHotels(ctx, query, o)
+	case *GoogleTravelHotelsOpts:
+		job, err = c.submitGoogleTravelHotels(ctx, query, o)
+	case *GoogleImagesOpts:
+		job, err = c.submitGoogleImages(ctx, query, o)
+	case *GoogleTrendsExploreOpts:
+		job, err = c.submitGoogleTrendsExplore(ctx, query, o)
+	default:
+		return "", fmt.Errorf("unsupported opts type %T, opts select the source", opts)
+	}
+
+	return c.submitOnly(job, err)
+}
+
+// submitOnly returns the ID of the job submitted without polling.
+func (c *SerpClientAsync) submitOnly(job *asyncJob, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+
+	return job.ID, nil
+}
+
+// poll polls the status of the job in the background and returns its async result.
+func (c *SerpClientAsync) poll(
+	ctx context.Context,
+	job *asyncJob,
+) *AsyncResult {
+	return oxylabs.RunAsync(ctx, func(ctx context.Context) (*Resp, error) {
+		var httpResp *http.Response
+		for attempt := 1; ; attempt++ {
+			// Poll job status.
+			var err error
+			curve := c.C.PollCurve(job.Payload, job.PollInterval)
+			if job.Curve != nil {
+				curve = *job.Curve
+			}
+			if httpResp, err = c.C.WaitJobCurve(ctx, job.ID, curve); err == nil {
+				break
+			}
+
+			// Resubmit the job if it faulted and retries are enabled.
+			if job.ID, err = c.C.ResubmitFaulted(ctx, job.Payload, attempt, err); err != nil {
+				return nil, err
+			}
+		}
+
+		// Unmarshal the http Response and get the response.
+		resp, err := DecodeRespWith(httpResp, job.Strategy, c.C.Codec())
+		if err != nil {
+			return nil, err
+		}
+		resp.orderResults()
+		resp.Meta = job.Meta
+		resp.Payload = c.C.PayloadEcho(job.Payload)
+
+		return resp, nil
+	})
+}
@@ -0,0 +1,94 @@
+package serp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// TypedResp is the resp of ScrapeTyped with the content of each result decoded into T.
+type TypedResp[T any] struct {
+	Results    []TypedResults[T] `json:"results"`
+	Job        Job               `json:"job"`
+	StatusCode int               `json:"status_code"`
+	Status     string            `json:"status"`
+}
+
+// TypedResults is a single result of a job with its content decoded into T.
+type TypedResults[T any] struct {
+	Content    T      `json:"content"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+	Page       int    `json:"page"`
+	Url        string `json:"url"`
+	JobID      string `json:"job_id"`
+	StatusCode int    `json:"status_code"`
+}
+
+// ScrapeTyped scrapes the source with the given payload via Oxylabs SERP API and
+// decodes the parsed content of each result directly into T, e.g. a struct matching
+// the output of custom parsing instructions. The payload must request parsing.
+func ScrapeTyped[T any](
+	ctx context.Context,
+	c *SerpClient,
+	source oxylabs.Source,
+	payload map[string]interface{},
+) (*TypedResp[T], error) {
+	// Copy so that the source is not written to the caller's payload.
+	p := make(map[string]interface{}, len(payload)+1)
+	for k, v := range payload {
+		p[k] = v
+	}
+	p["source"] = source
+
+	// Marshal.
+	jsonPayload, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+
+	// Req.
+	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeTypedRespWith[T](httpResp, c.C.Codec())
+}
+
+// DecodeTypedResp returns a TypedResp from the http.Response object of a job
+// submitted with parse=true or parsing instructions, decoding the content of
+// each result straight into T in a single pass. Unlike DecodeResp followed by
+// DecodeContent, the content is not decoded into generic values and re-encoded.
+func DecodeTypedResp[T any](httpResp *http.Response) (*TypedResp[T], error) {
+	return DecodeTypedRespWith[T](httpResp, oxylabs.StdCodec{})
+}
+
+// DecodeTypedRespWith returns a TypedResp from the http.Response object like
+// DecodeTypedResp, decoding the JSON with the codec.
+func DecodeTypedRespWith[T any](httpResp *http.Response, codec oxylabs.Codec) (*TypedResp[T], error) {
+	// Read the resp body into a buffer and release the connection.
+	defer httpResp.Body.Close()
+	respBody, release, err := internal.ReadBody(httpResp.Body, codec)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("error with status code %s: %s", httpResp.Status, respBody)
+	}
+
+	// Unmarshal the JSON object.
+	res := &TypedResp[T]{}
+	if err = codec.Unmarshal(respBody, res); err != nil {
+		return nil, fmt.Errorf("error decoding content into %T: %v", *new(T), err)
+	}
+	res.StatusCode = httpResp.StatusCode
+	res.Status = httpResp.Status
+
+	return res, nil
+}
@@ -0,0 +1,34 @@
+package serp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeTyped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": [{"content": {"title": "adidas", "prices": [10.5, 20]}, "page": 1, "status_code": 200}], "job": {"id": "1"}}`))
+	}))
+	defer server.Close()
+
+	c := Init("username", "password")
+	c.C.BaseUrl = server.URL
+
+	type product struct {
+		Title  string    `json:"title"`
+		Prices []float64 `json:"prices"`
+	}
+	resp, err := ScrapeTyped[product](context.Background(), c, oxylabs.GoogleUrl, map[string]interface{}{
+		"url":   "https://www.google.com/search?q=adidas",
+		"parse": true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, product{Title: "adidas", Prices: []float64{10.5, 20}}, resp.Results[0].Content)
+	assert.Equal(t, "1", resp.Job.ID)
+	assert.Equal(t, 200, resp.StatusCode)
+}
@@ -0,0 +1,538 @@
+package serp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+)
+
+// SerpUnifiedClient routes scrapes over the integration method configured with
+// oxylabs.WithIntegration and returns their results as Futures, so that the
+// integration method can be switched by configuration. It is safe for concurrent use.
+type SerpUnifiedClient struct {
+	C *internal.Client
+}
+
+// InitUnified initializes a unified client with the given credentials and client options.
+func InitUnified(
+	username string,
+	password string,
+	opts ...oxylabs.ClientOption,
+) *SerpUnifiedClient {
+	// Realtime scrapes and push-pull jobs share the client, and with it
+	// its connection pool, credentials, job limiter and poll scheduler.
+	c := internal.NewClient(internal.SyncBaseUrl, username, password, opts...)
+	c.AsyncUrl = internal.AsyncBaseUrl
+
+	return &SerpUnifiedClient{C: c}
+}
+
+// sync returns the client scraping over the realtime integration.
+func (c *SerpUnifiedClient) sync() *SerpClient {
+	return &SerpClient{C: c.C}
+}
+
+// async returns the client scraping over the push-pull integration.
+func (c *SerpUnifiedClient) async() *SerpClientAsync {
+	return &SerpClientAsync{C: c.C}
+}
+
+// integration returns the configured integration method.
+func (c *SerpUnifiedClient) integration() oxylabs.Integration {
+	if c.C.Config == nil || c.C.Config.Integration == "" {
+		return oxylabs.Realtime
+	}
+
+	return c.C.Config.Integration
+}
+
+// realtimeCtx returns the context of a realtime scrape, limited
+// to the push-pull fallback timeout if one is configured.
+func (c *SerpUnifiedClient) realtimeCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.C.Config == nil || c.C.Config.PushPullFallback == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, c.C.Config.PushPullFallback)
+}
+
+// fallback reports whether a failed realtime scrape should be retried with
+// push-pull. Fallback is opt-in with oxylabs.WithPushPullFallback, as a scrape
+// which was not done within the fallback timeout may still run and be billed.
+// Scrapes which failed before they were sent are retried as well.
+func (c *SerpUnifiedClient) fallback(ctx context.Context, realtimeCtx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil || c.C.Config == nil || c.C.Config.PushPullFallback == 0 {
+		return false
+	}
+
+	return errors.Is(realtimeCtx.Err(), context.DeadlineExceeded) || internal.NotSent(err)
+}
+
+// realtime runs the realtime scrape in the background and returns its Future
+// right away, as for push-pull, so that callers wait in Future.Get for both.
+// The scrape is retried with push-pull by fallback, see the fallback method.
+func (c *SerpUnifiedClient) realtime(
+	ctx context.Context,
+	scrape func(ctx context.Context) (*Resp, error),
+	fallback func(ctx context.Context) (*AsyncResult, error),
+) *Future {
+	return FromAsync(oxylabs.RunAsync(ctx, func(ctx context.Context) (*Resp, error) {
+		realtimeCtx, cancel := c.realtimeCtx(ctx)
+		defer cancel()
+
+		resp, err := scrape(realtimeCtx)
+		if !c.fallback(ctx, realtimeCtx, err) {
+			return resp, err
+		}
+
+		return FromAsync(fallback(ctx)).Get(ctx)
+	}), nil)
+}
+
+// unsupportedIntegration returns the error of a source which does
+// not support the configured integration method.
+func (c *SerpUnifiedClient) unsupportedIntegration(source oxylabs.Source) *Future {
+	return FromResp(nil, fmt.Errorf("source %s does not support the %s integration", source, c.integration()))
+}
+
+// proxyReq scrapes url via the proxy endpoint. The url must belong to host, if set.
+// check validates the opts of f once the client defaults are applied, as for the
+// other integration methods.
+func (c *SerpUnifiedClient) proxyReq(
+	ctx context.Context,
+	url string,
+	host string,
+	f internal.Fields,
+	check func() error,
+	meta map[string]string,
+) (*Resp, error) {
+	// Check validity of URL.
+	if err := c.C.CheckUrl(url, host); err != nil {
+		return nil, err
+	}
+
+	// Apply client defaults.
+	c.C.ApplyDefaults(f, 0)
+
+	// Check validity of parameters.
+	if err := check(); err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.C.ProxyReq(ctx, url, f)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	result := Results{Url: url, StatusCode: httpResp.StatusCode}
+	parse := f.Parse != nil && *f.Parse
+	if parse {
+		if err = json.Unmarshal(body, &result.ContentParsed); err != nil {
+			return nil, fmt.Errorf("error unmarshalling parsed content: %v", err)
+		}
+	} else {
+		result.Content = string(body)
+	}
+
+	return &Resp{
+		Parse:      parse,
+		Results:    []Results{result},
+		StatusCode: httpResp.StatusCode,
+		Status:     httpResp.Status,
+		Meta:       meta,
+	}, nil
+}
+
+// proxy scrapes url via the proxy endpoint in the background, see proxyReq.
+func (c *SerpUnifiedClient) proxy(
+	ctx context.Context,
+	url string,
+	host string,
+	f internal.Fields,
+	check func() error,
+	meta map[string]string,
+) *Future {
+	return FromAsync(oxylabs.RunAsync(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.proxyReq(ctx, url, host, f, check, meta)
+	}), nil)
+}
+
+// ScrapeGoogleSearch scrapes google via Oxylabs SERP API with google_search as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeGoogleSearch(
+	query string,
+	opts ...*GoogleSearchOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleSearchCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleSearchCtx scrapes google via Oxylabs SERP API with google_search as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeGoogleSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSearchOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleSearchCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleSearch)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleSearchCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleSearchCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeGoogleUrl scrapes google via Oxylabs SERP API with google as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeGoogleUrl(
+	url string,
+	opts ...*GoogleUrlOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleUrlCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleUrlCtx scrapes google via Oxylabs SERP API with google as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeGoogleUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleUrlOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleUrlCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		opt := &GoogleUrlOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that defaults are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+		return c.proxy(ctx, url, "google", opt.fields(), opt.Validate, opt.Meta)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleUrlCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleUrlCtx(ctx, url, opts...)
+	})
+}
+
+// ScrapeGoogleAds scrapes google via Oxylabs SERP API with google_ads as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeGoogleAds(
+	query string,
+	opts ...*GoogleAdsOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleAdsCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleAdsCtx scrapes google via Oxylabs SERP API with google_ads as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeGoogleAdsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleAdsOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleAdsCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleAds)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleAdsCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleAdsCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeGoogleSuggestions scrapes google via Oxylabs SERP API with google_suggest as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeGoogleSuggestions(
+	query string,
+	opts ...*GoogleSuggestionsOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleSuggestionsCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleSuggestionsCtx scrapes google via Oxylabs SERP API with google_suggest as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeGoogleSuggestionsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSuggestionsOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleSuggestionsCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleSuggestions)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleSuggestionsCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleSuggestionsCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeGoogleHotels scrapes google via Oxylabs SERP API with google_hotels as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeGoogleHotels(
+	query string,
+	opts ...*GoogleHotelsOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleHotelsCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleHotelsCtx scrapes google via Oxylabs SERP API with google_hotels as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeGoogleHotelsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleHotelsOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleHotelsCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleHotels)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleHotelsCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleHotelsCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeGoogleTravelHotels scrapes google via Oxylabs SERP API with google_travel_hotels as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeGoogleTravelHotels(
+	query string,
+	opts ...*GoogleTravelHotelsOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleTravelHotelsCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleTravelHotelsCtx scrapes google via Oxylabs SERP API with google_travel_hotels as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeGoogleTravelHotelsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleTravelHotelsOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleTravelHotelsCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleTravelHotels)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleTravelHotelsCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleTravelHotelsCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeGoogleImages scrapes google via Oxylabs SERP API with google_images as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeGoogleImages(
+	url string,
+	opts ...*GoogleImagesOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleImagesCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleImagesCtx scrapes google via Oxylabs SERP API with google_images as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeGoogleImagesCtx(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleImagesOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleImagesCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleImages)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleImagesCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleImagesCtx(ctx, url, opts...)
+	})
+}
+
+// ScrapeGoogleTrendsExplore scrapes google via Oxylabs SERP API with google_trends_explore as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeGoogleTrendsExplore(
+	query string,
+	opts ...*GoogleTrendsExploreOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeGoogleTrendsExploreCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeGoogleTrendsExploreCtx scrapes google via Oxylabs SERP API with google_trends_explore as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeGoogleTrendsExploreCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleTrendsExploreOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeGoogleTrendsExploreCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.GoogleTrendsExplore)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeGoogleTrendsExploreCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeGoogleTrendsExploreCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeBingSearch scrapes bing via Oxylabs SERP API with bing_search as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeBingSearch(
+	query string,
+	opts ...*BingSearchOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeBingSearchCtx(ctx, query, opts...), nil)
+
+	return future
+}
+
+// ScrapeBingSearchCtx scrapes bing via Oxylabs SERP API with bing_search as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeBingSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*BingSearchOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeBingSearchCtx(ctx, query, opts...))
+	case oxylabs.ProxyEndpoint:
+		return c.unsupportedIntegration(oxylabs.BingSearch)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeBingSearchCtx(ctx, query, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeBingSearchCtx(ctx, query, opts...)
+	})
+}
+
+// ScrapeBingUrl scrapes bing via Oxylabs SERP API with bing as source
+// over the configured integration method.
+func (c *SerpUnifiedClient) ScrapeBingUrl(
+	url string,
+	opts ...*BingUrlOpts,
+) *Future {
+	ctx, cancel := context.WithTimeout(context.Background(), internal.RequestTimeout(c.C.AsyncTimeout(), internal.LastOpts(opts).RequestTimeout))
+	future, _ := internal.CancelOnDone(cancel, c.ScrapeBingUrlCtx(ctx, url, opts...), nil)
+
+	return future
+}
+
+// ScrapeBingUrlCtx scrapes bing via Oxylabs SERP API with bing as source
+// over the configured integration method.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpUnifiedClient) ScrapeBingUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*BingUrlOpts,
+) *Future {
+	switch c.integration() {
+	case oxylabs.PushPull:
+		return FromAsync(c.async().ScrapeBingUrlCtx(ctx, url, opts...))
+	case oxylabs.ProxyEndpoint:
+		opt := &BingUrlOpts{}
+		if len(opts) > 0 && opts[len(opts)-1] != nil {
+			// Copy so that defaults are not written to the caller's opts.
+			o := *opts[len(opts)-1]
+			opt = &o
+		}
+		return c.proxy(ctx, url, "bing", opt.fields(), opt.Validate, opt.Meta)
+	}
+
+	return c.realtime(ctx, func(ctx context.Context) (*Resp, error) {
+		return c.sync().ScrapeBingUrlCtx(ctx, url, opts...)
+	}, func(ctx context.Context) (*AsyncResult, error) {
+		return c.async().ScrapeBingUrlCtx(ctx, url, opts...)
+	})
+}
+
+// Close rejects new scrapes, cancels in-flight polling, waits for in-flight
+// realtime reqs to return and closes idle connections.
+func (c *SerpUnifiedClient) Close() error {
+	return c.C.Close()
+}
+
+// Shutdown rejects new scrapes and waits for in-flight polling and realtime reqs to finish,
+// see SerpClientAsync.Shutdown.
+func (c *SerpUnifiedClient) Shutdown(ctx context.Context) error {
+	return c.C.Shutdown(ctx)
+}
+
+// Ping validates the credentials and the reachability of the API.
+func (c *SerpUnifiedClient) Ping(ctx context.Context) error {
+	return c.C.Ping(ctx)
+}
@@ -0,0 +1,221 @@
+package serp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+// hostTransport sends the reqs to each host to its test server.
+type hostTransport map[string]*url.URL
+
+func (t hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target := t[req.URL.Hostname()]
+	req.URL.Scheme = target.Scheme
+	req.URL.Host = target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClientUnified returns a unified client scraping realtime at realtimeUrl
+// and submitting push-pull jobs to a test server which completes every job
+// immediately. The submitted jobs are counted in submitted.
+func newTestClientUnified(
+	t *testing.T,
+	realtimeUrl string,
+	submitted *int32,
+	opts ...oxylabs.ClientOption,
+) *SerpUnifiedClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/queries/123":
+			w.Write([]byte(`{"id": "123", "status": "done"}`))
+		case "/v1/queries/123/results":
+			w.Write([]byte(`{"results": [{"content": "push-pull", "job_id": "123", "status_code": 200}]}`))
+		default:
+			atomic.AddInt32(submitted, 1)
+			w.Write([]byte(`{"id": "123", "status": "pending"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	realtime, _ := url.Parse(realtimeUrl)
+	pushPull, _ := url.Parse(server.URL)
+	c := InitUnified("username", "password", opts...)
+	c.C.HttpClient = &http.Client{Transport: hostTransport{
+		"realtime.oxylabs.io": realtime,
+		"data.oxylabs.io":     pushPull,
+	}}
+
+	return c
+}
+
+// slowServer returns the url of a realtime test server
+// which does not respond until the test ends.
+func slowServer(t *testing.T) string {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(done) })
+
+	return server.URL
+}
+
+// closedServer returns the url of a realtime test server which refuses connections.
+func closedServer() string {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	return server.URL
+}
+
+func TestSerpUnifiedClient_PushPull(t *testing.T) {
+	var submitted int32
+	c := newTestClientUnified(t, closedServer(), &submitted, oxylabs.WithIntegration(oxylabs.PushPull))
+
+	resp, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{PollInterval: time.Millisecond}).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "push-pull", resp.Results[0].Content)
+	assert.EqualValues(t, 1, submitted)
+}
+
+func TestSerpUnifiedClient_Fallback(t *testing.T) {
+	opts := &GoogleSearchOpts{PollInterval: time.Millisecond}
+
+	tests := []struct {
+		name         string
+		realtimeUrl  string
+		fallback     time.Duration
+		wantFallback bool
+	}{
+		{name: "timed out", realtimeUrl: slowServer(t), fallback: 50 * time.Millisecond, wantFallback: true},
+		{name: "not sent", realtimeUrl: closedServer(), fallback: time.Minute, wantFallback: true},
+		{name: "not sent without opt-in", realtimeUrl: closedServer()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var submitted int32
+			c := newTestClientUnified(t, tt.realtimeUrl, &submitted, oxylabs.WithPushPullFallback(tt.fallback))
+
+			resp, err := c.ScrapeGoogleSearch("adidas", opts).Get(context.Background())
+			if tt.wantFallback {
+				assert.NoError(t, err)
+				assert.Equal(t, "push-pull", resp.Results[0].Content)
+				assert.EqualValues(t, 1, submitted)
+			} else {
+				assert.Error(t, err)
+				assert.EqualValues(t, 0, submitted)
+			}
+		})
+	}
+}
+
+func TestSerpUnifiedClient_NoFallbackOnCallerTimeout(t *testing.T) {
+	var submitted int32
+	c := newTestClientUnified(t, slowServer(t), &submitted, oxylabs.WithPushPullFallback(time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.ScrapeGoogleSearchCtx(ctx, "adidas").Get(context.Background())
+	assert.Error(t, err)
+	assert.EqualValues(t, 0, submitted)
+}
+
+func TestSerpUnifiedClient_ProxyEndpoint(t *testing.T) {
+	var header http.Header
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Write([]byte(`{"results": {"organic": [{"pos": 1, "title": "Adidas"}]}}`))
+	}))
+	defer proxy.Close()
+
+	c := InitUnified("username", "password", oxylabs.WithIntegration(oxylabs.ProxyEndpoint))
+	c.C.ProxyHost = proxy.Listener.Addr().String()
+
+	opts := &GoogleUrlOpts{Parse: true, Meta: map[string]string{"team": "seo"}}
+	resp, err := c.ScrapeGoogleUrl("http://www.google.com/search?q=adidas", opts).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "Adidas", resp.Results[0].ContentParsed.Results.Organic[0].Title)
+	assert.Equal(t, "seo", resp.Meta["team"])
+	assert.Equal(t, "1", header.Get("x-oxylabs-parse"))
+	assert.Equal(t, "desktop", header.Get("x-oxylabs-user-agent-type"))
+	assert.NotEmpty(t, header.Get("Proxy-Authorization"))
+
+	// Defaults are applied to a copy of the opts.
+	assert.Empty(t, opts.UserAgent)
+
+	// The url is checked like over the other integrations.
+	header = nil
+	_, err = c.ScrapeGoogleUrl("http://www.bing.com/search?q=adidas").Get(context.Background())
+	assert.EqualError(t, err, "URL does not belong to google")
+	assert.Nil(t, header)
+
+	// A proxy url is not dropped silently.
+	proxyURL, _ := url.Parse("http://egress.example.com:3128")
+	c = InitUnified("username", "password", oxylabs.WithIntegration(oxylabs.ProxyEndpoint), oxylabs.WithProxyURL(proxyURL))
+	c.C.ProxyHost = proxy.Listener.Addr().String()
+	_, err = c.ScrapeGoogleUrl("http://www.google.com/search?q=adidas").Get(context.Background())
+	assert.ErrorContains(t, err, "cannot be used with a proxy url")
+}
+
+func TestSerpUnifiedClient_ProxyEndpointInvalidOpts(t *testing.T) {
+	var reqs int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&reqs, 1)
+	}))
+	defer proxy.Close()
+
+	c := InitUnified("username", "password", oxylabs.WithIntegration(oxylabs.ProxyEndpoint))
+	c.C.ProxyHost = proxy.Listener.Addr().String()
+
+	// The opts are checked like over the other integrations.
+	opts := &GoogleUrlOpts{Render: "pdf", UserAgent: "fridge"}
+	_, err := c.ScrapeGoogleUrl("http://www.google.com/search?q=adidas", opts).Get(context.Background())
+	assert.ErrorContains(t, err, "invalid render parameter")
+	assert.ErrorContains(t, err, "invalid user agent parameter")
+
+	_, err = c.ScrapeBingUrl("http://www.bing.com/search?q=adidas", &BingUrlOpts{GeoLocation: "XX"}).Get(context.Background())
+	assert.ErrorContains(t, err, "invalid geo_location parameter")
+	assert.EqualValues(t, 0, reqs)
+}
+
+func TestSerpUnifiedClient_ProxyEndpointUnsupported(t *testing.T) {
+	c := InitUnified("username", "password", oxylabs.WithIntegration(oxylabs.ProxyEndpoint))
+
+	_, err := c.ScrapeGoogleSearch("adidas").Get(context.Background())
+	assert.EqualError(t, err, "source google_search does not support the proxy_endpoint integration")
+}
+
+func TestSerpUnifiedClient_RealtimeReturnsFuture(t *testing.T) {
+	var submitted int32
+	c := newTestClientUnified(t, slowServer(t), &submitted)
+
+	// The Future is returned before the realtime scrape is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	future := c.ScrapeGoogleSearchCtx(ctx, "adidas")
+	select {
+	case <-future.Done():
+		t.Fatal("realtime scrape resolved before its resp")
+	default:
+	}
+
+	getCtx, getCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer getCancel()
+	_, err := future.Get(getCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Canceling the scrape resolves the Future with its error.
+	cancel()
+	_, err = future.Get(context.Background())
+	assert.ErrorIs(t, err, context.Canceled)
+}
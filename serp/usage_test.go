@@ -0,0 +1,52 @@
+package serp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerpClient_GetUsageStats(t *testing.T) {
+	var reqUrl *url.URL
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqUrl = r.URL
+		w.Write([]byte(`{
+			"data": {"sources": [
+				{"title": "google_search", "results_count": "120", "realtime_results_count": "30"},
+				{"title": "bing_search", "results_count": 5, "realtime_results_count": 0}
+			]},
+			"meta": {"group_by": "day", "date_from": "2024-01-01", "date_to": "2024-01-31"}
+		}`))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	c := Init("username", "password")
+	c.C.HttpClient = &http.Client{Transport: rewriteTransport{target: target}}
+
+	stats, err := c.GetUsageStats(&oxylabs.UsageStatsOpts{
+		DateFrom: "2024-01-01",
+		DateTo:   "2024-01-31",
+		GroupBy:  "day",
+		Source:   oxylabs.GoogleSearch,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/v2/stats", reqUrl.Path)
+	assert.Equal(t, url.Values{
+		"date_from": {"2024-01-01"},
+		"date_to":   {"2024-01-31"},
+		"group_by":  {"day"},
+		"source":    {"google_search"},
+	}, reqUrl.Query())
+	assert.Equal(t, "day", stats.Meta.GroupBy)
+	assert.Equal(t, int64(155), stats.TotalResults())
+
+	// Without opts no query parameters are sent.
+	_, err = c.GetUsageStats()
+	assert.NoError(t, err)
+	assert.Empty(t, reqUrl.RawQuery)
+}
@@ -0,0 +1,109 @@
+package serp
+
+import (
+	"fmt"
+
+	"github.com/oxylabs/oxylabs-sdk-go/internal"
+)
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleSearch would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleSearchOpts) Validate() error {
+	_, err := prepareGoogleSearch(nil, "", []*GoogleSearchOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleUrl would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleUrlOpts) Validate() error {
+	_, err := prepareGoogleUrl(nil, "", []*GoogleUrlOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleAds would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleAdsOpts) Validate() error {
+	_, err := prepareGoogleAds(nil, "", []*GoogleAdsOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleSuggestions would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleSuggestionsOpts) Validate() error {
+	_, err := prepareGoogleSuggestions(nil, "", []*GoogleSuggestionsOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleHotels would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleHotelsOpts) Validate() error {
+	_, err := prepareGoogleHotels(nil, "", []*GoogleHotelsOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleTravelHotels would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleTravelHotelsOpts) Validate() error {
+	_, err := prepareGoogleTravelHotels(nil, "", []*GoogleTravelHotelsOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleImages would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleImagesOpts) Validate() error {
+	_, err := prepareGoogleImages(nil, "", []*GoogleImagesOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeGoogleTrendsExplore would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *GoogleTrendsExploreOpts) Validate() error {
+	_, err := prepareGoogleTrendsExplore(nil, "", []*GoogleTrendsExploreOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeBingSearch would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *BingSearchOpts) Validate() error {
+	_, err := prepareBingSearch(nil, "", []*BingSearchOpts{opt})
+	return err
+}
+
+// Validate checks the options with the SDK defaults applied, as ScrapeBingUrl would.
+// Client defaults and the query are checked by the Validate method of the client.
+func (opt *BingUrlOpts) Validate() error {
+	_, err := prepareBingUrl(nil, "", []*BingUrlOpts{opt})
+	return err
+}
+
+// validate checks the query and opts of a scrape with the defaults of the client
+// applied, without making the req.
+func validate(c *internal.Client, query string, opts interface{}) error {
+	var err error
+	switch opt := opts.(type) {
+	case *GoogleSearchOpts:
+		_, err = prepareGoogleSearch(c, query, []*GoogleSearchOpts{opt})
+	case *GoogleUrlOpts:
+		_, err = prepareGoogleUrl(c, query, []*GoogleUrlOpts{opt})
+	case *GoogleAdsOpts:
+		_, err = prepareGoogleAds(c, query, []*GoogleAdsOpts{opt})
+	case *GoogleSuggestionsOpts:
+		_, err = prepareGoogleSuggestions(c, query, []*GoogleSuggestionsOpts{opt})
+	case *GoogleHotelsOpts:
+		_, err = prepareGoogleHotels(c, query, []*GoogleHotelsOpts{opt})
+	case *GoogleTravelHotelsOpts:
+		_, err = prepareGoogleTravelHotels(c, query, []*GoogleTravelHotelsOpts{opt})
+	case *GoogleImagesOpts:
+		_, err = prepareGoogleImages(c, query, []*GoogleImagesOpts{opt})
+	case *GoogleTrendsExploreOpts:
+		_, err = prepareGoogleTrendsExplore(c, query, []*GoogleTrendsExploreOpts{opt})
+	case *BingSearchOpts:
+		_, err = prepareBingSearch(c, query, []*BingSearchOpts{opt})
+	case *BingUrlOpts:
+		_, err = prepareBingUrl(c, query, []*BingUrlOpts{opt})
+	default:
+		err = fmt.Errorf("unsupported opts type %T", opts)
+	}
+
+	return err
+}
@@ -0,0 +1,137 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultKeyTemplate is the key template of archived results if none is given.
+const DefaultKeyTemplate = "{source}/{date}/{job_id}.json"
+
+// emptyKeyValue replaces the empty values of key template placeholders,
+// which would otherwise produce empty path segments.
+const emptyKeyValue = "_"
+
+// ObjectPutter is implemented by object storage clients, e.g. S3, which writes
+// to S3 and S3-compatible storage, or a thin wrapper around a GCS client.
+type ObjectPutter interface {
+	PutObject(ctx context.Context, key string, body []byte, contentType string) error
+}
+
+// templateVar matches the placeholders of key templates.
+var templateVar = regexp.MustCompile(`\{([A-Za-z0-9_.-]+)\}`)
+
+// archiveJob is the part of a result used to fill key templates.
+// Resps of all sources share it, so it is read from their JSON form.
+type archiveJob struct {
+	Meta map[string]string `json:"meta"`
+	Job  struct {
+		ID     string `json:"id"`
+		Source string `json:"source"`
+		Query  string `json:"query"`
+		Status string `json:"status"`
+	} `json:"job"`
+}
+
+// Archive writes every result as a JSON object to object storage.
+type Archive[T any] struct {
+	putter   ObjectPutter
+	template string
+	now      func() time.Time
+}
+
+// NewArchive returns a sink writing results to putter under keys built from
+// template, or DefaultKeyTemplate if it is empty. Templates may contain the
+// placeholders {source}, {job_id}, {query}, {status}, {date} and {time}
+// of the time the result is archived in UTC, and {meta.<key>} of the user
+// tags of the req. Values are escaped so that they add no path segments,
+// and empty values are replaced with "_".
+func NewArchive[T any](putter ObjectPutter, template string) (*Archive[T], error) {
+	if template == "" {
+		template = DefaultKeyTemplate
+	}
+
+	for _, match := range templateVar.FindAllStringSubmatch(template, -1) {
+		switch name := match[1]; {
+		case name == "source", name == "job_id", name == "query", name == "status",
+			name == "date", name == "time", strings.HasPrefix(name, "meta."):
+		default:
+			return nil, fmt.Errorf("unknown key template placeholder {%s}", name)
+		}
+	}
+
+	return &Archive[T]{putter: putter, template: template, now: time.Now}, nil
+}
+
+// Publish marshals the result and writes it under its key.
+func (s *Archive[T]) Publish(ctx context.Context, result T) error {
+	value, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("error marshalling result: %v", err)
+	}
+
+	key, err := s.key(value)
+	if err != nil {
+		return err
+	}
+
+	if err = s.putter.PutObject(ctx, key, value, "application/json"); err != nil {
+		return fmt.Errorf("error putting object %s: %v", key, err)
+	}
+
+	return nil
+}
+
+// key returns the key of the marshalled result.
+func (s *Archive[T]) key(value []byte) (string, error) {
+	var job archiveJob
+	if err := json.Unmarshal(value, &job); err != nil {
+		return "", fmt.Errorf("error unmarshalling result: %v", err)
+	}
+
+	now := s.now().UTC()
+	var err error
+	key := templateVar.ReplaceAllStringFunc(s.template, func(placeholder string) string {
+		var v string
+		switch name := placeholder[1 : len(placeholder)-1]; name {
+		case "source":
+			v = job.Job.Source
+		case "job_id":
+			v = job.Job.ID
+			if v == "" {
+				err = fmt.Errorf("result has no job ID")
+			}
+		case "query":
+			v = job.Job.Query
+		case "status":
+			v = job.Job.Status
+		case "date":
+			v = now.Format("2006-01-02")
+		case "time":
+			v = now.Format("150405")
+		default:
+			v = job.Meta[strings.TrimPrefix(name, "meta.")]
+		}
+
+		// Values must not add path segments to the key.
+		switch v {
+		case "":
+			return emptyKeyValue
+		case ".", "..":
+			if err == nil {
+				err = fmt.Errorf("key template placeholder %s has the value %q", placeholder, v)
+			}
+		}
+		return url.PathEscape(v)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
@@ -0,0 +1,59 @@
+package sink
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type putter map[string]string
+
+func (p putter) PutObject(ctx context.Context, key string, body []byte, contentType string) error {
+	p[key] = string(body)
+	return nil
+}
+
+type archivedResult struct {
+	Job struct {
+		ID     string `json:"id"`
+		Source string `json:"source"`
+		Query  string `json:"query"`
+	} `json:"job"`
+	Meta map[string]string `json:"meta,omitempty"`
+}
+
+func TestArchive_Publish(t *testing.T) {
+	p := putter{}
+	s, err := NewArchive[*archivedResult](p, "{meta.team}/{source}/{date}/{query}-{job_id}.json")
+	assert.NoError(t, err)
+	s.now = func() time.Time { return time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	result := &archivedResult{Meta: map[string]string{"team": "seo"}}
+	result.Job.ID = "12345"
+	result.Job.Source = "google_search"
+	result.Job.Query = "adidas/shoes"
+
+	assert.NoError(t, s.Publish(context.Background(), result))
+	assert.Contains(t, p, "seo/google_search/2024-03-01/adidas%2Fshoes-12345.json")
+
+	_, err = NewArchive[*archivedResult](p, "{source}/{unknown}.json")
+	assert.Error(t, err)
+}
+
+func TestArchive_KeyValues(t *testing.T) {
+	p := putter{}
+	s, err := NewArchive[*archivedResult](p, "{meta.team}/{query}/{job_id}.json")
+	assert.NoError(t, err)
+
+	// Empty values do not produce empty path segments.
+	result := &archivedResult{}
+	result.Job.ID = "12345"
+	assert.NoError(t, s.Publish(context.Background(), result))
+	assert.Contains(t, p, "_/_/12345.json")
+
+	// Values must not address parent segments.
+	result.Job.Query = ".."
+	assert.EqualError(t, s.Publish(context.Background(), result), `key template placeholder {query} has the value ".."`)
+}
@@ -0,0 +1,123 @@
+// Package sink publishes completed results, e.g. *serp.Resp or *ecommerce.Resp,
+// to downstream consumers such as channels, files or message queues.
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ResultSink is implemented by the destinations of completed results.
+// Implementations must be safe for concurrent use.
+type ResultSink[T any] interface {
+	Publish(ctx context.Context, result T) error
+}
+
+// Func adapts a function to a ResultSink.
+type Func[T any] func(ctx context.Context, result T) error
+
+// Publish calls f(ctx, result).
+func (f Func[T]) Publish(ctx context.Context, result T) error {
+	return f(ctx, result)
+}
+
+// Forward publishes every result received from ch, e.g. the Results channel of
+// an async scrape, to s until ch is closed or ctx is done.
+func Forward[T any](ctx context.Context, ch <-chan T, s ResultSink[T]) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case result, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := s.Publish(ctx, result); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Channel publishes results to a channel.
+type Channel[T any] struct {
+	ch chan<- T
+}
+
+// NewChannel returns a sink publishing results to ch.
+func NewChannel[T any](ch chan<- T) *Channel[T] {
+	return &Channel[T]{ch: ch}
+}
+
+// Publish sends the result to the channel, blocking until it is received or ctx is done.
+func (s *Channel[T]) Publish(ctx context.Context, result T) error {
+	select {
+	case s.ch <- result:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NDJSON writes results to an io.Writer as newline-delimited JSON.
+type NDJSON[T any] struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewNDJSON returns a sink writing results to w, one JSON object per line.
+func NewNDJSON[T any](w io.Writer) *NDJSON[T] {
+	return &NDJSON[T]{enc: json.NewEncoder(w)}
+}
+
+// Publish writes the result as a single line of JSON.
+func (s *NDJSON[T]) Publish(ctx context.Context, result T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.enc.Encode(result); err != nil {
+		return fmt.Errorf("error encoding result: %v", err)
+	}
+
+	return nil
+}
+
+// Producer is implemented by message queue producers, e.g. a thin wrapper
+// around a Kafka or SQS client.
+type Producer interface {
+	Produce(ctx context.Context, key string, value []byte) error
+}
+
+// Queue publishes results as JSON messages via a Producer.
+type Queue[T any] struct {
+	producer Producer
+	key      func(T) string
+}
+
+// NewQueue returns a sink producing a JSON message per result. key returns
+// the message key of a result, e.g. its job ID, and may be nil.
+func NewQueue[T any](producer Producer, key func(T) string) *Queue[T] {
+	return &Queue[T]{producer: producer, key: key}
+}
+
+// Publish marshals the result and produces it.
+func (s *Queue[T]) Publish(ctx context.Context, result T) error {
+	value, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("error marshalling result: %v", err)
+	}
+
+	var key string
+	if s.key != nil {
+		key = s.key(result)
+	}
+
+	if err = s.producer.Produce(ctx, key, value); err != nil {
+		return fmt.Errorf("error producing result: %v", err)
+	}
+
+	return nil
+}
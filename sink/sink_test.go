@@ -0,0 +1,40 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type result struct {
+	Job string `json:"job"`
+}
+
+func TestForward_NDJSON(t *testing.T) {
+	ch := make(chan *result, 2)
+	ch <- &result{Job: "1"}
+	ch <- &result{Job: "2"}
+	close(ch)
+
+	var buf bytes.Buffer
+	err := Forward[*result](context.Background(), ch, NewNDJSON[*result](&buf))
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"job\":\"1\"}\n{\"job\":\"2\"}\n", buf.String())
+}
+
+type producer map[string]string
+
+func (p producer) Produce(ctx context.Context, key string, value []byte) error {
+	p[key] = string(value)
+	return nil
+}
+
+func TestQueue_Publish(t *testing.T) {
+	p := producer{}
+	s := NewQueue(p, func(r *result) string { return r.Job })
+
+	assert.NoError(t, s.Publish(context.Background(), &result{Job: "1"}))
+	assert.Equal(t, producer{"1": `{"job":"1"}`}, p)
+}
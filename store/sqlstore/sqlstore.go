@@ -0,0 +1,307 @@
+// Package sqlstore persists job metadata and results, e.g. *serp.Resp or
+// *ecommerce.Resp, to Postgres or MySQL tables so that they can be analyzed
+// with SQL directly. Any database/sql driver of the dialect can be used.
+//
+// Jobs are written to the oxylabs_jobs table and their results, one row per
+// page, to the oxylabs_results table:
+//
+//	oxylabs_jobs
+//	  job_id       VARCHAR(64) PRIMARY KEY
+//	  source       VARCHAR(64)   source of the job, e.g. google_search
+//	  query        TEXT          query of the job, if any
+//	  url          TEXT          url of the job, if any
+//	  status       VARCHAR(32)   status of the job, e.g. done
+//	  status_code  INTEGER       status code of the resp
+//	  created_at   VARCHAR(64)   creation time of the job reported by the API
+//	  meta         JSON          user tags of the req, if any
+//	  stored_at    TIMESTAMP     time the job was written
+//
+//	oxylabs_results
+//	  job_id       VARCHAR(64)   job_id of oxylabs_jobs
+//	  page         INTEGER       page of the result
+//	  url          TEXT          url of the scraped page
+//	  status_code  INTEGER       status code of the scraped page
+//	  parser_type  VARCHAR(64)   parser of the result, if any
+//	  content      TEXT          raw content of an unparsed result
+//	  parsed       JSON          parsed content of a parsed result
+//	  PRIMARY KEY (job_id, page)
+//
+// JSON columns are JSONB in Postgres. The tables can be created with
+// Store.CreateTables or from the statements returned by Dialect.Schema.
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// JobsTable is the table of job metadata.
+	JobsTable = "oxylabs_jobs"
+	// ResultsTable is the table of job results.
+	ResultsTable = "oxylabs_results"
+)
+
+// Dialect is the SQL dialect of a database.
+type Dialect int
+
+const (
+	Postgres Dialect = iota
+	MySQL
+)
+
+// Schema returns the statements creating the tables of the dialect.
+func (d Dialect) Schema() []string {
+	jsonType := "JSON"
+	if d == Postgres {
+		jsonType = "JSONB"
+	}
+
+	return []string{
+		`CREATE TABLE IF NOT EXISTS ` + JobsTable + ` (
+	job_id VARCHAR(64) PRIMARY KEY,
+	source VARCHAR(64) NOT NULL,
+	query TEXT,
+	url TEXT,
+	status VARCHAR(32) NOT NULL,
+	status_code INTEGER NOT NULL,
+	created_at VARCHAR(64),
+	meta ` + jsonType + `,
+	stored_at TIMESTAMP NOT NULL
+)`,
+		`CREATE TABLE IF NOT EXISTS ` + ResultsTable + ` (
+	job_id VARCHAR(64) NOT NULL,
+	page INTEGER NOT NULL,
+	url TEXT,
+	status_code INTEGER NOT NULL,
+	parser_type VARCHAR(64),
+	content TEXT,
+	parsed ` + jsonType + `,
+	PRIMARY KEY (job_id, page)
+)`,
+	}
+}
+
+// placeholders returns n bind placeholders of the dialect separated by commas.
+func (d Dialect) placeholders(n int) string {
+	p := make([]string, n)
+	for i := range p {
+		if d == Postgres {
+			p[i] = fmt.Sprintf("$%d", i+1)
+		} else {
+			p[i] = "?"
+		}
+	}
+
+	return strings.Join(p, ", ")
+}
+
+// upsertJob returns the statement inserting a job or replacing
+// a previously stored job with the same ID.
+func (d Dialect) upsertJob() string {
+	columns := []string{"source", "query", "url", "status", "status_code", "created_at", "meta", "stored_at"}
+	update := make([]string, len(columns))
+	for i, column := range columns {
+		if d == Postgres {
+			update[i] = column + " = EXCLUDED." + column
+		} else {
+			update[i] = column + " = VALUES(" + column + ")"
+		}
+	}
+
+	conflict := "ON DUPLICATE KEY UPDATE"
+	if d == Postgres {
+		conflict = "ON CONFLICT (job_id) DO UPDATE SET"
+	}
+
+	return fmt.Sprintf(
+		"INSERT INTO %s (job_id, %s) VALUES (%s) %s %s",
+		JobsTable,
+		strings.Join(columns, ", "),
+		d.placeholders(len(columns)+1),
+		conflict,
+		strings.Join(update, ", "),
+	)
+}
+
+// record is the part of a resp written to the tables. Resps of all
+// sources share it, so they are written via their JSON form.
+type record struct {
+	Parse             bool              `json:"parse"`
+	ParseInstructions bool              `json:"parse_instructions"`
+	StatusCode        int               `json:"status_code"`
+	Meta              map[string]string `json:"meta"`
+	Job               struct {
+		ID        string      `json:"id"`
+		Source    string      `json:"source"`
+		Query     string      `json:"query"`
+		Url       interface{} `json:"url"`
+		Status    string      `json:"status"`
+		CreatedAt string      `json:"created_at"`
+	} `json:"job"`
+	Results []struct {
+		Page                int             `json:"page"`
+		Url                 string          `json:"url"`
+		StatusCode          int             `json:"status_code"`
+		ParserType          string          `json:"parser_type"`
+		Content             json.RawMessage `json:"content"`
+		ContentParsed       json.RawMessage `json:"content_parsed"`
+		CustomContentParsed json.RawMessage `json:"custom_content_parsed"`
+	} `json:"results"`
+}
+
+// Store writes resps of type T to the tables. It implements sink.ResultSink,
+// so results can be published to it directly. It is safe for concurrent use.
+type Store[T any] struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// New returns a store writing resps to db of the given dialect.
+func New[T any](db *sql.DB, dialect Dialect) *Store[T] {
+	return &Store[T]{db: db, dialect: dialect}
+}
+
+// CreateTables creates the tables if they do not exist.
+func (s *Store[T]) CreateTables(ctx context.Context) error {
+	for _, stmt := range s.dialect.Schema() {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("error creating tables: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Publish writes the job and results of resp in a single transaction,
+// replacing those of a previously written resp of the same job.
+func (s *Store[T]) Publish(ctx context.Context, resp T) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("error marshalling resp: %v", err)
+	}
+
+	var r record
+	if err = json.Unmarshal(data, &r); err != nil {
+		return fmt.Errorf("error unmarshalling resp: %v", err)
+	}
+	if r.Job.ID == "" {
+		return fmt.Errorf("resp has no job ID")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err = s.writeJob(ctx, tx, &r); err != nil {
+		return err
+	}
+	if err = s.writeResults(ctx, tx, &r); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %v", err)
+	}
+
+	return nil
+}
+
+// writeJob upserts the job row of r.
+func (s *Store[T]) writeJob(ctx context.Context, tx *sql.Tx, r *record) error {
+	var meta interface{}
+	if len(r.Meta) > 0 {
+		data, err := json.Marshal(r.Meta)
+		if err != nil {
+			return fmt.Errorf("error marshalling meta: %v", err)
+		}
+		meta = string(data)
+	}
+
+	var url string
+	if u, ok := r.Job.Url.(string); ok {
+		url = u
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		s.dialect.upsertJob(),
+		r.Job.ID,
+		r.Job.Source,
+		r.Job.Query,
+		url,
+		r.Job.Status,
+		r.StatusCode,
+		r.Job.CreatedAt,
+		meta,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("error writing job %s: %v", r.Job.ID, err)
+	}
+
+	return nil
+}
+
+// writeResults replaces the result rows of the job of r.
+func (s *Store[T]) writeResults(ctx context.Context, tx *sql.Tx, r *record) error {
+	_, err := tx.ExecContext(
+		ctx,
+		fmt.Sprintf("DELETE FROM %s WHERE job_id = %s", ResultsTable, s.dialect.placeholders(1)),
+		r.Job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("error deleting results of job %s: %v", r.Job.ID, err)
+	}
+
+	insert := fmt.Sprintf(
+		"INSERT INTO %s (job_id, page, url, status_code, parser_type, content, parsed) VALUES (%s)",
+		ResultsTable,
+		s.dialect.placeholders(7),
+	)
+	for i, result := range r.Results {
+		page := result.Page
+		if page == 0 {
+			page = i + 1
+		}
+
+		// Parsed results are written as JSON, unparsed ones as their raw content.
+		var content, parsed interface{}
+		switch {
+		case r.ParseInstructions && len(result.CustomContentParsed) > 0:
+			parsed = string(result.CustomContentParsed)
+		case r.Parse && len(result.ContentParsed) > 0:
+			parsed = string(result.ContentParsed)
+		case len(result.Content) > 0:
+			var raw string
+			if err = json.Unmarshal(result.Content, &raw); err == nil {
+				content = raw
+			} else {
+				content = string(result.Content)
+			}
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			insert,
+			r.Job.ID,
+			page,
+			result.Url,
+			result.StatusCode,
+			result.ParserType,
+			content,
+			parsed,
+		)
+		if err != nil {
+			return fmt.Errorf("error writing result of job %s: %v", r.Job.ID, err)
+		}
+	}
+
+	return nil
+}
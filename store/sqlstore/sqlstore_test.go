@@ -0,0 +1,110 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/oxylabs/oxylabs-sdk-go/serp"
+	"github.com/oxylabs/oxylabs-sdk-go/sink"
+	"github.com/stretchr/testify/assert"
+)
+
+// execRecorder is a database/sql driver recording the statements it executes.
+type execRecorder struct {
+	mu        sync.Mutex
+	stmts     []string
+	args      [][]driver.Value
+	committed bool
+}
+
+// recorder is registered once as the sqlstore_recorder driver, since
+// sql.Register panics on a second registration, e.g. with -count=2.
+var recorder = &execRecorder{}
+
+func init() {
+	sql.Register("sqlstore_recorder", recorder)
+}
+
+// reset clears the recorded statements.
+func (d *execRecorder) reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stmts, d.args, d.committed = nil, nil, false
+}
+
+func (d *execRecorder) Open(name string) (driver.Conn, error) { return &recorderConn{d}, nil }
+
+type recorderConn struct{ d *execRecorder }
+
+func (c *recorderConn) Prepare(query string) (driver.Stmt, error) {
+	return &recorderStmt{c.d, query}, nil
+}
+func (c *recorderConn) Close() error              { return nil }
+func (c *recorderConn) Begin() (driver.Tx, error) { return &recorderTx{c.d}, nil }
+
+type recorderTx struct{ d *execRecorder }
+
+func (tx *recorderTx) Commit() error {
+	tx.d.mu.Lock()
+	defer tx.d.mu.Unlock()
+	tx.d.committed = true
+	return nil
+}
+func (tx *recorderTx) Rollback() error { return nil }
+
+type recorderStmt struct {
+	d     *execRecorder
+	query string
+}
+
+func (s *recorderStmt) Close() error  { return nil }
+func (s *recorderStmt) NumInput() int { return -1 }
+func (s *recorderStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.stmts = append(s.d.stmts, s.query)
+	s.d.args = append(s.d.args, args)
+	return driver.RowsAffected(1), nil
+}
+func (s *recorderStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, driver.ErrSkip
+}
+
+func TestStore_Publish(t *testing.T) {
+	recorder.reset()
+	db, err := sql.Open("sqlstore_recorder", "")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	resp := &serp.Resp{Parse: true, StatusCode: 200, Meta: map[string]string{"team": "seo"}}
+	resp.Job.ID = "12345"
+	resp.Job.Source = "google_search"
+	resp.Job.Query = "adidas"
+	resp.Job.Status = "done"
+	result := serp.Results{Page: 1, Url: "https://www.google.com/search?q=adidas", StatusCode: 200}
+	result.ContentParsed.Results.Organic = []serp.Organic{{Pos: 1, Title: "Adidas"}}
+	resp.Results = []serp.Results{result}
+
+	var s sink.ResultSink[*serp.Resp] = New[*serp.Resp](db, Postgres)
+	assert.NoError(t, s.Publish(context.Background(), resp))
+
+	assert.True(t, recorder.committed)
+	assert.Len(t, recorder.stmts, 3)
+	assert.Contains(t, recorder.stmts[0], "ON CONFLICT (job_id) DO UPDATE SET")
+	assert.Equal(t, []driver.Value{"12345", "google_search", "adidas", "", "done", int64(200)}, recorder.args[0][:6])
+	assert.Equal(t, `{"team":"seo"}`, recorder.args[0][7])
+	assert.True(t, strings.HasPrefix(recorder.stmts[1], "DELETE FROM oxylabs_results"))
+	assert.Equal(t, "12345", recorder.args[2][0])
+	assert.Nil(t, recorder.args[2][5])
+	assert.Contains(t, recorder.args[2][6], `"title":"Adidas"`)
+}
+
+func TestDialect_Placeholders(t *testing.T) {
+	assert.Equal(t, "$1, $2, $3", Postgres.placeholders(3))
+	assert.Equal(t, "?, ?, ?", MySQL.placeholders(3))
+	assert.Contains(t, MySQL.upsertJob(), "ON DUPLICATE KEY UPDATE source = VALUES(source)")
+}